@@ -61,6 +61,7 @@ func main() {
 		Password:    cfg.Oracle.Password,
 		MaxConns:    cfg.Oracle.MaxConns,
 		MinConns:    cfg.Oracle.MinConns,
+		Cluster:     cfg.Oracle.ClusterMode,
 	})
 	if err != nil {
 		log.Fatal("Failed to connect to Oracle", logger.Error(err))
@@ -70,15 +71,40 @@ func main() {
 
 	// Initialize repositories
 	log.Info("Initializing repositories...")
+	auditWriter := repository.NewAuditLogWriter(
+		repository.NewAuditLogRepository(pgDB.DB),
+		repository.AuditLogWriterConfig{
+			QueueSize:      cfg.Audit.QueueSize,
+			BatchSize:      cfg.Audit.BatchSize,
+			FlushInterval:  cfg.Audit.FlushInterval,
+			OverflowPolicy: cfg.Audit.OverflowPolicy,
+			SyncActions:    cfg.Audit.SyncActions,
+		},
+		log,
+	)
+
 	repos := &repository.Repositories{
 		Users:             repository.NewUserRepository(pgDB.DB),
 		Roles:             repository.NewRoleRepository(pgDB.DB),
 		UserRoles:         repository.NewUserRoleRepository(pgDB.DB),
 		Permissions:       repository.NewPermissionRepository(pgDB.DB),
-		AuditLogs:         repository.NewAuditLogRepository(pgDB.DB),
+		AuditLogs:         auditWriter,
 		SessionMetrics:    repository.NewSessionMetricsRepository(pgDB.DB),
 		TablespaceMetrics: repository.NewTablespaceMetricsRepository(pgDB.DB),
 		QueryMetrics:      repository.NewQueryMetricsRepository(pgDB.DB),
+		SQLPlanChanges:    repository.NewSQLPlanChangeRepository(pgDB.DB),
+		AlertRules:        repository.NewAlertRuleRepository(pgDB.DB),
+		Alerts:            repository.NewAlertRepository(pgDB.DB),
+		NotificationChannels: repository.NewNotificationChannelRepository(pgDB.DB),
+		Notifications:     repository.NewNotificationRepository(pgDB.DB),
+		Silences:          repository.NewSilenceRepository(pgDB.DB),
+		MaintenanceWindows: repository.NewMaintenanceWindowRepository(pgDB.DB),
+		ASHSamples:        repository.NewASHSampleRepository(pgDB.DB),
+		SystemStats:       repository.NewSystemStatsRepository(pgDB.DB),
+		RecoveryAreaMetrics: repository.NewRecoveryAreaMetricsRepository(pgDB.DB),
+		DataGuardLag:      repository.NewDataGuardLagRepository(pgDB.DB),
+		ParameterSnapshots: repository.NewParameterSnapshotRepository(pgDB.DB),
+		SegmentSnapshots:  repository.NewSegmentSnapshotRepository(pgDB.DB),
 	}
 	log.Info("Repositories initialized successfully")
 
@@ -108,10 +134,95 @@ func main() {
 		repos.QueryMetrics,
 		repos.AuditLogs,
 	)
+
+	silenceService := service.NewSilenceService(
+		repos.Silences,
+		repos.MaintenanceWindows,
+		repos.AuditLogs,
+	)
+
+	notificationService := service.NewNotificationService(
+		repos.NotificationChannels,
+		repos.Notifications,
+		repos.AuditLogs,
+		silenceService,
+		log,
+		cfg.Oracle.TargetName,
+		cfg.Notify.DedupWindow,
+		cfg.Notify.QueueSize,
+		cfg.Notify.MaxAttempts,
+		cfg.Notify.InitialBackoff,
+		cfg.Notify.MaxBackoff,
+	)
+
+	alertService := service.NewAlertService(
+		oracleService,
+		repos.AlertRules,
+		repos.Alerts,
+		repos.QueryMetrics,
+		repos.SQLPlanChanges,
+		repos.AuditLogs,
+		notificationService,
+		log,
+		cfg.Oracle.TargetName,
+		cfg.Alerting.EvalInterval,
+		cfg.Alerting.SQLTopN,
+	)
+
+	ashService := service.NewASHService(
+		oracleService,
+		repos.ASHSamples,
+		log,
+		cfg.Oracle.TargetName,
+		cfg.ASH.SampleInterval,
+		cfg.ASH.BufferDuration,
+		cfg.ASH.FlushInterval,
+		cfg.ASH.Retention,
+	)
+
+	systemStatsService := service.NewSystemStatsService(
+		oracleService,
+		repos.SystemStats,
+		log,
+		cfg.Oracle.TargetName,
+		cfg.SysStats.Interval,
+		cfg.SysStats.Retention,
+	)
+
+	historyService := service.NewHistoryService(
+		oracleService,
+		repos.RecoveryAreaMetrics,
+		repos.DataGuardLag,
+		repos.ParameterSnapshots,
+		repos.SegmentSnapshots,
+		log,
+		cfg.Oracle.TargetName,
+		cfg.History.Interval,
+		cfg.History.Retention,
+	)
 	log.Info("Services initialized successfully")
 
+	// Start background alert evaluation and notification delivery
+	notificationService.Start()
+	alertService.Start()
+	log.Info(fmt.Sprintf("Alert evaluation started (every %s)", cfg.Alerting.EvalInterval))
+
+	// Start system wait event and time model collection
+	systemStatsService.Start()
+	log.Info(fmt.Sprintf("System statistics collection started (every %s)", cfg.SysStats.Interval))
+
+	// Start recovery area, Data Guard lag, parameter and segment history snapshots
+	historyService.Start()
+	log.Info(fmt.Sprintf("History collection started (every %s)", cfg.History.Interval))
+
+	// Start active session history sampling
+	if cfg.ASH.Enabled {
+		ashService.Start()
+		log.Info(fmt.Sprintf("ASH sampling started (every %s)", cfg.ASH.SampleInterval))
+	}
+
 	// Initialize GraphQL resolver
-	resolver := graph.NewResolver(authService, rbacService, oracleService)
+	resolver := graph.NewResolver(authService, rbacService, oracleService, alertService, notificationService, silenceService, ashService, systemStatsService, historyService)
 
 	// Create GraphQL server
 	srv := handler.NewDefaultServer(graph.NewExecutableSchema(graph.Config{
@@ -190,5 +301,17 @@ func main() {
 		log.Error("Server forced to shutdown", logger.Error(err))
 	}
 
+	// Stop background workers before draining audit logs
+	alertService.Stop()
+	notificationService.Stop()
+	ashService.Stop()
+	systemStatsService.Stop()
+	historyService.Stop()
+
+	// Drain queued audit logs before the PostgreSQL pool is closed
+	if err := auditWriter.Close(ctx); err != nil {
+		log.Error("Failed to drain audit logs", logger.Error(err))
+	}
+
 	log.Info("Server stopped")
 }
\ No newline at end of file
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/joho/godotenv"
@@ -16,6 +17,12 @@ type Config struct {
 	Oracle    OracleConfig
 	JWT       JWTConfig
 	Logging   LoggingConfig
+	Audit     AuditConfig
+	Alerting  AlertingConfig
+	Notify    NotifyConfig
+	ASH       ASHConfig
+	SysStats  SysStatsConfig
+	History   HistoryConfig
 }
 
 // ServerConfig holds HTTP server configuration
@@ -48,6 +55,8 @@ type OracleConfig struct {
 	Password    string
 	MaxConns    int
 	MinConns    int
+	TargetName  string // name identifying this database in history and alerts
+	ClusterMode bool   // query gv$ views to see every RAC instance
 }
 
 // JWTConfig holds JWT token configuration
@@ -63,6 +72,51 @@ type LoggingConfig struct {
 	Format string // json, text
 }
 
+// AuditConfig holds asynchronous audit writer configuration
+type AuditConfig struct {
+	QueueSize      int
+	BatchSize      int
+	FlushInterval  time.Duration
+	OverflowPolicy string   // block, drop
+	SyncActions    []string // actions always written synchronously
+}
+
+// AlertingConfig holds alert rule engine configuration
+type AlertingConfig struct {
+	EvalInterval time.Duration
+	SQLTopN      int // number of SQL statements snapshotted per evaluation
+}
+
+// NotifyConfig holds alert notification delivery configuration
+type NotifyConfig struct {
+	DedupWindow    time.Duration // repeated firings within this window are notified once
+	QueueSize      int
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// ASHConfig holds active session history sampler configuration
+type ASHConfig struct {
+	Enabled        bool
+	SampleInterval time.Duration
+	BufferDuration time.Duration // how much history is kept in memory
+	FlushInterval  time.Duration
+	Retention      time.Duration // how long samples are kept in PostgreSQL
+}
+
+// SysStatsConfig holds system-wide wait event and time model collector configuration
+type SysStatsConfig struct {
+	Interval  time.Duration
+	Retention time.Duration
+}
+
+// HistoryConfig holds history snapshot (recovery area, Data Guard lag, parameters, segments) configuration
+type HistoryConfig struct {
+	Interval  time.Duration
+	Retention time.Duration
+}
+
 // Load loads configuration from environment variables
 func Load() (*Config, error) {
 	// Try to load .env file (optional, ignore error if not found)
@@ -94,6 +148,8 @@ func Load() (*Config, error) {
 			Password:    getEnv("ORACLE_PASSWORD", ""),
 			MaxConns:    getIntEnv("ORACLE_MAX_CONNS", 10),
 			MinConns:    getIntEnv("ORACLE_MIN_CONNS", 2),
+			TargetName:  getEnv("ORACLE_TARGET_NAME", getEnv("ORACLE_SERVICE_NAME", "ORCLPDB1")),
+			ClusterMode: getBoolEnv("ORACLE_CLUSTER_MODE", false),
 		},
 		JWT: JWTConfig{
 			Secret:     getEnv("JWT_SECRET", ""),
@@ -104,6 +160,42 @@ func Load() (*Config, error) {
 			Level:  getEnv("LOG_LEVEL", "info"),
 			Format: getEnv("LOG_FORMAT", "json"),
 		},
+		Audit: AuditConfig{
+			QueueSize:      getIntEnv("AUDIT_QUEUE_SIZE", 1000),
+			BatchSize:      getIntEnv("AUDIT_BATCH_SIZE", 100),
+			FlushInterval:  getDurationEnv("AUDIT_FLUSH_INTERVAL", 2*time.Second),
+			OverflowPolicy: getEnv("AUDIT_OVERFLOW_POLICY", "block"),
+			SyncActions: getListEnv("AUDIT_SYNC_ACTIONS", []string{
+				"LOGIN", "CREATE_USER", "ASSIGN_ROLE", "REVOKE_ROLE", "ACCESS_DENIED", "KILL_SESSION",
+				"GATHER_TABLE_STATS", "RECOMPILE_SCHEMA", "RECOMPILE_OBJECT",
+			}),
+		},
+		Alerting: AlertingConfig{
+			EvalInterval: getDurationEnv("ALERT_EVAL_INTERVAL", 30*time.Second),
+			SQLTopN:      getIntEnv("ALERT_SQL_TOP_N", 100),
+		},
+		Notify: NotifyConfig{
+			DedupWindow:    getDurationEnv("NOTIFY_DEDUP_WINDOW", 15*time.Minute),
+			QueueSize:      getIntEnv("NOTIFY_QUEUE_SIZE", 100),
+			MaxAttempts:    getIntEnv("NOTIFY_MAX_ATTEMPTS", 4),
+			InitialBackoff: getDurationEnv("NOTIFY_INITIAL_BACKOFF", 2*time.Second),
+			MaxBackoff:     getDurationEnv("NOTIFY_MAX_BACKOFF", time.Minute),
+		},
+		ASH: ASHConfig{
+			Enabled:        getEnv("ASH_ENABLED", "true") == "true",
+			SampleInterval: getDurationEnv("ASH_SAMPLE_INTERVAL", time.Second),
+			BufferDuration: getDurationEnv("ASH_BUFFER_DURATION", time.Hour),
+			FlushInterval:  getDurationEnv("ASH_FLUSH_INTERVAL", 10*time.Second),
+			Retention:      getDurationEnv("ASH_RETENTION", 7*24*time.Hour),
+		},
+		SysStats: SysStatsConfig{
+			Interval:  getDurationEnv("SYSSTAT_INTERVAL", time.Minute),
+			Retention: getDurationEnv("SYSSTAT_RETENTION", 30*24*time.Hour),
+		},
+		History: HistoryConfig{
+			Interval:  getDurationEnv("HISTORY_INTERVAL", 5*time.Minute),
+			Retention: getDurationEnv("HISTORY_RETENTION", 90*24*time.Hour),
+		},
 	}
 
 	// Validate critical configuration
@@ -137,6 +229,48 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("JWT_SECRET must be at least 32 characters")
 	}
 
+	// Validate audit writer
+	if c.Audit.QueueSize <= 0 || c.Audit.BatchSize <= 0 {
+		return fmt.Errorf("AUDIT_QUEUE_SIZE and AUDIT_BATCH_SIZE must be positive")
+	}
+	if c.Audit.FlushInterval <= 0 {
+		return fmt.Errorf("AUDIT_FLUSH_INTERVAL must be positive")
+	}
+	if c.Audit.OverflowPolicy != "block" && c.Audit.OverflowPolicy != "drop" {
+		return fmt.Errorf("AUDIT_OVERFLOW_POLICY must be 'block' or 'drop'")
+	}
+
+	// Validate alerting
+	if c.Alerting.EvalInterval < time.Second {
+		return fmt.Errorf("ALERT_EVAL_INTERVAL must be at least 1s")
+	}
+
+	// Validate notifications
+	if c.Notify.QueueSize <= 0 || c.Notify.MaxAttempts <= 0 {
+		return fmt.Errorf("NOTIFY_QUEUE_SIZE and NOTIFY_MAX_ATTEMPTS must be positive")
+	}
+	if c.Notify.InitialBackoff <= 0 || c.Notify.MaxBackoff < c.Notify.InitialBackoff {
+		return fmt.Errorf("NOTIFY_INITIAL_BACKOFF must be positive and not exceed NOTIFY_MAX_BACKOFF")
+	}
+
+	// Validate ASH sampler
+	if c.ASH.SampleInterval < 100*time.Millisecond {
+		return fmt.Errorf("ASH_SAMPLE_INTERVAL must be at least 100ms")
+	}
+	if c.ASH.BufferDuration < c.ASH.SampleInterval || c.ASH.FlushInterval <= 0 || c.ASH.Retention <= 0 {
+		return fmt.Errorf("ASH_BUFFER_DURATION, ASH_FLUSH_INTERVAL and ASH_RETENTION must be positive")
+	}
+
+	// Validate system statistics collector
+	if c.SysStats.Interval < 10*time.Second || c.SysStats.Retention <= 0 {
+		return fmt.Errorf("SYSSTAT_INTERVAL must be at least 10s and SYSSTAT_RETENTION positive")
+	}
+
+	// Validate history collector
+	if c.History.Interval < time.Minute || c.History.Retention <= 0 {
+		return fmt.Errorf("HISTORY_INTERVAL must be at least 1m and HISTORY_RETENTION positive")
+	}
+
 	return nil
 }
 
@@ -174,6 +308,15 @@ func getIntEnv(key string, defaultValue int) int {
 	return defaultValue
 }
 
+func getBoolEnv(key string, defaultValue bool) bool {
+	if value := os.Getenv(key); value != "" {
+		if boolVal, err := strconv.ParseBool(value); err == nil {
+			return boolVal
+		}
+	}
+	return defaultValue
+}
+
 func getDurationEnv(key string, defaultValue time.Duration) time.Duration {
 	if value := os.Getenv(key); value != "" {
 		if duration, err := time.ParseDuration(value); err == nil {
@@ -181,4 +324,17 @@ func getDurationEnv(key string, defaultValue time.Duration) time.Duration {
 		}
 	}
 	return defaultValue
+}
+
+func getListEnv(key string, defaultValue []string) []string {
+	if value := os.Getenv(key); value != "" {
+		items := []string{}
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+		return items
+	}
+	return defaultValue
 }
\ No newline at end of file
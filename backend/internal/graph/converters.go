@@ -0,0 +1,1378 @@
+package graph
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aashiq-04/oracle-dba/internal/graph/model"
+	"github.com/aashiq-04/oracle-dba/internal/repository"
+	"github.com/aashiq-04/oracle-dba/internal/service"
+	"github.com/aashiq-04/oracle-dba/pkg/oracle"
+)
+
+// Conversions between service/repository types and GraphQL models that are
+// shared by several resolvers. Kept outside schema.resolvers.go so gqlgen
+// does not move them when regenerating.
+
+func toModelAlertRule(rule *repository.AlertRule) *model.AlertRule {
+	var createdBy *string
+	if rule.CreatedBy != nil {
+		id := rule.CreatedBy.String()
+		createdBy = &id
+	}
+
+	return &model.AlertRule{
+		ID:              rule.ID.String(),
+		Name:            rule.Name,
+		Description:     rule.Description,
+		Metric:          model.AlertMetric(rule.Metric),
+		Condition:       model.AlertCondition(rule.Condition),
+		Threshold:       rule.Threshold,
+		Duration:        (time.Duration(rule.DurationSeconds) * time.Second).String(),
+		DurationSeconds: rule.DurationSeconds,
+		Severity:        model.AlertSeverity(rule.Severity),
+		TargetFilter:    rule.TargetFilter,
+		ObjectFilter:    rule.ObjectFilter,
+		Enabled:         rule.Enabled,
+		CreatedBy:       createdBy,
+		CreatedAt:       rule.CreatedAt,
+		UpdatedAt:       rule.UpdatedAt,
+	}
+}
+
+func alertRuleFromInput(input model.AlertRuleInput) (*repository.AlertRule, error) {
+	rule := &repository.AlertRule{
+		Name:         input.Name,
+		Description:  input.Description,
+		Metric:       string(input.Metric),
+		Condition:    string(input.Condition),
+		Threshold:    input.Threshold,
+		Severity:     string(input.Severity),
+		TargetFilter: input.TargetFilter,
+		ObjectFilter: input.ObjectFilter,
+		Enabled:      true,
+	}
+
+	if input.Duration != nil && *input.Duration != "" {
+		duration, err := time.ParseDuration(*input.Duration)
+		if err != nil {
+			return nil, fmt.Errorf("invalid duration: %w", err)
+		}
+		rule.DurationSeconds = int(duration.Seconds())
+	}
+
+	if input.Enabled != nil {
+		rule.Enabled = *input.Enabled
+	}
+
+	return rule, nil
+}
+
+func toModelAlert(alert *repository.Alert) *model.Alert {
+	var ruleID *string
+	if alert.RuleID != nil {
+		id := alert.RuleID.String()
+		ruleID = &id
+	}
+
+	return &model.Alert{
+		ID:              alert.ID.String(),
+		RuleID:          ruleID,
+		RuleName:        alert.RuleName,
+		Metric:          model.AlertMetric(alert.Metric),
+		Severity:        model.AlertSeverity(alert.Severity),
+		Status:          model.AlertStatus(alert.Status),
+		Target:          alert.Target,
+		ObjectKey:       alert.ObjectKey,
+		Value:           alert.Value,
+		Threshold:       alert.Threshold,
+		Message:         alert.Message,
+		FiredAt:         alert.FiredAt,
+		AcknowledgedAt:  alert.AcknowledgedAt,
+		AcknowledgedBy:  alert.AcknowledgedBy,
+		ResolvedAt:      alert.ResolvedAt,
+		LastEvaluatedAt: alert.LastEvaluatedAt,
+	}
+}
+
+// parseOptionalUUID parses an optional GraphQL ID
+func parseOptionalUUID(id *string) (*uuid.UUID, error) {
+	if id == nil {
+		return nil, nil
+	}
+	parsed, err := uuid.Parse(*id)
+	if err != nil {
+		return nil, err
+	}
+	return &parsed, nil
+}
+
+// toModelNotificationChannel never exposes secrets, only whether one is set,
+// and masks webhook URLs that embed a token
+func toModelNotificationChannel(channel *repository.NotificationChannel) *model.NotificationChannel {
+	var createdBy *string
+	if channel.CreatedBy != nil {
+		id := channel.CreatedBy.String()
+		createdBy = &id
+	}
+
+	settings := channel.Settings
+	to := settings.To
+	if to == nil {
+		to = []string{}
+	}
+
+	return &model.NotificationChannel{
+		ID:              channel.ID.String(),
+		Name:            channel.Name,
+		Type:            model.NotificationChannelType(channel.Type),
+		URL:             optionalString(service.MaskChannelURL(channel)),
+		HasSecret:       settings.Secret != "" || settings.SMTPPassword != "",
+		SMTPHost:        optionalString(settings.SMTPHost),
+		SMTPPort:        optionalInt(settings.SMTPPort),
+		SMTPUsername:    optionalString(settings.SMTPUsername),
+		From:            optionalString(settings.From),
+		To:              to,
+		SubjectTemplate: channel.SubjectTemplate,
+		BodyTemplate:    channel.BodyTemplate,
+		MinSeverity:     model.AlertSeverity(channel.MinSeverity),
+		Enabled:         channel.Enabled,
+		CreatedBy:       createdBy,
+		CreatedAt:       channel.CreatedAt,
+		UpdatedAt:       channel.UpdatedAt,
+	}
+}
+
+func notificationChannelFromInput(input model.NotificationChannelInput) *repository.NotificationChannel {
+	channel := &repository.NotificationChannel{
+		Name:            input.Name,
+		Type:            string(input.Type),
+		SubjectTemplate: input.SubjectTemplate,
+		BodyTemplate:    input.BodyTemplate,
+		MinSeverity:     "INFO",
+		Enabled:         true,
+		Settings: repository.NotificationChannelSettings{
+			URL:          derefString(input.URL),
+			Secret:       derefString(input.Secret),
+			SMTPHost:     derefString(input.SMTPHost),
+			SMTPUsername: derefString(input.SMTPUsername),
+			SMTPPassword: derefString(input.SMTPPassword),
+			From:         derefString(input.From),
+			To:           input.To,
+		},
+	}
+
+	if input.SMTPPort != nil {
+		channel.Settings.SMTPPort = *input.SMTPPort
+	}
+	if input.MinSeverity != nil {
+		channel.MinSeverity = string(*input.MinSeverity)
+	}
+	if input.Enabled != nil {
+		channel.Enabled = *input.Enabled
+	}
+
+	return channel
+}
+
+func toModelAlertNotification(n *repository.Notification) *model.AlertNotification {
+	return &model.AlertNotification{
+		ID:           n.ID.String(),
+		AlertID:      uuidString(n.AlertID),
+		ChannelID:    uuidString(n.ChannelID),
+		ChannelName:  optionalString(n.ChannelName),
+		Event:        n.Event,
+		Status:       model.NotificationStatus(n.Status),
+		Attempts:     n.Attempts,
+		Error:        n.Error,
+		Reason:       n.Reason,
+		SuppressedBy: uuidString(n.SuppressedBy),
+		CreatedAt:    n.CreatedAt,
+	}
+}
+
+func toModelSilence(silence *repository.Silence) *model.Silence {
+	labels := make([]string, 0, len(silence.Matchers))
+	for label := range silence.Matchers {
+		labels = append(labels, label)
+	}
+	sort.Strings(labels)
+
+	matchers := make([]*model.SilenceMatcher, len(labels))
+	for i, label := range labels {
+		matchers[i] = &model.SilenceMatcher{
+			Label:   model.AlertLabel(strings.ToUpper(label)),
+			Pattern: silence.Matchers[label],
+		}
+	}
+
+	now := time.Now()
+	return &model.Silence{
+		ID:            silence.ID.String(),
+		Matchers:      matchers,
+		Comment:       silence.Comment,
+		CreatedBy:     uuidString(silence.CreatedBy),
+		CreatedByName: silence.CreatedByName,
+		StartsAt:      silence.StartsAt,
+		EndsAt:        silence.EndsAt,
+		Active:        !now.Before(silence.StartsAt) && now.Before(silence.EndsAt),
+		CreatedAt:     silence.CreatedAt,
+	}
+}
+
+func silenceFromInput(input model.SilenceInput) (*repository.Silence, error) {
+	silence := &repository.Silence{
+		Matchers: make(map[string]string, len(input.Matchers)),
+		Comment:  input.Comment,
+		StartsAt: time.Now(),
+	}
+
+	for _, matcher := range input.Matchers {
+		silence.Matchers[strings.ToLower(string(matcher.Label))] = matcher.Pattern
+	}
+
+	if input.StartsAt != nil {
+		silence.StartsAt = *input.StartsAt
+	}
+
+	switch {
+	case input.EndsAt != nil:
+		silence.EndsAt = *input.EndsAt
+	case input.Duration != nil:
+		duration, err := time.ParseDuration(*input.Duration)
+		if err != nil {
+			return nil, fmt.Errorf("invalid duration: %w", err)
+		}
+		silence.EndsAt = silence.StartsAt.Add(duration)
+	default:
+		return nil, fmt.Errorf("either endsAt or duration is required")
+	}
+
+	return silence, nil
+}
+
+func toModelMaintenanceWindow(window *repository.MaintenanceWindow) *model.MaintenanceWindow {
+	return &model.MaintenanceWindow{
+		ID:              window.ID.String(),
+		Name:            window.Name,
+		Target:          window.Target,
+		StartsAt:        window.StartsAt,
+		Duration:        (time.Duration(window.DurationMinutes) * time.Minute).String(),
+		DurationMinutes: window.DurationMinutes,
+		Recurrence:      model.MaintenanceRecurrence(window.Recurrence),
+		Timezone:        window.Timezone,
+		Until:           window.Until,
+		Enabled:         window.Enabled,
+		ActiveNow:       service.MaintenanceWindowActive(window, time.Now()),
+		CreatedBy:       uuidString(window.CreatedBy),
+		CreatedAt:       window.CreatedAt,
+		UpdatedAt:       window.UpdatedAt,
+	}
+}
+
+func maintenanceWindowFromInput(input model.MaintenanceWindowInput) (*repository.MaintenanceWindow, error) {
+	duration, err := time.ParseDuration(input.Duration)
+	if err != nil {
+		return nil, fmt.Errorf("invalid duration: %w", err)
+	}
+
+	window := &repository.MaintenanceWindow{
+		Name:            input.Name,
+		Target:          input.Target,
+		StartsAt:        input.StartsAt,
+		DurationMinutes: int(duration.Minutes()),
+		Recurrence:      string(input.Recurrence),
+		Timezone:        derefString(input.Timezone),
+		Until:           input.Until,
+		Enabled:         true,
+	}
+
+	if input.Enabled != nil {
+		window.Enabled = *input.Enabled
+	}
+
+	return window, nil
+}
+
+func uuidString(id *uuid.UUID) *string {
+	if id == nil {
+		return nil
+	}
+	s := id.String()
+	return &s
+}
+
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func optionalInt(i int) *int {
+	if i == 0 {
+		return nil
+	}
+	return &i
+}
+
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func toModelAshBreakdowns(breakdown []*service.ASHBreakdown) []*model.AshBreakdown {
+	result := make([]*model.AshBreakdown, len(breakdown))
+	for i, b := range breakdown {
+		result[i] = &model.AshBreakdown{
+			Key:               b.Key,
+			Label:             b.Label,
+			Samples:           b.Samples,
+			DbTimeSeconds:     b.DBTimeSeconds,
+			AvgActiveSessions: b.AvgActiveSessions,
+			Percentage:        b.Percentage,
+		}
+	}
+	return result
+}
+
+func toModelOracleSession(session *service.OracleSession) *model.OracleSession {
+	return &model.OracleSession{
+		Sid:             session.SID,
+		Serial:          session.Serial,
+		Username:        session.Username,
+		SchemaName:      session.SchemaName,
+		OsUser:          session.OSUser,
+		Machine:         session.Machine,
+		Program:         session.Program,
+		Status:          model.SessionStatus(session.Status),
+		SQLID:           session.SQLID,
+		SQLText:         session.SQLText,
+		LogonTime:       session.LogonTime,
+		LastCallSeconds: session.LastCallET,
+		BlockingSession: session.BlockingSession,
+		WaitClass:       session.WaitClass,
+		Event:           session.Event,
+		SecondsInWait:   session.SecondsInWait,
+		InstID:          session.InstID,
+		ConID:           session.ConID,
+	}
+}
+
+// sessionListOptions maps session listing arguments to service options,
+// sorting by defaultSort (descending) when no sort is given
+func sessionListOptions(filter *model.SessionFilterInput, sortInput *model.SessionSortInput, limit, offset *int, after *string, defaultSort string) service.SessionListOptions {
+	opts := service.SessionListOptions{
+		SortBy:     defaultSort,
+		Descending: true,
+		Limit:      limitOrDefault(limit, service.DefaultSessionPageSize),
+		Cursor:     derefString(after),
+	}
+	if offset != nil {
+		opts.Offset = *offset
+	}
+	if sortInput != nil {
+		opts.SortBy = string(sortInput.Field)
+		opts.Descending = sortInput.Descending != nil && *sortInput.Descending
+	}
+	if filter != nil {
+		opts.Filter = oracle.SessionFilter{
+			SchemaName: derefString(filter.SchemaName),
+			Username:   derefString(filter.Username),
+			Machine:    derefString(filter.Machine),
+			Program:    derefString(filter.Program),
+			Module:     derefString(filter.Module),
+			WaitClass:  derefString(filter.WaitClass),
+		}
+		if filter.Status != nil {
+			opts.Filter.Status = string(*filter.Status)
+		}
+		if filter.MinIdleSeconds != nil {
+			opts.Filter.MinIdleSeconds = *filter.MinIdleSeconds
+		}
+		if filter.InstID != nil {
+			opts.Filter.InstID = *filter.InstID
+		}
+	}
+	return opts
+}
+
+func toModelSessionPage(page *service.SessionPage) *model.SessionPage {
+	result := &model.SessionPage{
+		Sessions:   make([]*model.OracleSession, len(page.Sessions)),
+		TotalCount: page.TotalCount,
+		NextCursor: page.NextCursor,
+	}
+	for i, session := range page.Sessions {
+		result.Sessions[i] = toModelOracleSession(session)
+	}
+	return result
+}
+
+func toModelSessionDetail(detail *service.SessionDetail) *model.SessionDetail {
+	result := &model.SessionDetail{
+		Session:          toModelOracleSession(detail.Session),
+		State:            detail.State,
+		ClientIdentifier: detail.ClientIdentifier,
+		ClientInfo:       detail.ClientInfo,
+		Module:           detail.Module,
+		Action:           detail.Action,
+		ServiceName:      detail.ServiceName,
+		Terminal:         detail.Terminal,
+		OsProcessID:      detail.OSProcessID,
+		SQLExecStart:     detail.SQLExecStart,
+		CurrentSQL:       toModelSessionSQL(detail.CurrentSQL),
+		PreviousSQL:      toModelSessionSQL(detail.PreviousSQL),
+		Statistics: &model.SessionStatistics{
+			CPUSeconds:        detail.Statistics.CPUSeconds,
+			LogicalReads:      detail.Statistics.LogicalReads,
+			PhysicalReads:     detail.Statistics.PhysicalReads,
+			PgaMemoryBytes:    detail.Statistics.PGAMemoryBytes,
+			PgaMemoryMaxBytes: detail.Statistics.PGAMemoryMaxBytes,
+			OpenCursors:       detail.Statistics.OpenCursors,
+		},
+		OpenCursors: make([]*model.SessionCursor, len(detail.OpenCursors)),
+		Locks:       make([]*model.SessionLock, len(detail.Locks)),
+		WaitHistory: make([]*model.SessionWait, len(detail.WaitHistory)),
+	}
+
+	for i, c := range detail.OpenCursors {
+		result.OpenCursors[i] = &model.SessionCursor{
+			SQLID:          c.SQLID,
+			SQLText:        c.SQLText,
+			CursorType:     c.CursorType,
+			LastActiveTime: c.LastActiveTime,
+		}
+	}
+	for i, l := range detail.Locks {
+		result.Locks[i] = &model.SessionLock{
+			Type:          l.Type,
+			Mode:          l.Mode,
+			RequestedMode: l.RequestedMode,
+			ID1:           l.ID1,
+			ID2:           l.ID2,
+			HeldSeconds:   l.HeldSeconds,
+			Blocking:      l.Blocking,
+			ObjectOwner:   l.ObjectOwner,
+			ObjectName:    l.ObjectName,
+			ObjectType:    l.ObjectType,
+		}
+	}
+	for i, w := range detail.WaitHistory {
+		result.WaitHistory[i] = &model.SessionWait{
+			Sequence:               w.Sequence,
+			Event:                  w.Event,
+			WaitClass:              w.WaitClass,
+			WaitTimeMicro:          w.WaitTimeMicro,
+			TimeSinceLastWaitMicro: w.TimeSinceLastWaitMicro,
+			Parameters:             w.Parameters,
+		}
+	}
+
+	return result
+}
+
+func toModelSessionSQL(sessionSQL *service.SessionSQL) *model.SessionSQL {
+	if sessionSQL == nil {
+		return nil
+	}
+	return &model.SessionSQL{
+		SQLID:       sessionSQL.SQLID,
+		ChildNumber: sessionSQL.ChildNumber,
+		FullText:    sessionSQL.FullText,
+	}
+}
+
+func toModelLongOperation(op *service.LongOperation) *model.LongOperation {
+	result := &model.LongOperation{
+		Sid:               op.SID,
+		Serial:            op.Serial,
+		OpName:            op.OpName,
+		Target:            op.Target,
+		TargetDescription: op.TargetDesc,
+		SoFar:             op.SoFar,
+		TotalWork:         op.TotalWork,
+		Units:             op.Units,
+		PercentComplete:   op.PercentComplete,
+		ElapsedSeconds:    op.ElapsedSeconds,
+		RemainingSeconds:  op.RemainingSeconds,
+		StartTime:         op.StartTime,
+		LastUpdateTime:    op.LastUpdateTime,
+		Message:           op.Message,
+		SQLID:             op.SQLID,
+		InstID:            op.InstID,
+		Completed:         op.Completed(),
+	}
+	if op.Session != nil {
+		result.Session = toModelOracleSession(op.Session)
+	}
+	return result
+}
+
+func toModelTablespace(ts *service.Tablespace) *model.Tablespace {
+	datafiles := make([]*model.Datafile, len(ts.Datafiles))
+	for i, df := range ts.Datafiles {
+		datafiles[i] = &model.Datafile{
+			FileID:         df.FileID,
+			FileName:       df.FileName,
+			TablespaceName: df.Tablespace,
+			Status:         df.Status,
+			SizeMb:         df.SizeMB,
+			UsedMb:         df.UsedMB,
+			Autoextensible: df.Autoextensible,
+			MaxSizeMb:      df.MaxSizeMB,
+			IncrementMb:    df.IncrementMB,
+			Temporary:      df.Temporary,
+			DiskGroup:      df.DiskGroup,
+			ConID:          df.ConID,
+		}
+	}
+
+	return &model.Tablespace{
+		Name:               ts.Name,
+		TotalSizeMb:        ts.TotalSizeMB,
+		UsedSizeMb:         ts.UsedSizeMB,
+		FreeSizeMb:         ts.FreeSizeMB,
+		UsagePercentage:    ts.UsagePercentage,
+		MaxSizeMb:          ts.MaxSizeMB,
+		MaxUsagePercentage: ts.MaxUsagePercentage,
+		Autoextensible:     ts.Autoextensible,
+		Status:             ts.Status,
+		Contents:           model.TablespaceContents(ts.Contents),
+		DatafileCount:      ts.DatafileCount,
+		ConID:              ts.ConID,
+		Pdb:                ts.PDB,
+		Datafiles:          datafiles,
+	}
+}
+
+func toModelSegment(seg *service.Segment) *model.Segment {
+	return &model.Segment{
+		Owner:          seg.Owner,
+		SegmentName:    seg.SegmentName,
+		PartitionName:  seg.PartitionName,
+		SegmentType:    seg.SegmentType,
+		TablespaceName: seg.TablespaceName,
+		SizeMb:         seg.SizeMB,
+		Extents:        seg.Extents,
+		Blocks:         seg.Blocks,
+		ConID:          seg.ConID,
+	}
+}
+
+func toModelSegmentGrowth(g *repository.SegmentGrowth) *model.SegmentGrowth {
+	growth := &model.SegmentGrowth{
+		Owner:          g.Owner,
+		SegmentName:    g.SegmentName,
+		PartitionName:  g.PartitionName,
+		SegmentType:    g.SegmentType,
+		TablespaceName: g.TablespaceName,
+		StartTime:      g.StartTime,
+		StartSizeMb:    g.StartSizeMB,
+		EndTime:        g.EndTime,
+		EndSizeMb:      g.EndSizeMB,
+		GrowthMb:       g.GrowthMB,
+	}
+	if days := g.EndTime.Sub(g.StartTime).Hours() / 24; days > 0 {
+		rate := g.GrowthMB / days
+		growth.GrowthRateMbPerDay = &rate
+	}
+	return growth
+}
+
+func toModelASMDiskGroup(g *service.ASMDiskGroup) *model.AsmDiskGroup {
+	disks := make([]*model.AsmDisk, len(g.Disks))
+	for i, d := range g.Disks {
+		disks[i] = &model.AsmDisk{
+			DiskNumber:   d.DiskNumber,
+			Name:         d.Name,
+			Path:         d.Path,
+			FailGroup:    d.FailGroup,
+			MountStatus:  d.MountStatus,
+			HeaderStatus: d.HeaderStatus,
+			ModeStatus:   d.ModeStatus,
+			State:        d.State,
+			TotalMb:      d.TotalMB,
+			FreeMb:       d.FreeMB,
+		}
+	}
+
+	return &model.AsmDiskGroup{
+		GroupNumber:          g.GroupNumber,
+		Name:                 g.Name,
+		State:                g.State,
+		Redundancy:           g.Redundancy,
+		TotalMb:              g.TotalMB,
+		FreeMb:               g.FreeMB,
+		UsableFileMb:         g.UsableFileMB,
+		RequiredMirrorFreeMb: g.RequiredMirrorFreeMB,
+		UsagePercentage:      g.UsagePercentage,
+		OfflineDisks:         g.OfflineDisks,
+		Disks:                disks,
+		Tablespaces:          g.Tablespaces,
+	}
+}
+
+func toModelTempUsage(usage []*service.TempUsage) []*model.TempUsage {
+	result := make([]*model.TempUsage, len(usage))
+	for i, u := range usage {
+		result[i] = &model.TempUsage{
+			Sid:         u.SID,
+			Serial:      u.Serial,
+			Username:    u.Username,
+			SQLID:       u.SQLID,
+			SQLText:     u.SQLText,
+			Tablespace:  u.Tablespace,
+			SegmentType: u.SegmentType,
+			UsedMb:      u.UsedMB,
+		}
+	}
+	return result
+}
+
+func toModelUndoSummary(summary *service.UndoSummary) *model.UndoSummary {
+	history := make([]*model.UndoStat, len(summary.History))
+	for i, u := range summary.History {
+		history[i] = &model.UndoStat{
+			BeginTime:                 u.BeginTime,
+			EndTime:                   u.EndTime,
+			UndoBlocks:                u.UndoBlocks,
+			TransactionCount:          u.TransactionCount,
+			MaxQuerySeconds:           u.MaxQuerySeconds,
+			MaxQuerySQLID:             u.MaxQuerySQLID,
+			TunedUndoRetentionSeconds: u.TunedUndoRetentionSeconds,
+			ActiveBlocks:              u.ActiveBlocks,
+			UnexpiredBlocks:           u.UnexpiredBlocks,
+			ExpiredBlocks:             u.ExpiredBlocks,
+			UnexpiredStealCount:       u.UnexpiredStealCount,
+			SnapshotTooOldCount:       u.SnapshotTooOldCount,
+			NoSpaceCount:              u.NoSpaceCount,
+		}
+	}
+
+	return &model.UndoSummary{
+		UndoTablespace:            summary.UndoTablespace,
+		UndoRetentionSeconds:      summary.UndoRetentionSeconds,
+		TunedUndoRetentionSeconds: summary.TunedUndoRetentionSeconds,
+		LongestQuerySeconds:       summary.LongestQuerySeconds,
+		LongestQuerySQLID:         summary.LongestQuerySQLID,
+		SnapshotTooOldCount:       summary.SnapshotTooOldCount,
+		NoSpaceCount:              summary.NoSpaceCount,
+		UnexpiredStealCount:       summary.UnexpiredStealCount,
+		Risk:                      model.UndoRisk(summary.Risk),
+		RiskReasons:               summary.RiskReasons,
+		History:                   history,
+	}
+}
+
+func toModelActiveTransactions(transactions []*service.ActiveTransaction) []*model.ActiveTransaction {
+	result := make([]*model.ActiveTransaction, len(transactions))
+	for i, t := range transactions {
+		result[i] = &model.ActiveTransaction{
+			Sid:            t.SID,
+			Serial:         t.Serial,
+			Username:       t.Username,
+			Program:        t.Program,
+			Status:         t.Status,
+			StartTime:      t.StartTime,
+			ElapsedSeconds: t.ElapsedSeconds,
+			UndoBlocks:     t.UndoBlocks,
+			UndoRecords:    t.UndoRecords,
+			UndoMb:         t.UndoMB,
+			SQLID:          t.SQLID,
+		}
+	}
+	return result
+}
+
+func toModelRedoSummary(summary *service.RedoSummary) *model.RedoSummary {
+	groups := make([]*model.RedoLogGroup, len(summary.Groups))
+	for i, g := range summary.Groups {
+		members := make([]*model.RedoLogMember, len(g.Members))
+		for j, m := range g.Members {
+			members[j] = &model.RedoLogMember{
+				Member: m.Member,
+				Type:   m.Type,
+				Status: m.Status,
+			}
+		}
+		groups[i] = &model.RedoLogGroup{
+			GroupNumber: g.GroupNumber,
+			Thread:      g.Thread,
+			Sequence:    int(g.Sequence),
+			SizeMb:      g.SizeMB,
+			MemberCount: g.MemberCount,
+			Archived:    g.Archived,
+			Status:      g.Status,
+			FirstTime:   g.FirstTime,
+			Members:     members,
+		}
+	}
+
+	switches := make([]*model.LogSwitchHour, len(summary.SwitchesPerHour))
+	for i, h := range summary.SwitchesPerHour {
+		switches[i] = &model.LogSwitchHour{
+			Hour:      h.Hour,
+			HourOfDay: h.Hour.Hour(),
+			Switches:  h.Switches,
+		}
+	}
+
+	archive := make([]*model.ArchiveDay, len(summary.ArchivePerDay))
+	for i, d := range summary.ArchivePerDay {
+		archive[i] = &model.ArchiveDay{
+			Day:          d.Day,
+			ArchivedLogs: d.ArchivedLogs,
+			SizeMb:       d.SizeMB,
+		}
+	}
+
+	return &model.RedoSummary{
+		LogMode:              summary.LogMode,
+		Groups:               groups,
+		SwitchesPerHour:      switches,
+		ArchivePerDay:        archive,
+		MaxSwitchesPerHour:   summary.MaxSwitchesPerHour,
+		PeakSwitchesPerHour:  summary.PeakSwitchesPerHour,
+		HoursOverThreshold:   summary.HoursOverThreshold,
+		Undersized:           summary.Undersized,
+		PendingArchiveGroups: summary.PendingArchiveGroups,
+		ArchivingBehind:      summary.ArchivingBehind,
+		Flags:                summary.Flags,
+	}
+}
+
+func toModelRecoveryArea(area *service.RecoveryArea) *model.RecoveryArea {
+	fileTypes := make([]*model.RecoveryAreaFileType, len(area.FileTypes))
+	for i, ft := range area.FileTypes {
+		fileTypes[i] = &model.RecoveryAreaFileType{
+			FileType:                ft.FileType,
+			PercentSpaceUsed:        ft.PercentSpaceUsed,
+			PercentSpaceReclaimable: ft.PercentSpaceReclaimable,
+			UsedMb:                  ft.UsedMB,
+			ReclaimableMb:           ft.ReclaimableMB,
+			FileCount:               ft.FileCount,
+		}
+	}
+
+	return &model.RecoveryArea{
+		Name:                     area.Name,
+		SpaceLimitMb:             area.SpaceLimitMB,
+		SpaceUsedMb:              area.SpaceUsedMB,
+		SpaceReclaimableMb:       area.SpaceReclaimableMB,
+		NonReclaimableMb:         area.NonReclaimableMB,
+		UsagePercentage:          area.UsagePercentage,
+		NonReclaimablePercentage: area.NonReclaimablePercentage,
+		FileCount:                area.FileCount,
+		FileTypes:                fileTypes,
+	}
+}
+
+func toModelRecoveryAreaMetric(metric *repository.RecoveryAreaMetric) *model.RecoveryAreaMetric {
+	nonReclaimable := metric.SpaceUsedMB - metric.SpaceReclaimableMB
+	result := &model.RecoveryAreaMetric{
+		CapturedAt:         metric.CapturedAt,
+		SpaceLimitMb:       metric.SpaceLimitMB,
+		SpaceUsedMb:        metric.SpaceUsedMB,
+		SpaceReclaimableMb: metric.SpaceReclaimableMB,
+		NonReclaimableMb:   nonReclaimable,
+		FileCount:          metric.FileCount,
+	}
+	if metric.SpaceLimitMB > 0 {
+		result.NonReclaimablePercentage = nonReclaimable / metric.SpaceLimitMB * 100
+	}
+	return result
+}
+
+func toModelBackupStatus(status *service.BackupStatus) *model.BackupStatus {
+	jobs := make([]*model.BackupJob, len(status.Jobs))
+	for i, job := range status.Jobs {
+		jobs[i] = &model.BackupJob{
+			SessionKey:       int(job.SessionKey),
+			CommandID:        job.CommandID,
+			StartTime:        job.StartTime,
+			EndTime:          job.EndTime,
+			Status:           job.Status,
+			InputType:        job.InputType,
+			OutputDeviceType: job.OutputDeviceType,
+			ElapsedSeconds:   job.ElapsedSeconds,
+			InputMb:          job.InputMB,
+			OutputMb:         job.OutputMB,
+			CompressionRatio: job.CompressionRatio,
+		}
+	}
+
+	sets := make([]*model.BackupSet, len(status.BackupSets))
+	for i, set := range status.BackupSets {
+		sets[i] = &model.BackupSet{
+			SetStamp:            int(set.SetStamp),
+			SetCount:            int(set.SetCount),
+			Type:                model.BackupSetType(set.Type),
+			IncrementalLevel:    set.IncrementalLevel,
+			Pieces:              set.Pieces,
+			AvailablePieces:     set.AvailablePieces,
+			StartTime:           set.StartTime,
+			CompletionTime:      set.CompletionTime,
+			ElapsedSeconds:      set.ElapsedSeconds,
+			ControlfileIncluded: set.ControlfileIncluded,
+			SizeMb:              set.SizeMB,
+		}
+	}
+
+	coverage := status.Coverage
+	uncovered := make([]*model.DatafileBackup, len(coverage.UncoveredDatafiles))
+	for i, df := range coverage.UncoveredDatafiles {
+		uncovered[i] = &model.DatafileBackup{
+			FileID:         df.FileID,
+			FileName:       df.FileName,
+			LastFullBackup: df.LastFullBackup,
+			AgeHours:       df.AgeHours,
+		}
+	}
+
+	return &model.BackupStatus{
+		Coverage: &model.BackupCoverage{
+			DatabaseCreated:             coverage.DatabaseCreated,
+			LogMode:                     coverage.LogMode,
+			LastFullBackup:              coverage.LastFullBackup,
+			LastIncrementalBackup:       coverage.LastIncrementalBackup,
+			LastArchivelogBackup:        coverage.LastArchivelogBackup,
+			LastControlfileBackup:       coverage.LastControlfileBackup,
+			LastSpfileBackup:            coverage.LastSpfileBackup,
+			FullBackupAgeHours:          coverage.FullBackupAgeHours,
+			ArchivelogBackupAgeHours:    coverage.ArchivelogBackupAgeHours,
+			FullBackupMaxAgeDays:        coverage.FullBackupMaxAgeDays,
+			ArchivelogBackupMaxAgeHours: coverage.ArchivelogBackupMaxAgeHours,
+			FullBackupOverdue:           coverage.FullBackupOverdue,
+			ArchivelogBackupOverdue:     coverage.ArchivelogBackupOverdue,
+			UncoveredDatafiles:          uncovered,
+			Issues:                      coverage.Issues,
+		},
+		Jobs:       jobs,
+		BackupSets: sets,
+	}
+}
+
+func toModelDataGuardStatus(status *service.DataGuardStatus) *model.DataGuardStatus {
+	destinations := make([]*model.ArchiveDestination, len(status.Destinations))
+	for i, d := range status.Destinations {
+		destinations[i] = &model.ArchiveDestination{
+			DestID:           d.DestID,
+			DestName:         d.DestName,
+			Status:           d.Status,
+			Type:             d.Type,
+			DatabaseMode:     d.DatabaseMode,
+			RecoveryMode:     d.RecoveryMode,
+			Destination:      d.Destination,
+			ArchivedThread:   d.ArchivedThread,
+			ArchivedSequence: int(d.ArchivedSeq),
+			AppliedThread:    d.AppliedThread,
+			AppliedSequence:  int(d.AppliedSeq),
+			GapStatus:        d.GapStatus,
+			Error:            d.Error,
+		}
+	}
+
+	processes := make([]*model.StandbyProcess, len(status.Processes))
+	for i, p := range status.Processes {
+		processes[i] = &model.StandbyProcess{
+			Process:       p.Process,
+			Pid:           p.PID,
+			Status:        p.Status,
+			ClientProcess: p.ClientProcess,
+			Thread:        p.Thread,
+			Sequence:      int(p.Sequence),
+			Block:         int(p.Block),
+			Blocks:        int(p.Blocks),
+		}
+	}
+
+	gaps := make([]*model.ArchiveGap, len(status.Gaps))
+	for i, g := range status.Gaps {
+		gaps[i] = &model.ArchiveGap{
+			Thread:       g.Thread,
+			LowSequence:  int(g.LowSequence),
+			HighSequence: int(g.HighSequence),
+		}
+	}
+
+	return &model.DataGuardStatus{
+		DbUniqueName:             status.DBUniqueName,
+		DatabaseRole:             status.DatabaseRole,
+		ProtectionMode:           status.ProtectionMode,
+		ProtectionLevel:          status.ProtectionLevel,
+		SwitchoverStatus:         status.SwitchoverStatus,
+		BrokerEnabled:            status.BrokerEnabled,
+		TransportLagSeconds:      status.TransportLagSeconds,
+		ApplyLagSeconds:          status.ApplyLagSeconds,
+		ApplyFinishSeconds:       status.ApplyFinishSeconds,
+		ApplyRateKbPerSec:        status.ApplyRateKBPerSec,
+		AverageApplyRateKbPerSec: status.AverageApplyRateKBPerSec,
+		Destinations:             destinations,
+		Processes:                processes,
+		Gaps:                     gaps,
+		GapDetected:              status.GapDetected,
+	}
+}
+
+func toModelParameter(p *service.Parameter) *model.Parameter {
+	return &model.Parameter{
+		Name:                 p.Name,
+		Value:                p.Value,
+		SpfileValue:          p.SPFileValue,
+		SpfileDiffers:        p.SPFileDiffers(),
+		IsDefault:            p.IsDefault,
+		ModifiedSinceStartup: p.ModifiedSinceStartup,
+		Deprecated:           p.Deprecated,
+		SessionModifiable:    p.SessionModifiable,
+		SystemModifiable:     model.ParameterSystemModifiable(p.SystemModifiable),
+		PdbModifiable:        p.PDBModifiable,
+		Description:          p.Description,
+	}
+}
+
+func toModelParameterChange(change *repository.ParameterChange) *model.ParameterChange {
+	return &model.ParameterChange{
+		Name:                change.Name,
+		CapturedAt:          change.CapturedAt,
+		Target:              change.Target,
+		Value:               change.Value,
+		SpfileValue:         change.SPFileValue,
+		IsDefault:           change.IsDefault,
+		PreviousValue:       change.PreviousValue,
+		PreviousSpfileValue: change.PreviousSPFileValue,
+	}
+}
+
+func toModelParameterDifference(diff *service.ParameterDifference) *model.ParameterDifference {
+	value := func(snapshot *repository.ParameterSnapshot) *model.ParameterValue {
+		if snapshot == nil {
+			return nil
+		}
+		return &model.ParameterValue{
+			CapturedAt:  snapshot.CapturedAt,
+			Target:      snapshot.Target,
+			Value:       snapshot.Value,
+			SpfileValue: snapshot.SPFileValue,
+			IsDefault:   snapshot.IsDefault,
+		}
+	}
+	return &model.ParameterDifference{Name: diff.Name, Base: value(diff.Base), Other: value(diff.Other)}
+}
+
+func toModelSQLPerformance(sp *service.SQLPerformance) *model.SQLPerformance {
+	result := &model.SQLPerformance{
+		SQLID:          sp.SQLID,
+		SQLText:        derefString(sp.SQLText),
+		SchemaName:     sp.ParsingSchema,
+		ParsingSchema:  sp.ParsingSchema,
+		Executions:     sp.Executions,
+		ElapsedTimeMs:  sp.ElapsedSeconds * 1000,
+		AvgElapsedMs:   sp.AvgElapsedSeconds * 1000,
+		CPUTimeMs:      sp.CPUSeconds * 1000,
+		DiskReads:      sp.DiskReads,
+		BufferGets:     sp.BufferGets,
+		RowsProcessed:  sp.RowsProcessed,
+		FirstLoadTime:  sp.FirstLoadTime,
+		LastActiveTime: sp.LastActiveTime,
+		InstID:         sp.InstID,
+		ConID:          sp.ConID,
+	}
+	if sp.Executions > 0 {
+		result.AvgCPUMs = result.CPUTimeMs / float64(sp.Executions)
+	}
+	if sp.PlanHashValue != nil {
+		planHashValue := int(*sp.PlanHashValue)
+		result.PlanHashValue = &planHashValue
+	}
+	return result
+}
+
+// toModelExecutionPlan returns the plan steps both as a flat list and linked
+// into a tree under the root operation
+func toModelExecutionPlan(plan *service.ExecutionPlan) *model.ExecutionPlan {
+	result := &model.ExecutionPlan{
+		SQLID:         plan.SQLID,
+		ChildNumber:   plan.ChildNumber,
+		PlanHashValue: int(plan.PlanHashValue),
+		Text:          plan.Text,
+		Steps:         make([]*model.PlanStep, len(plan.Steps)),
+	}
+
+	byID := make(map[int]*model.PlanStep, len(plan.Steps))
+	for i, step := range plan.Steps {
+		node := &model.PlanStep{
+			ID:               step.ID,
+			ParentID:         step.ParentID,
+			Depth:            step.Depth,
+			Operation:        step.Operation,
+			Options:          step.Options,
+			ObjectOwner:      step.ObjectOwner,
+			ObjectName:       step.ObjectName,
+			Cost:             step.Cost,
+			Cardinality:      step.Cardinality,
+			Bytes:            step.Bytes,
+			CPUCost:          step.CPUCost,
+			IoCost:           step.IOCost,
+			Time:             step.Time,
+			AccessPredicates: step.AccessPredicates,
+			FilterPredicates: step.FilterPredicates,
+			Children:         []*model.PlanStep{},
+		}
+		result.Steps[i] = node
+		byID[step.ID] = node
+	}
+
+	// Steps are ordered by ID, so children are appended in execution order
+	for _, node := range result.Steps {
+		if node.ParentID == nil {
+			if result.Tree == nil {
+				result.Tree = node
+			}
+			continue
+		}
+		if parent, ok := byID[*node.ParentID]; ok {
+			parent.Children = append(parent.Children, node)
+		}
+	}
+	if result.Tree == nil && len(result.Steps) > 0 {
+		result.Tree = result.Steps[0]
+	}
+
+	return result
+}
+
+func toModelSQLPlanChange(change *repository.SQLPlanChange) *model.SQLPlanChange {
+	return &model.SQLPlanChange{
+		ID:               change.ID.String(),
+		SQLID:            change.SQLID,
+		OldPlanHashValue: int(change.OldPlanHashValue),
+		NewPlanHashValue: int(change.NewPlanHashValue),
+		OldAvgElapsedMs:  change.OldAvgElapsedMS,
+		NewAvgElapsedMs:  change.NewAvgElapsedMS,
+		Regressed:        change.Regressed,
+		DetectedAt:       change.DetectedAt,
+	}
+}
+
+func toModelWaitEventStats(stats []*service.WaitEventStat) []*model.WaitEventStat {
+	result := make([]*model.WaitEventStat, len(stats))
+	for i, stat := range stats {
+		result[i] = &model.WaitEventStat{
+			Event:             stat.Event,
+			WaitClass:         stat.WaitClass,
+			Waits:             int(stat.Waits),
+			TimeWaitedSeconds: stat.TimeWaitedSeconds,
+			AvgWaitMs:         stat.AvgWaitMs,
+			PctDbTime:         stat.PctDBTime,
+		}
+	}
+	return result
+}
+
+func toModelDbTimeSummary(summary *service.DBTimeSummary) *model.DbTimeSummary {
+	return &model.DbTimeSummary{
+		IntervalSeconds:   summary.IntervalSeconds,
+		DbTimeSeconds:     summary.DBTimeSeconds,
+		DbCPUSeconds:      summary.DBCPUSeconds,
+		WaitTimeSeconds:   summary.WaitTimeSeconds,
+		CPUPercentage:     summary.CPUPercentage,
+		AvgActiveSessions: summary.AvgActiveSessions,
+	}
+}
+
+func toModelDbTimePoints(points []*service.DBTimePoint) []*model.DbTimePoint {
+	result := make([]*model.DbTimePoint, len(points))
+	for i, point := range points {
+		result[i] = &model.DbTimePoint{
+			CapturedAt:        point.CapturedAt,
+			IntervalSeconds:   point.IntervalSeconds,
+			DbTimeSeconds:     point.DBTimeSeconds,
+			DbCPUSeconds:      point.DBCPUSeconds,
+			AvgActiveSessions: point.AvgActiveSessions,
+		}
+	}
+	return result
+}
+
+func toModelSystemRatios(ratios *service.SystemRatios) *model.SystemRatios {
+	return &model.SystemRatios{
+		IntervalSeconds:          ratios.IntervalSeconds,
+		BufferCacheHitPercentage: ratios.BufferCacheHitPercentage,
+		SoftParsePercentage:      ratios.SoftParsePercentage,
+		ParseToExecuteRatio:      ratios.ParseToExecuteRatio,
+		ExecuteToParsePercentage: ratios.ExecuteToParsePercentage,
+		ExecutesPerSecond:        ratios.ExecutesPerSecond,
+		HardParsesPerSecond:      ratios.HardParsesPerSecond,
+		LogicalReadsPerSecond:    ratios.LogicalReadsPerSecond,
+	}
+}
+
+func toModelMemorySummary(summary *service.MemorySummary) *model.MemorySummary {
+	sga := make([]*model.SgaComponent, len(summary.SGAComponents))
+	for i, c := range summary.SGAComponents {
+		sga[i] = &model.SgaComponent{Name: c.Name, SizeMb: c.SizeMB, Resizeable: c.Resizeable}
+	}
+
+	dynamic := make([]*model.SgaDynamicComponent, len(summary.DynamicComponents))
+	for i, c := range summary.DynamicComponents {
+		dynamic[i] = &model.SgaDynamicComponent{
+			Component:           c.Component,
+			CurrentSizeMb:       c.CurrentSizeMB,
+			MinSizeMb:           c.MinSizeMB,
+			MaxSizeMb:           c.MaxSizeMB,
+			UserSpecifiedSizeMb: c.UserSpecifiedSizeMB,
+			OperationCount:      c.OperationCount,
+			LastOperationType:   c.LastOperationType,
+			LastOperationMode:   c.LastOperationMode,
+			LastOperationTime:   c.LastOperationTime,
+			GranuleSizeMb:       c.GranuleSizeMB,
+		}
+	}
+
+	pga := make([]*model.PgaStat, len(summary.PGAStats))
+	for i, stat := range summary.PGAStats {
+		pga[i] = &model.PgaStat{Name: stat.Name, Value: stat.Value, Unit: stat.Unit}
+	}
+
+	return &model.MemorySummary{
+		SgaComponents:         sga,
+		DynamicComponents:     dynamic,
+		PgaStats:              pga,
+		SgaMaxSizeMb:          summary.SGAMaxSizeMB,
+		SgaFreeMb:             summary.SGAFreeMB,
+		PgaTargetMb:           summary.PGATargetMB,
+		PgaAllocatedMb:        summary.PGAAllocatedMB,
+		PgaInUseMb:            summary.PGAInUseMB,
+		PgaMaxAllocatedMb:     summary.PGAMaxAllocatedMB,
+		PgaCacheHitPercentage: summary.PGACacheHitPercentage,
+		PgaOverAllocations:    int(summary.PGAOverAllocations),
+	}
+}
+
+func toModelProcessMemory(p *service.ProcessMemory) *model.ProcessMemory {
+	categories := make([]*model.ProcessMemoryCategory, len(p.Categories))
+	for i, c := range p.Categories {
+		categories[i] = &model.ProcessMemoryCategory{
+			Category:       c.Category,
+			AllocatedMb:    c.AllocatedMB,
+			UsedMb:         c.UsedMB,
+			MaxAllocatedMb: c.MaxAllocatedMB,
+		}
+	}
+
+	return &model.ProcessMemory{
+		Pid:            p.PID,
+		Spid:           p.SPID,
+		Sid:            p.SID,
+		Serial:         p.Serial,
+		Username:       p.Username,
+		Program:        p.Program,
+		UsedMb:         p.UsedMB,
+		AllocatedMb:    p.AllocatedMB,
+		FreeableMb:     p.FreeableMB,
+		MaxAllocatedMb: p.MaxAllocatedMB,
+		Categories:     categories,
+	}
+}
+
+func toModelMemoryResizeOperation(op *service.MemoryResizeOperation) *model.MemoryResizeOperation {
+	return &model.MemoryResizeOperation{
+		Component:     op.Component,
+		OperationType: op.OperationType,
+		OperationMode: op.OperationMode,
+		Parameter:     op.Parameter,
+		InitialSizeMb: op.InitialSizeMB,
+		TargetSizeMb:  op.TargetSizeMB,
+		FinalSizeMb:   op.FinalSizeMB,
+		Status:        op.Status,
+		StartTime:     op.StartTime,
+		EndTime:       op.EndTime,
+	}
+}
+
+func toModelMemoryAdvice(advice *service.MemoryAdvice) *model.MemoryAdvice {
+	sga := make([]*model.SgaTargetAdvice, len(advice.SGATarget))
+	for i, a := range advice.SGATarget {
+		sga[i] = &model.SgaTargetAdvice{
+			SgaSizeMb:              a.SGASizeMB,
+			SizeFactor:             a.SizeFactor,
+			EstimatedDbTime:        a.EstimatedDBTime,
+			EstimatedDbTimeFactor:  a.EstimatedDBTimeFactor,
+			EstimatedPhysicalReads: int(a.EstimatedPhysicalReads),
+		}
+	}
+
+	pga := make([]*model.PgaTargetAdvice, len(advice.PGATarget))
+	for i, a := range advice.PGATarget {
+		pga[i] = &model.PgaTargetAdvice{
+			PgaTargetMb:                 a.PGATargetMB,
+			TargetFactor:                a.TargetFactor,
+			EstimatedCacheHitPercentage: a.EstimatedCacheHitPercentage,
+			EstimatedOverAllocations:    int(a.EstimatedOverAllocations),
+			EstimatedExtraMbReadWritten: a.EstimatedExtraMBReadWritten,
+		}
+	}
+
+	cache := make([]*model.DbCacheAdviceSeries, len(advice.DBCache))
+	for i, series := range advice.DBCache {
+		points := make([]*model.DbCacheAdvice, len(series.Points))
+		for j, a := range series.Points {
+			points[j] = &model.DbCacheAdvice{
+				SizeMb:                      a.SizeMB,
+				SizeFactor:                  a.SizeFactor,
+				EstimatedPhysicalReadFactor: a.EstimatedPhysicalReadFactor,
+				EstimatedPhysicalReads:      int(a.EstimatedPhysicalReads),
+				EstimatedReadTimeSeconds:    a.EstimatedReadTimeSeconds,
+			}
+		}
+		cache[i] = &model.DbCacheAdviceSeries{Name: series.Name, BlockSize: series.BlockSize, Points: points}
+	}
+
+	return &model.MemoryAdvice{SgaTarget: sga, PgaTarget: pga, DbCache: cache}
+}
+
+func toModelTableStatistics(t *service.TableStatistics) *model.TableStatistics {
+	return &model.TableStatistics{
+		Owner:           t.Owner,
+		TableName:       t.TableName,
+		NumRows:         t.NumRows,
+		LastAnalyzed:    t.LastAnalyzed,
+		Status:          model.StatisticsStatus(t.Status),
+		Locked:          t.Locked,
+		LockType:        t.LockType,
+		Inserts:         t.Inserts,
+		Updates:         t.Updates,
+		Deletes:         t.Deletes,
+		Truncated:       t.Truncated,
+		LastModified:    t.LastModified,
+		PercentModified: t.PercentModified,
+		ConID:           t.ConID,
+	}
+}
+
+func toModelAutoStatsJobHistory(history *service.AutoStatsJobHistory) *model.AutoStatsJobHistory {
+	runs := make([]*model.AutoStatsJobRun, len(history.Runs))
+	for i, run := range history.Runs {
+		runs[i] = &model.AutoStatsJobRun{
+			WindowName:      run.WindowName,
+			WindowStartTime: run.WindowStartTime,
+			JobName:         run.JobName,
+			Status:          run.Status,
+			StartTime:       run.StartTime,
+			DurationSeconds: run.DurationSeconds,
+			ErrorCode:       run.ErrorCode,
+			Info:            run.Info,
+		}
+	}
+	return &model.AutoStatsJobHistory{Enabled: history.Enabled, Runs: runs}
+}
+
+func toModelIndexAnalysis(analysis *service.IndexAnalysis) *model.IndexAnalysis {
+	schemas := make([]*model.SchemaIndexFindings, len(analysis.Schemas))
+	for i, schema := range analysis.Schemas {
+		findings := make([]*model.IndexFinding, len(schema.Findings))
+		for j, f := range schema.Findings {
+			findings[j] = &model.IndexFinding{
+				Type:             model.IndexFindingType(f.Type),
+				Priority:         model.IndexFindingPriority(f.Priority),
+				Owner:            f.Owner,
+				TableName:        f.TableName,
+				IndexName:        optionalString(f.IndexName),
+				ConstraintName:   optionalString(f.ConstraintName),
+				Columns:          f.Columns,
+				RelatedIndexName: optionalString(f.RelatedIndexName),
+				Description:      f.Description,
+				Ddl:              f.DDL,
+			}
+		}
+		schemas[i] = &model.SchemaIndexFindings{
+			SchemaName: schema.SchemaName,
+			ConID:      schema.ConID,
+			IndexCount: schema.IndexCount,
+			Findings:   findings,
+		}
+	}
+
+	usage := make([]*model.IndexUsage, len(analysis.Usage))
+	for i, u := range analysis.Usage {
+		usage[i] = &model.IndexUsage{
+			Owner:           u.Owner,
+			IndexName:       u.IndexName,
+			TableName:       u.TableName,
+			Used:            u.Used,
+			AccessCount:     u.AccessCount,
+			ExecutionCount:  u.ExecutionCount,
+			RowsReturned:    u.RowsReturned,
+			LastUsed:        u.LastUsed,
+			MonitoringSince: u.MonitoringSince,
+			ConID:           u.ConID,
+		}
+	}
+
+	return &model.IndexAnalysis{
+		UsageSource: optionalString(analysis.UsageSource),
+		Schemas:     schemas,
+		Usage:       usage,
+	}
+}
+
+func toModelInvalidObjects(objects []*service.InvalidObject) []*model.InvalidObject {
+	result := make([]*model.InvalidObject, len(objects))
+	for i, obj := range objects {
+		result[i] = &model.InvalidObject{
+			Owner:       obj.Owner,
+			ObjectName:  obj.ObjectName,
+			ObjectType:  obj.ObjectType,
+			Status:      obj.Status,
+			LastDdlTime: obj.LastDDLTime,
+			CreatedDate: obj.Created,
+			ConID:       obj.ConID,
+			Errors:      toModelCompileErrors(obj.Errors),
+		}
+	}
+	return result
+}
+
+func toModelRecompileResults(results []*service.RecompileResult) []*model.RecompileResult {
+	converted := make([]*model.RecompileResult, len(results))
+	for i, r := range results {
+		converted[i] = &model.RecompileResult{
+			Owner:        r.Owner,
+			ObjectName:   r.ObjectName,
+			ObjectType:   r.ObjectType,
+			StatusBefore: r.StatusBefore,
+			StatusAfter:  r.StatusAfter,
+			Error:        r.Error,
+			Errors:       toModelCompileErrors(r.Errors),
+		}
+	}
+	return converted
+}
+
+func toModelCompileErrors(errs []*service.CompileError) []*model.CompileError {
+	result := make([]*model.CompileError, len(errs))
+	for i, e := range errs {
+		result[i] = &model.CompileError{
+			Line:      e.Line,
+			Position:  e.Position,
+			Text:      e.Text,
+			Attribute: e.Attribute,
+		}
+	}
+	return result
+}
+
+// limitOrDefault returns an optional GraphQL limit argument or a default
+func limitOrDefault(limit *int, defaultLimit int) int {
+	if limit == nil || *limit <= 0 {
+		return defaultLimit
+	}
+	return *limit
+}
@@ -10,6 +10,156 @@ import (
 	"time"
 )
 
+type ActiveTransaction struct {
+	Sid            int        `json:"sid"`
+	Serial         int        `json:"serial"`
+	Username       *string    `json:"username,omitempty"`
+	Program        *string    `json:"program,omitempty"`
+	Status         string     `json:"status"`
+	StartTime      *time.Time `json:"startTime,omitempty"`
+	ElapsedSeconds int        `json:"elapsedSeconds"`
+	UndoBlocks     int        `json:"undoBlocks"`
+	UndoRecords    int        `json:"undoRecords"`
+	UndoMb         float64    `json:"undoMb"`
+	SQLID          *string    `json:"sqlId,omitempty"`
+}
+
+type Alert struct {
+	ID              string        `json:"id"`
+	RuleID          *string       `json:"ruleId,omitempty"`
+	RuleName        string        `json:"ruleName"`
+	Metric          AlertMetric   `json:"metric"`
+	Severity        AlertSeverity `json:"severity"`
+	Status          AlertStatus   `json:"status"`
+	Target          string        `json:"target"`
+	ObjectKey       string        `json:"objectKey"`
+	Value           float64       `json:"value"`
+	Threshold       float64       `json:"threshold"`
+	Message         string        `json:"message"`
+	FiredAt         time.Time     `json:"firedAt"`
+	AcknowledgedAt  *time.Time    `json:"acknowledgedAt,omitempty"`
+	AcknowledgedBy  *string       `json:"acknowledgedBy,omitempty"`
+	ResolvedAt      *time.Time    `json:"resolvedAt,omitempty"`
+	LastEvaluatedAt time.Time     `json:"lastEvaluatedAt"`
+}
+
+type AlertFilterInput struct {
+	RuleID   *string        `json:"ruleId,omitempty"`
+	Status   *AlertStatus   `json:"status,omitempty"`
+	Severity *AlertSeverity `json:"severity,omitempty"`
+	Target   *string        `json:"target,omitempty"`
+}
+
+type AlertNotification struct {
+	ID           string             `json:"id"`
+	AlertID      *string            `json:"alertId,omitempty"`
+	ChannelID    *string            `json:"channelId,omitempty"`
+	ChannelName  *string            `json:"channelName,omitempty"`
+	Event        string             `json:"event"`
+	Status       NotificationStatus `json:"status"`
+	Attempts     int                `json:"attempts"`
+	Error        *string            `json:"error,omitempty"`
+	Reason       *string            `json:"reason,omitempty"`
+	SuppressedBy *string            `json:"suppressedBy,omitempty"`
+	CreatedAt    time.Time          `json:"createdAt"`
+}
+
+type AlertRule struct {
+	ID              string         `json:"id"`
+	Name            string         `json:"name"`
+	Description     *string        `json:"description,omitempty"`
+	Metric          AlertMetric    `json:"metric"`
+	Condition       AlertCondition `json:"condition"`
+	Threshold       float64        `json:"threshold"`
+	Duration        string         `json:"duration"`
+	DurationSeconds int            `json:"durationSeconds"`
+	Severity        AlertSeverity  `json:"severity"`
+	TargetFilter    *string        `json:"targetFilter,omitempty"`
+	ObjectFilter    *string        `json:"objectFilter,omitempty"`
+	Enabled         bool           `json:"enabled"`
+	CreatedBy       *string        `json:"createdBy,omitempty"`
+	CreatedAt       time.Time      `json:"createdAt"`
+	UpdatedAt       time.Time      `json:"updatedAt"`
+}
+
+type AlertRuleInput struct {
+	Name         string         `json:"name"`
+	Description  *string        `json:"description,omitempty"`
+	Metric       AlertMetric    `json:"metric"`
+	Condition    AlertCondition `json:"condition"`
+	Threshold    float64        `json:"threshold"`
+	Duration     *string        `json:"duration,omitempty"`
+	Severity     AlertSeverity  `json:"severity"`
+	TargetFilter *string        `json:"targetFilter,omitempty"`
+	ObjectFilter *string        `json:"objectFilter,omitempty"`
+	Enabled      *bool          `json:"enabled,omitempty"`
+}
+
+type ArchiveDay struct {
+	Day          time.Time `json:"day"`
+	ArchivedLogs int       `json:"archivedLogs"`
+	SizeMb       float64   `json:"sizeMb"`
+}
+
+type ArchiveDestination struct {
+	DestID           int     `json:"destId"`
+	DestName         string  `json:"destName"`
+	Status           string  `json:"status"`
+	Type             string  `json:"type"`
+	DatabaseMode     *string `json:"databaseMode,omitempty"`
+	RecoveryMode     *string `json:"recoveryMode,omitempty"`
+	Destination      *string `json:"destination,omitempty"`
+	ArchivedThread   int     `json:"archivedThread"`
+	ArchivedSequence int     `json:"archivedSequence"`
+	AppliedThread    int     `json:"appliedThread"`
+	AppliedSequence  int     `json:"appliedSequence"`
+	GapStatus        *string `json:"gapStatus,omitempty"`
+	Error            *string `json:"error,omitempty"`
+}
+
+type ArchiveGap struct {
+	Thread       int `json:"thread"`
+	LowSequence  int `json:"lowSequence"`
+	HighSequence int `json:"highSequence"`
+}
+
+type AshBreakdown struct {
+	Key               string  `json:"key"`
+	Label             *string `json:"label,omitempty"`
+	Samples           int     `json:"samples"`
+	DbTimeSeconds     float64 `json:"dbTimeSeconds"`
+	AvgActiveSessions float64 `json:"avgActiveSessions"`
+	Percentage        float64 `json:"percentage"`
+}
+
+type AsmDisk struct {
+	DiskNumber   int     `json:"diskNumber"`
+	Name         *string `json:"name,omitempty"`
+	Path         string  `json:"path"`
+	FailGroup    *string `json:"failGroup,omitempty"`
+	MountStatus  string  `json:"mountStatus"`
+	HeaderStatus string  `json:"headerStatus"`
+	ModeStatus   string  `json:"modeStatus"`
+	State        string  `json:"state"`
+	TotalMb      float64 `json:"totalMb"`
+	FreeMb       float64 `json:"freeMb"`
+}
+
+type AsmDiskGroup struct {
+	GroupNumber          int        `json:"groupNumber"`
+	Name                 string     `json:"name"`
+	State                string     `json:"state"`
+	Redundancy           string     `json:"redundancy"`
+	TotalMb              float64    `json:"totalMb"`
+	FreeMb               float64    `json:"freeMb"`
+	UsableFileMb         float64    `json:"usableFileMb"`
+	RequiredMirrorFreeMb float64    `json:"requiredMirrorFreeMb"`
+	UsagePercentage      float64    `json:"usagePercentage"`
+	OfflineDisks         int        `json:"offlineDisks"`
+	Disks                []*AsmDisk `json:"disks"`
+	Tablespaces          []string   `json:"tablespaces"`
+}
+
 type AuditLog struct {
 	ID              string      `json:"id"`
 	UserID          *string     `json:"userId,omitempty"`
@@ -42,6 +192,74 @@ type AuthPayload struct {
 	ExpiresAt time.Time `json:"expiresAt"`
 }
 
+type AutoStatsJobHistory struct {
+	Enabled bool               `json:"enabled"`
+	Runs    []*AutoStatsJobRun `json:"runs"`
+}
+
+type AutoStatsJobRun struct {
+	WindowName      *string    `json:"windowName,omitempty"`
+	WindowStartTime *time.Time `json:"windowStartTime,omitempty"`
+	JobName         string     `json:"jobName"`
+	Status          string     `json:"status"`
+	StartTime       *time.Time `json:"startTime,omitempty"`
+	DurationSeconds *float64   `json:"durationSeconds,omitempty"`
+	ErrorCode       *int       `json:"errorCode,omitempty"`
+	Info            *string    `json:"info,omitempty"`
+}
+
+type BackupCoverage struct {
+	DatabaseCreated             time.Time         `json:"databaseCreated"`
+	LogMode                     string            `json:"logMode"`
+	LastFullBackup              *time.Time        `json:"lastFullBackup,omitempty"`
+	LastIncrementalBackup       *time.Time        `json:"lastIncrementalBackup,omitempty"`
+	LastArchivelogBackup        *time.Time        `json:"lastArchivelogBackup,omitempty"`
+	LastControlfileBackup       *time.Time        `json:"lastControlfileBackup,omitempty"`
+	LastSpfileBackup            *time.Time        `json:"lastSpfileBackup,omitempty"`
+	FullBackupAgeHours          float64           `json:"fullBackupAgeHours"`
+	ArchivelogBackupAgeHours    *float64          `json:"archivelogBackupAgeHours,omitempty"`
+	FullBackupMaxAgeDays        int               `json:"fullBackupMaxAgeDays"`
+	ArchivelogBackupMaxAgeHours int               `json:"archivelogBackupMaxAgeHours"`
+	FullBackupOverdue           bool              `json:"fullBackupOverdue"`
+	ArchivelogBackupOverdue     bool              `json:"archivelogBackupOverdue"`
+	UncoveredDatafiles          []*DatafileBackup `json:"uncoveredDatafiles"`
+	Issues                      []string          `json:"issues"`
+}
+
+type BackupJob struct {
+	SessionKey       int        `json:"sessionKey"`
+	CommandID        *string    `json:"commandId,omitempty"`
+	StartTime        time.Time  `json:"startTime"`
+	EndTime          *time.Time `json:"endTime,omitempty"`
+	Status           string     `json:"status"`
+	InputType        string     `json:"inputType"`
+	OutputDeviceType *string    `json:"outputDeviceType,omitempty"`
+	ElapsedSeconds   *float64   `json:"elapsedSeconds,omitempty"`
+	InputMb          float64    `json:"inputMb"`
+	OutputMb         float64    `json:"outputMb"`
+	CompressionRatio *float64   `json:"compressionRatio,omitempty"`
+}
+
+type BackupSet struct {
+	SetStamp            int           `json:"setStamp"`
+	SetCount            int           `json:"setCount"`
+	Type                BackupSetType `json:"type"`
+	IncrementalLevel    *int          `json:"incrementalLevel,omitempty"`
+	Pieces              int           `json:"pieces"`
+	AvailablePieces     int           `json:"availablePieces"`
+	StartTime           time.Time     `json:"startTime"`
+	CompletionTime      time.Time     `json:"completionTime"`
+	ElapsedSeconds      float64       `json:"elapsedSeconds"`
+	ControlfileIncluded bool          `json:"controlfileIncluded"`
+	SizeMb              float64       `json:"sizeMb"`
+}
+
+type BackupStatus struct {
+	Coverage   *BackupCoverage `json:"coverage"`
+	Jobs       []*BackupJob    `json:"jobs"`
+	BackupSets []*BackupSet    `json:"backupSets"`
+}
+
 type BlockingSession struct {
 	BlockingSid            int           `json:"blockingSid"`
 	BlockingSerial         int           `json:"blockingSerial"`
@@ -58,6 +276,15 @@ type BlockingSession struct {
 	BlockedEvent           *string       `json:"blockedEvent,omitempty"`
 	BlockedDurationSeconds int           `json:"blockedDurationSeconds"`
 	BlockedSQLText         *string       `json:"blockedSqlText,omitempty"`
+	BlockingInstID         int           `json:"blockingInstId"`
+	BlockedInstID          int           `json:"blockedInstId"`
+}
+
+type CompileError struct {
+	Line      int    `json:"line"`
+	Position  int    `json:"position"`
+	Text      string `json:"text"`
+	Attribute string `json:"attribute"`
 }
 
 type CreateUserInput struct {
@@ -68,7 +295,32 @@ type CreateUserInput struct {
 	RoleIds  []string `json:"roleIds"`
 }
 
+type DataGuardLagMetric struct {
+	CapturedAt          time.Time `json:"capturedAt"`
+	TransportLagSeconds *float64  `json:"transportLagSeconds,omitempty"`
+	ApplyLagSeconds     *float64  `json:"applyLagSeconds,omitempty"`
+}
+
+type DataGuardStatus struct {
+	DbUniqueName             string                `json:"dbUniqueName"`
+	DatabaseRole             string                `json:"databaseRole"`
+	ProtectionMode           string                `json:"protectionMode"`
+	ProtectionLevel          string                `json:"protectionLevel"`
+	SwitchoverStatus         string                `json:"switchoverStatus"`
+	BrokerEnabled            bool                  `json:"brokerEnabled"`
+	TransportLagSeconds      *float64              `json:"transportLagSeconds,omitempty"`
+	ApplyLagSeconds          *float64              `json:"applyLagSeconds,omitempty"`
+	ApplyFinishSeconds       *float64              `json:"applyFinishSeconds,omitempty"`
+	ApplyRateKbPerSec        *float64              `json:"applyRateKbPerSec,omitempty"`
+	AverageApplyRateKbPerSec *float64              `json:"averageApplyRateKbPerSec,omitempty"`
+	Destinations             []*ArchiveDestination `json:"destinations"`
+	Processes                []*StandbyProcess     `json:"processes"`
+	Gaps                     []*ArchiveGap         `json:"gaps"`
+	GapDetected              bool                  `json:"gapDetected"`
+}
+
 type DatabaseInstance struct {
+	InstID         int       `json:"instId"`
 	InstanceName   string    `json:"instanceName"`
 	HostName       string    `json:"hostName"`
 	Version        string    `json:"version"`
@@ -86,13 +338,116 @@ type DatabaseSize struct {
 	UsagePercentage float64 `json:"usagePercentage"`
 }
 
+type Datafile struct {
+	FileID         int      `json:"fileId"`
+	FileName       string   `json:"fileName"`
+	TablespaceName string   `json:"tablespaceName"`
+	Status         string   `json:"status"`
+	SizeMb         float64  `json:"sizeMb"`
+	UsedMb         *float64 `json:"usedMb,omitempty"`
+	Autoextensible bool     `json:"autoextensible"`
+	MaxSizeMb      float64  `json:"maxSizeMb"`
+	IncrementMb    float64  `json:"incrementMb"`
+	Temporary      bool     `json:"temporary"`
+	DiskGroup      *string  `json:"diskGroup,omitempty"`
+	ConID          int      `json:"conId"`
+}
+
+type DatafileBackup struct {
+	FileID         int        `json:"fileId"`
+	FileName       string     `json:"fileName"`
+	LastFullBackup *time.Time `json:"lastFullBackup,omitempty"`
+	AgeHours       float64    `json:"ageHours"`
+}
+
+type DbCacheAdvice struct {
+	SizeMb                      float64  `json:"sizeMb"`
+	SizeFactor                  float64  `json:"sizeFactor"`
+	EstimatedPhysicalReadFactor *float64 `json:"estimatedPhysicalReadFactor,omitempty"`
+	EstimatedPhysicalReads      int      `json:"estimatedPhysicalReads"`
+	EstimatedReadTimeSeconds    *float64 `json:"estimatedReadTimeSeconds,omitempty"`
+}
+
+type DbCacheAdviceSeries struct {
+	Name      string           `json:"name"`
+	BlockSize int              `json:"blockSize"`
+	Points    []*DbCacheAdvice `json:"points"`
+}
+
+type DbTimePoint struct {
+	CapturedAt        time.Time `json:"capturedAt"`
+	IntervalSeconds   float64   `json:"intervalSeconds"`
+	DbTimeSeconds     float64   `json:"dbTimeSeconds"`
+	DbCPUSeconds      float64   `json:"dbCpuSeconds"`
+	AvgActiveSessions float64   `json:"avgActiveSessions"`
+}
+
+type DbTimeSummary struct {
+	IntervalSeconds   float64  `json:"intervalSeconds"`
+	DbTimeSeconds     float64  `json:"dbTimeSeconds"`
+	DbCPUSeconds      float64  `json:"dbCpuSeconds"`
+	WaitTimeSeconds   float64  `json:"waitTimeSeconds"`
+	CPUPercentage     *float64 `json:"cpuPercentage,omitempty"`
+	AvgActiveSessions float64  `json:"avgActiveSessions"`
+}
+
+type ExecutionPlan struct {
+	SQLID         string      `json:"sqlId"`
+	ChildNumber   int         `json:"childNumber"`
+	PlanHashValue int         `json:"planHashValue"`
+	Text          string      `json:"text"`
+	Steps         []*PlanStep `json:"steps"`
+	Tree          *PlanStep   `json:"tree"`
+}
+
+type GatherTableStatsInput struct {
+	Owner           string   `json:"owner"`
+	TableName       string   `json:"tableName"`
+	EstimatePercent *float64 `json:"estimatePercent,omitempty"`
+	Cascade         *bool    `json:"cascade,omitempty"`
+}
+
+type IndexAnalysis struct {
+	UsageSource *string                `json:"usageSource,omitempty"`
+	Schemas     []*SchemaIndexFindings `json:"schemas"`
+	Usage       []*IndexUsage          `json:"usage"`
+}
+
+type IndexFinding struct {
+	Type             IndexFindingType     `json:"type"`
+	Priority         IndexFindingPriority `json:"priority"`
+	Owner            string               `json:"owner"`
+	TableName        string               `json:"tableName"`
+	IndexName        *string              `json:"indexName,omitempty"`
+	ConstraintName   *string              `json:"constraintName,omitempty"`
+	Columns          []string             `json:"columns"`
+	RelatedIndexName *string              `json:"relatedIndexName,omitempty"`
+	Description      string               `json:"description"`
+	Ddl              string               `json:"ddl"`
+}
+
+type IndexUsage struct {
+	Owner           string     `json:"owner"`
+	IndexName       string     `json:"indexName"`
+	TableName       string     `json:"tableName"`
+	Used            bool       `json:"used"`
+	AccessCount     *int       `json:"accessCount,omitempty"`
+	ExecutionCount  *int       `json:"executionCount,omitempty"`
+	RowsReturned    *int       `json:"rowsReturned,omitempty"`
+	LastUsed        *time.Time `json:"lastUsed,omitempty"`
+	MonitoringSince *time.Time `json:"monitoringSince,omitempty"`
+	ConID           int        `json:"conId"`
+}
+
 type InvalidObject struct {
-	Owner       string     `json:"owner"`
-	ObjectName  string     `json:"objectName"`
-	ObjectType  string     `json:"objectType"`
-	Status      string     `json:"status"`
-	LastDdlTime *time.Time `json:"lastDdlTime,omitempty"`
-	CreatedDate *time.Time `json:"createdDate,omitempty"`
+	Owner       string          `json:"owner"`
+	ObjectName  string          `json:"objectName"`
+	ObjectType  string          `json:"objectType"`
+	Status      string          `json:"status"`
+	LastDdlTime *time.Time      `json:"lastDdlTime,omitempty"`
+	CreatedDate *time.Time      `json:"createdDate,omitempty"`
+	ConID       int             `json:"conId"`
+	Errors      []*CompileError `json:"errors"`
 }
 
 type LockInfo struct {
@@ -109,14 +464,145 @@ type LockInfo struct {
 	BlockingSession *int    `json:"blockingSession,omitempty"`
 }
 
+type LogSwitchHour struct {
+	Hour      time.Time `json:"hour"`
+	HourOfDay int       `json:"hourOfDay"`
+	Switches  int       `json:"switches"`
+}
+
 type LoginInput struct {
 	Username string `json:"username"`
 	Password string `json:"password"`
 }
 
+type LongOperation struct {
+	Sid               int            `json:"sid"`
+	Serial            int            `json:"serial"`
+	OpName            string         `json:"opName"`
+	Target            *string        `json:"target,omitempty"`
+	TargetDescription *string        `json:"targetDescription,omitempty"`
+	SoFar             int            `json:"soFar"`
+	TotalWork         int            `json:"totalWork"`
+	Units             *string        `json:"units,omitempty"`
+	PercentComplete   *float64       `json:"percentComplete,omitempty"`
+	ElapsedSeconds    int            `json:"elapsedSeconds"`
+	RemainingSeconds  *int           `json:"remainingSeconds,omitempty"`
+	StartTime         *time.Time     `json:"startTime,omitempty"`
+	LastUpdateTime    *time.Time     `json:"lastUpdateTime,omitempty"`
+	Message           *string        `json:"message,omitempty"`
+	SQLID             *string        `json:"sqlId,omitempty"`
+	InstID            int            `json:"instId"`
+	Completed         bool           `json:"completed"`
+	Session           *OracleSession `json:"session,omitempty"`
+}
+
+type MaintenanceWindow struct {
+	ID              string                `json:"id"`
+	Name            string                `json:"name"`
+	Target          string                `json:"target"`
+	StartsAt        time.Time             `json:"startsAt"`
+	Duration        string                `json:"duration"`
+	DurationMinutes int                   `json:"durationMinutes"`
+	Recurrence      MaintenanceRecurrence `json:"recurrence"`
+	Timezone        string                `json:"timezone"`
+	Until           *time.Time            `json:"until,omitempty"`
+	Enabled         bool                  `json:"enabled"`
+	ActiveNow       bool                  `json:"activeNow"`
+	CreatedBy       *string               `json:"createdBy,omitempty"`
+	CreatedAt       time.Time             `json:"createdAt"`
+	UpdatedAt       time.Time             `json:"updatedAt"`
+}
+
+type MaintenanceWindowInput struct {
+	Name       string                `json:"name"`
+	Target     string                `json:"target"`
+	StartsAt   time.Time             `json:"startsAt"`
+	Duration   string                `json:"duration"`
+	Recurrence MaintenanceRecurrence `json:"recurrence"`
+	Timezone   *string               `json:"timezone,omitempty"`
+	Until      *time.Time            `json:"until,omitempty"`
+	Enabled    *bool                 `json:"enabled,omitempty"`
+}
+
+type MemoryAdvice struct {
+	SgaTarget []*SgaTargetAdvice     `json:"sgaTarget"`
+	PgaTarget []*PgaTargetAdvice     `json:"pgaTarget"`
+	DbCache   []*DbCacheAdviceSeries `json:"dbCache"`
+}
+
+type MemoryResizeOperation struct {
+	Component     string     `json:"component"`
+	OperationType string     `json:"operationType"`
+	OperationMode *string    `json:"operationMode,omitempty"`
+	Parameter     *string    `json:"parameter,omitempty"`
+	InitialSizeMb float64    `json:"initialSizeMb"`
+	TargetSizeMb  float64    `json:"targetSizeMb"`
+	FinalSizeMb   float64    `json:"finalSizeMb"`
+	Status        string     `json:"status"`
+	StartTime     time.Time  `json:"startTime"`
+	EndTime       *time.Time `json:"endTime,omitempty"`
+}
+
+type MemorySummary struct {
+	SgaComponents         []*SgaComponent        `json:"sgaComponents"`
+	DynamicComponents     []*SgaDynamicComponent `json:"dynamicComponents"`
+	PgaStats              []*PgaStat             `json:"pgaStats"`
+	SgaMaxSizeMb          float64                `json:"sgaMaxSizeMb"`
+	SgaFreeMb             float64                `json:"sgaFreeMb"`
+	PgaTargetMb           float64                `json:"pgaTargetMb"`
+	PgaAllocatedMb        float64                `json:"pgaAllocatedMb"`
+	PgaInUseMb            float64                `json:"pgaInUseMb"`
+	PgaMaxAllocatedMb     float64                `json:"pgaMaxAllocatedMb"`
+	PgaCacheHitPercentage *float64               `json:"pgaCacheHitPercentage,omitempty"`
+	PgaOverAllocations    int                    `json:"pgaOverAllocations"`
+}
+
 type Mutation struct {
 }
 
+type NotificationChannel struct {
+	ID              string                  `json:"id"`
+	Name            string                  `json:"name"`
+	Type            NotificationChannelType `json:"type"`
+	URL             *string                 `json:"url,omitempty"`
+	HasSecret       bool                    `json:"hasSecret"`
+	SMTPHost        *string                 `json:"smtpHost,omitempty"`
+	SMTPPort        *int                    `json:"smtpPort,omitempty"`
+	SMTPUsername    *string                 `json:"smtpUsername,omitempty"`
+	From            *string                 `json:"from,omitempty"`
+	To              []string                `json:"to"`
+	SubjectTemplate *string                 `json:"subjectTemplate,omitempty"`
+	BodyTemplate    *string                 `json:"bodyTemplate,omitempty"`
+	MinSeverity     AlertSeverity           `json:"minSeverity"`
+	Enabled         bool                    `json:"enabled"`
+	CreatedBy       *string                 `json:"createdBy,omitempty"`
+	CreatedAt       time.Time               `json:"createdAt"`
+	UpdatedAt       time.Time               `json:"updatedAt"`
+}
+
+type NotificationChannelInput struct {
+	Name            string                  `json:"name"`
+	Type            NotificationChannelType `json:"type"`
+	URL             *string                 `json:"url,omitempty"`
+	Secret          *string                 `json:"secret,omitempty"`
+	SMTPHost        *string                 `json:"smtpHost,omitempty"`
+	SMTPPort        *int                    `json:"smtpPort,omitempty"`
+	SMTPUsername    *string                 `json:"smtpUsername,omitempty"`
+	SMTPPassword    *string                 `json:"smtpPassword,omitempty"`
+	From            *string                 `json:"from,omitempty"`
+	To              []string                `json:"to,omitempty"`
+	SubjectTemplate *string                 `json:"subjectTemplate,omitempty"`
+	BodyTemplate    *string                 `json:"bodyTemplate,omitempty"`
+	MinSeverity     *AlertSeverity          `json:"minSeverity,omitempty"`
+	Enabled         *bool                   `json:"enabled,omitempty"`
+}
+
+type NotificationResult struct {
+	Success  bool    `json:"success"`
+	Attempts int     `json:"attempts"`
+	Error    *string `json:"error,omitempty"`
+}
+
 type OracleSession struct {
 	Sid             int           `json:"sid"`
 	Serial          int           `json:"serial"`
@@ -134,6 +620,63 @@ type OracleSession struct {
 	WaitClass       *string       `json:"waitClass,omitempty"`
 	Event           *string       `json:"event,omitempty"`
 	SecondsInWait   *int          `json:"secondsInWait,omitempty"`
+	InstID          int           `json:"instId"`
+	ConID           int           `json:"conId"`
+}
+
+type Parameter struct {
+	Name                 string                    `json:"name"`
+	Value                *string                   `json:"value,omitempty"`
+	SpfileValue          *string                   `json:"spfileValue,omitempty"`
+	SpfileDiffers        bool                      `json:"spfileDiffers"`
+	IsDefault            bool                      `json:"isDefault"`
+	ModifiedSinceStartup bool                      `json:"modifiedSinceStartup"`
+	Deprecated           bool                      `json:"deprecated"`
+	SessionModifiable    bool                      `json:"sessionModifiable"`
+	SystemModifiable     ParameterSystemModifiable `json:"systemModifiable"`
+	PdbModifiable        bool                      `json:"pdbModifiable"`
+	Description          *string                   `json:"description,omitempty"`
+}
+
+type ParameterChange struct {
+	Name                string    `json:"name"`
+	CapturedAt          time.Time `json:"capturedAt"`
+	Target              string    `json:"target"`
+	Value               *string   `json:"value,omitempty"`
+	SpfileValue         *string   `json:"spfileValue,omitempty"`
+	IsDefault           bool      `json:"isDefault"`
+	PreviousValue       *string   `json:"previousValue,omitempty"`
+	PreviousSpfileValue *string   `json:"previousSpfileValue,omitempty"`
+}
+
+type ParameterDifference struct {
+	Name  string          `json:"name"`
+	Base  *ParameterValue `json:"base,omitempty"`
+	Other *ParameterValue `json:"other,omitempty"`
+}
+
+type ParameterSetInput struct {
+	Target *string    `json:"target,omitempty"`
+	At     *time.Time `json:"at,omitempty"`
+}
+
+type ParameterValue struct {
+	CapturedAt  time.Time `json:"capturedAt"`
+	Target      string    `json:"target"`
+	Value       *string   `json:"value,omitempty"`
+	SpfileValue *string   `json:"spfileValue,omitempty"`
+	IsDefault   bool      `json:"isDefault"`
+}
+
+type Pdb struct {
+	ConID       int        `json:"conId"`
+	Name        string     `json:"name"`
+	Dbid        int        `json:"dbid"`
+	OpenMode    string     `json:"openMode"`
+	Restricted  bool       `json:"restricted"`
+	OpenTime    *time.Time `json:"openTime,omitempty"`
+	TotalSizeMb float64    `json:"totalSizeMb"`
+	InstID      int        `json:"instId"`
 }
 
 type Permission struct {
@@ -142,9 +685,136 @@ type Permission struct {
 	Description string `json:"description"`
 }
 
+type PgaStat struct {
+	Name  string  `json:"name"`
+	Value float64 `json:"value"`
+	Unit  *string `json:"unit,omitempty"`
+}
+
+type PgaTargetAdvice struct {
+	PgaTargetMb                 float64 `json:"pgaTargetMb"`
+	TargetFactor                float64 `json:"targetFactor"`
+	EstimatedCacheHitPercentage float64 `json:"estimatedCacheHitPercentage"`
+	EstimatedOverAllocations    int     `json:"estimatedOverAllocations"`
+	EstimatedExtraMbReadWritten float64 `json:"estimatedExtraMbReadWritten"`
+}
+
+type PlanStep struct {
+	ID               int         `json:"id"`
+	ParentID         *int        `json:"parentId,omitempty"`
+	Depth            int         `json:"depth"`
+	Operation        string      `json:"operation"`
+	Options          *string     `json:"options,omitempty"`
+	ObjectOwner      *string     `json:"objectOwner,omitempty"`
+	ObjectName       *string     `json:"objectName,omitempty"`
+	Cost             *int        `json:"cost,omitempty"`
+	Cardinality      *int        `json:"cardinality,omitempty"`
+	Bytes            *int        `json:"bytes,omitempty"`
+	CPUCost          *int        `json:"cpuCost,omitempty"`
+	IoCost           *int        `json:"ioCost,omitempty"`
+	Time             *int        `json:"time,omitempty"`
+	AccessPredicates *string     `json:"accessPredicates,omitempty"`
+	FilterPredicates *string     `json:"filterPredicates,omitempty"`
+	Children         []*PlanStep `json:"children"`
+}
+
+type ProcessMemory struct {
+	Pid            int                      `json:"pid"`
+	Spid           *string                  `json:"spid,omitempty"`
+	Sid            *int                     `json:"sid,omitempty"`
+	Serial         *int                     `json:"serial,omitempty"`
+	Username       *string                  `json:"username,omitempty"`
+	Program        *string                  `json:"program,omitempty"`
+	UsedMb         float64                  `json:"usedMb"`
+	AllocatedMb    float64                  `json:"allocatedMb"`
+	FreeableMb     float64                  `json:"freeableMb"`
+	MaxAllocatedMb float64                  `json:"maxAllocatedMb"`
+	Categories     []*ProcessMemoryCategory `json:"categories"`
+}
+
+type ProcessMemoryCategory struct {
+	Category       string   `json:"category"`
+	AllocatedMb    float64  `json:"allocatedMb"`
+	UsedMb         *float64 `json:"usedMb,omitempty"`
+	MaxAllocatedMb *float64 `json:"maxAllocatedMb,omitempty"`
+}
+
 type Query struct {
 }
 
+type RecompileResult struct {
+	Owner        string          `json:"owner"`
+	ObjectName   string          `json:"objectName"`
+	ObjectType   string          `json:"objectType"`
+	StatusBefore string          `json:"statusBefore"`
+	StatusAfter  string          `json:"statusAfter"`
+	Error        *string         `json:"error,omitempty"`
+	Errors       []*CompileError `json:"errors"`
+}
+
+type RecoveryArea struct {
+	Name                     string                  `json:"name"`
+	SpaceLimitMb             float64                 `json:"spaceLimitMb"`
+	SpaceUsedMb              float64                 `json:"spaceUsedMb"`
+	SpaceReclaimableMb       float64                 `json:"spaceReclaimableMb"`
+	NonReclaimableMb         float64                 `json:"nonReclaimableMb"`
+	UsagePercentage          float64                 `json:"usagePercentage"`
+	NonReclaimablePercentage float64                 `json:"nonReclaimablePercentage"`
+	FileCount                int                     `json:"fileCount"`
+	FileTypes                []*RecoveryAreaFileType `json:"fileTypes"`
+}
+
+type RecoveryAreaFileType struct {
+	FileType                string  `json:"fileType"`
+	PercentSpaceUsed        float64 `json:"percentSpaceUsed"`
+	PercentSpaceReclaimable float64 `json:"percentSpaceReclaimable"`
+	UsedMb                  float64 `json:"usedMb"`
+	ReclaimableMb           float64 `json:"reclaimableMb"`
+	FileCount               int     `json:"fileCount"`
+}
+
+type RecoveryAreaMetric struct {
+	CapturedAt               time.Time `json:"capturedAt"`
+	SpaceLimitMb             float64   `json:"spaceLimitMb"`
+	SpaceUsedMb              float64   `json:"spaceUsedMb"`
+	SpaceReclaimableMb       float64   `json:"spaceReclaimableMb"`
+	NonReclaimableMb         float64   `json:"nonReclaimableMb"`
+	NonReclaimablePercentage float64   `json:"nonReclaimablePercentage"`
+	FileCount                int       `json:"fileCount"`
+}
+
+type RedoLogGroup struct {
+	GroupNumber int              `json:"groupNumber"`
+	Thread      int              `json:"thread"`
+	Sequence    int              `json:"sequence"`
+	SizeMb      float64          `json:"sizeMb"`
+	MemberCount int              `json:"memberCount"`
+	Archived    bool             `json:"archived"`
+	Status      string           `json:"status"`
+	FirstTime   *time.Time       `json:"firstTime,omitempty"`
+	Members     []*RedoLogMember `json:"members"`
+}
+
+type RedoLogMember struct {
+	Member string  `json:"member"`
+	Type   string  `json:"type"`
+	Status *string `json:"status,omitempty"`
+}
+
+type RedoSummary struct {
+	LogMode              string           `json:"logMode"`
+	Groups               []*RedoLogGroup  `json:"groups"`
+	SwitchesPerHour      []*LogSwitchHour `json:"switchesPerHour"`
+	ArchivePerDay        []*ArchiveDay    `json:"archivePerDay"`
+	MaxSwitchesPerHour   int              `json:"maxSwitchesPerHour"`
+	PeakSwitchesPerHour  int              `json:"peakSwitchesPerHour"`
+	HoursOverThreshold   int              `json:"hoursOverThreshold"`
+	Undersized           bool             `json:"undersized"`
+	PendingArchiveGroups int              `json:"pendingArchiveGroups"`
+	ArchivingBehind      bool             `json:"archivingBehind"`
+	Flags                []string         `json:"flags"`
+}
+
 type Role struct {
 	ID          string        `json:"id"`
 	Name        string        `json:"name"`
@@ -161,6 +831,13 @@ type SchemaChange struct {
 	Status       string    `json:"status"`
 }
 
+type SchemaIndexFindings struct {
+	SchemaName string          `json:"schemaName"`
+	ConID      int             `json:"conId"`
+	IndexCount int             `json:"indexCount"`
+	Findings   []*IndexFinding `json:"findings"`
+}
+
 type SchemaInfo struct {
 	SchemaName     string `json:"schemaName"`
 	TotalObjects   int    `json:"totalObjects"`
@@ -170,12 +847,128 @@ type SchemaInfo struct {
 	ProcedureCount int    `json:"procedureCount"`
 	FunctionCount  int    `json:"functionCount"`
 	PackageCount   int    `json:"packageCount"`
+	ConID          int    `json:"conId"`
+}
+
+type SchemaStatistics struct {
+	Owner          string     `json:"owner"`
+	Tables         int        `json:"tables"`
+	StaleTables    int        `json:"staleTables"`
+	MissingTables  int        `json:"missingTables"`
+	LockedTables   int        `json:"lockedTables"`
+	OldestAnalyzed *time.Time `json:"oldestAnalyzed,omitempty"`
+	ConID          int        `json:"conId"`
+}
+
+type Segment struct {
+	Owner          string  `json:"owner"`
+	SegmentName    string  `json:"segmentName"`
+	PartitionName  *string `json:"partitionName,omitempty"`
+	SegmentType    string  `json:"segmentType"`
+	TablespaceName string  `json:"tablespaceName"`
+	SizeMb         float64 `json:"sizeMb"`
+	Extents        int     `json:"extents"`
+	Blocks         int     `json:"blocks"`
+	ConID          int     `json:"conId"`
+}
+
+type SegmentGrowth struct {
+	Owner              string    `json:"owner"`
+	SegmentName        string    `json:"segmentName"`
+	PartitionName      *string   `json:"partitionName,omitempty"`
+	SegmentType        string    `json:"segmentType"`
+	TablespaceName     string    `json:"tablespaceName"`
+	StartTime          time.Time `json:"startTime"`
+	StartSizeMb        float64   `json:"startSizeMb"`
+	EndTime            time.Time `json:"endTime"`
+	EndSizeMb          float64   `json:"endSizeMb"`
+	GrowthMb           float64   `json:"growthMb"`
+	GrowthRateMbPerDay *float64  `json:"growthRateMbPerDay,omitempty"`
+}
+
+type SegmentSnapshot struct {
+	CapturedAt     time.Time `json:"capturedAt"`
+	SegmentType    string    `json:"segmentType"`
+	TablespaceName string    `json:"tablespaceName"`
+	SizeMb         float64   `json:"sizeMb"`
+	Extents        int       `json:"extents"`
+}
+
+type SessionCursor struct {
+	SQLID          *string    `json:"sqlId,omitempty"`
+	SQLText        *string    `json:"sqlText,omitempty"`
+	CursorType     *string    `json:"cursorType,omitempty"`
+	LastActiveTime *time.Time `json:"lastActiveTime,omitempty"`
+}
+
+type SessionDetail struct {
+	Session          *OracleSession     `json:"session"`
+	State            *string            `json:"state,omitempty"`
+	ClientIdentifier *string            `json:"clientIdentifier,omitempty"`
+	ClientInfo       *string            `json:"clientInfo,omitempty"`
+	Module           *string            `json:"module,omitempty"`
+	Action           *string            `json:"action,omitempty"`
+	ServiceName      *string            `json:"serviceName,omitempty"`
+	Terminal         *string            `json:"terminal,omitempty"`
+	OsProcessID      *string            `json:"osProcessId,omitempty"`
+	SQLExecStart     *time.Time         `json:"sqlExecStart,omitempty"`
+	CurrentSQL       *SessionSQL        `json:"currentSql,omitempty"`
+	PreviousSQL      *SessionSQL        `json:"previousSql,omitempty"`
+	Statistics       *SessionStatistics `json:"statistics"`
+	OpenCursors      []*SessionCursor   `json:"openCursors"`
+	Locks            []*SessionLock     `json:"locks"`
+	WaitHistory      []*SessionWait     `json:"waitHistory"`
 }
 
 type SessionFilterInput struct {
-	SchemaName *string        `json:"schemaName,omitempty"`
-	Status     *SessionStatus `json:"status,omitempty"`
-	Username   *string        `json:"username,omitempty"`
+	SchemaName     *string        `json:"schemaName,omitempty"`
+	Status         *SessionStatus `json:"status,omitempty"`
+	Username       *string        `json:"username,omitempty"`
+	Machine        *string        `json:"machine,omitempty"`
+	Program        *string        `json:"program,omitempty"`
+	Module         *string        `json:"module,omitempty"`
+	WaitClass      *string        `json:"waitClass,omitempty"`
+	MinIdleSeconds *int           `json:"minIdleSeconds,omitempty"`
+	InstID         *int           `json:"instId,omitempty"`
+}
+
+type SessionLock struct {
+	Type          string  `json:"type"`
+	Mode          string  `json:"mode"`
+	RequestedMode string  `json:"requestedMode"`
+	ID1           int     `json:"id1"`
+	ID2           int     `json:"id2"`
+	HeldSeconds   int     `json:"heldSeconds"`
+	Blocking      bool    `json:"blocking"`
+	ObjectOwner   *string `json:"objectOwner,omitempty"`
+	ObjectName    *string `json:"objectName,omitempty"`
+	ObjectType    *string `json:"objectType,omitempty"`
+}
+
+type SessionPage struct {
+	Sessions   []*OracleSession `json:"sessions"`
+	TotalCount int              `json:"totalCount"`
+	NextCursor *string          `json:"nextCursor,omitempty"`
+}
+
+type SessionSortInput struct {
+	Field      SessionSortField `json:"field"`
+	Descending *bool            `json:"descending,omitempty"`
+}
+
+type SessionSQL struct {
+	SQLID       string  `json:"sqlId"`
+	ChildNumber *int    `json:"childNumber,omitempty"`
+	FullText    *string `json:"fullText,omitempty"`
+}
+
+type SessionStatistics struct {
+	CPUSeconds        float64 `json:"cpuSeconds"`
+	LogicalReads      int     `json:"logicalReads"`
+	PhysicalReads     int     `json:"physicalReads"`
+	PgaMemoryBytes    int     `json:"pgaMemoryBytes"`
+	PgaMemoryMaxBytes int     `json:"pgaMemoryMaxBytes"`
+	OpenCursors       int     `json:"openCursors"`
 }
 
 type SessionSummary struct {
@@ -186,6 +979,15 @@ type SessionSummary struct {
 	BySchema         []*SessionsBySchema `json:"bySchema"`
 }
 
+type SessionWait struct {
+	Sequence               int     `json:"sequence"`
+	Event                  string  `json:"event"`
+	WaitClass              *string `json:"waitClass,omitempty"`
+	WaitTimeMicro          int     `json:"waitTimeMicro"`
+	TimeSinceLastWaitMicro *int    `json:"timeSinceLastWaitMicro,omitempty"`
+	Parameters             *string `json:"parameters,omitempty"`
+}
+
 type SessionsBySchema struct {
 	SchemaName string `json:"schemaName"`
 	Total      int    `json:"total"`
@@ -193,6 +995,63 @@ type SessionsBySchema struct {
 	Inactive   int    `json:"inactive"`
 }
 
+type SgaComponent struct {
+	Name       string  `json:"name"`
+	SizeMb     float64 `json:"sizeMb"`
+	Resizeable bool    `json:"resizeable"`
+}
+
+type SgaDynamicComponent struct {
+	Component           string     `json:"component"`
+	CurrentSizeMb       float64    `json:"currentSizeMb"`
+	MinSizeMb           float64    `json:"minSizeMb"`
+	MaxSizeMb           float64    `json:"maxSizeMb"`
+	UserSpecifiedSizeMb float64    `json:"userSpecifiedSizeMb"`
+	OperationCount      int        `json:"operationCount"`
+	LastOperationType   *string    `json:"lastOperationType,omitempty"`
+	LastOperationMode   *string    `json:"lastOperationMode,omitempty"`
+	LastOperationTime   *time.Time `json:"lastOperationTime,omitempty"`
+	GranuleSizeMb       float64    `json:"granuleSizeMb"`
+}
+
+type SgaTargetAdvice struct {
+	SgaSizeMb              float64 `json:"sgaSizeMb"`
+	SizeFactor             float64 `json:"sizeFactor"`
+	EstimatedDbTime        float64 `json:"estimatedDbTime"`
+	EstimatedDbTimeFactor  float64 `json:"estimatedDbTimeFactor"`
+	EstimatedPhysicalReads int     `json:"estimatedPhysicalReads"`
+}
+
+type Silence struct {
+	ID            string            `json:"id"`
+	Matchers      []*SilenceMatcher `json:"matchers"`
+	Comment       string            `json:"comment"`
+	CreatedBy     *string           `json:"createdBy,omitempty"`
+	CreatedByName string            `json:"createdByName"`
+	StartsAt      time.Time         `json:"startsAt"`
+	EndsAt        time.Time         `json:"endsAt"`
+	Active        bool              `json:"active"`
+	CreatedAt     time.Time         `json:"createdAt"`
+}
+
+type SilenceInput struct {
+	Matchers []*SilenceMatcherInput `json:"matchers"`
+	Comment  string                 `json:"comment"`
+	StartsAt *time.Time             `json:"startsAt,omitempty"`
+	EndsAt   *time.Time             `json:"endsAt,omitempty"`
+	Duration *string                `json:"duration,omitempty"`
+}
+
+type SilenceMatcher struct {
+	Label   AlertLabel `json:"label"`
+	Pattern string     `json:"pattern"`
+}
+
+type SilenceMatcherInput struct {
+	Label   AlertLabel `json:"label"`
+	Pattern string     `json:"pattern"`
+}
+
 type SQLMetric struct {
 	ID            string    `json:"id"`
 	SQLID         string    `json:"sqlId"`
@@ -222,6 +1081,9 @@ type SQLPerformance struct {
 	RowsProcessed  int        `json:"rowsProcessed"`
 	FirstLoadTime  *time.Time `json:"firstLoadTime,omitempty"`
 	LastActiveTime *time.Time `json:"lastActiveTime,omitempty"`
+	PlanHashValue  *int       `json:"planHashValue,omitempty"`
+	InstID         *int       `json:"instId,omitempty"`
+	ConID          *int       `json:"conId,omitempty"`
 }
 
 type SQLPerformanceFilterInput struct {
@@ -231,18 +1093,74 @@ type SQLPerformanceFilterInput struct {
 	SortBy        *SQLSortField `json:"sortBy,omitempty"`
 }
 
+type SQLPlanChange struct {
+	ID               string    `json:"id"`
+	SQLID            string    `json:"sqlId"`
+	OldPlanHashValue int       `json:"oldPlanHashValue"`
+	NewPlanHashValue int       `json:"newPlanHashValue"`
+	OldAvgElapsedMs  float64   `json:"oldAvgElapsedMs"`
+	NewAvgElapsedMs  float64   `json:"newAvgElapsedMs"`
+	Regressed        bool      `json:"regressed"`
+	DetectedAt       time.Time `json:"detectedAt"`
+}
+
+type StandbyProcess struct {
+	Process       string  `json:"process"`
+	Pid           string  `json:"pid"`
+	Status        string  `json:"status"`
+	ClientProcess *string `json:"clientProcess,omitempty"`
+	Thread        int     `json:"thread"`
+	Sequence      int     `json:"sequence"`
+	Block         int     `json:"block"`
+	Blocks        int     `json:"blocks"`
+}
+
 type Subscription struct {
 }
 
+type SystemRatios struct {
+	IntervalSeconds          float64  `json:"intervalSeconds"`
+	BufferCacheHitPercentage *float64 `json:"bufferCacheHitPercentage,omitempty"`
+	SoftParsePercentage      *float64 `json:"softParsePercentage,omitempty"`
+	ParseToExecuteRatio      *float64 `json:"parseToExecuteRatio,omitempty"`
+	ExecuteToParsePercentage *float64 `json:"executeToParsePercentage,omitempty"`
+	ExecutesPerSecond        float64  `json:"executesPerSecond"`
+	HardParsesPerSecond      float64  `json:"hardParsesPerSecond"`
+	LogicalReadsPerSecond    float64  `json:"logicalReadsPerSecond"`
+}
+
+type TableStatistics struct {
+	Owner           string           `json:"owner"`
+	TableName       string           `json:"tableName"`
+	NumRows         *int             `json:"numRows,omitempty"`
+	LastAnalyzed    *time.Time       `json:"lastAnalyzed,omitempty"`
+	Status          StatisticsStatus `json:"status"`
+	Locked          bool             `json:"locked"`
+	LockType        *string          `json:"lockType,omitempty"`
+	Inserts         int              `json:"inserts"`
+	Updates         int              `json:"updates"`
+	Deletes         int              `json:"deletes"`
+	Truncated       bool             `json:"truncated"`
+	LastModified    *time.Time       `json:"lastModified,omitempty"`
+	PercentModified *float64         `json:"percentModified,omitempty"`
+	ConID           int              `json:"conId"`
+}
+
 type Tablespace struct {
-	Name            string             `json:"name"`
-	TotalSizeMb     float64            `json:"totalSizeMb"`
-	UsedSizeMb      float64            `json:"usedSizeMb"`
-	FreeSizeMb      float64            `json:"freeSizeMb"`
-	UsagePercentage float64            `json:"usagePercentage"`
-	Status          string             `json:"status"`
-	Contents        TablespaceContents `json:"contents"`
-	DatafileCount   int                `json:"datafileCount"`
+	Name               string             `json:"name"`
+	TotalSizeMb        float64            `json:"totalSizeMb"`
+	UsedSizeMb         float64            `json:"usedSizeMb"`
+	FreeSizeMb         float64            `json:"freeSizeMb"`
+	UsagePercentage    float64            `json:"usagePercentage"`
+	MaxSizeMb          float64            `json:"maxSizeMb"`
+	MaxUsagePercentage float64            `json:"maxUsagePercentage"`
+	Autoextensible     bool               `json:"autoextensible"`
+	Status             string             `json:"status"`
+	Contents           TablespaceContents `json:"contents"`
+	DatafileCount      int                `json:"datafileCount"`
+	ConID              int                `json:"conId"`
+	Pdb                *string            `json:"pdb,omitempty"`
+	Datafiles          []*Datafile        `json:"datafiles"`
 }
 
 type TablespaceFilterInput struct {
@@ -266,11 +1184,52 @@ type TablespaceMetric struct {
 	CapturedAt      time.Time `json:"capturedAt"`
 }
 
+type TempUsage struct {
+	Sid         int     `json:"sid"`
+	Serial      int     `json:"serial"`
+	Username    *string `json:"username,omitempty"`
+	SQLID       *string `json:"sqlId,omitempty"`
+	SQLText     *string `json:"sqlText,omitempty"`
+	Tablespace  string  `json:"tablespace"`
+	SegmentType string  `json:"segmentType"`
+	UsedMb      float64 `json:"usedMb"`
+}
+
 type TimeRangeInput struct {
 	StartTime time.Time `json:"startTime"`
 	EndTime   time.Time `json:"endTime"`
 }
 
+type UndoStat struct {
+	BeginTime                 time.Time `json:"beginTime"`
+	EndTime                   time.Time `json:"endTime"`
+	UndoBlocks                int       `json:"undoBlocks"`
+	TransactionCount          int       `json:"transactionCount"`
+	MaxQuerySeconds           int       `json:"maxQuerySeconds"`
+	MaxQuerySQLID             *string   `json:"maxQuerySqlId,omitempty"`
+	TunedUndoRetentionSeconds int       `json:"tunedUndoRetentionSeconds"`
+	ActiveBlocks              int       `json:"activeBlocks"`
+	UnexpiredBlocks           int       `json:"unexpiredBlocks"`
+	ExpiredBlocks             int       `json:"expiredBlocks"`
+	UnexpiredStealCount       int       `json:"unexpiredStealCount"`
+	SnapshotTooOldCount       int       `json:"snapshotTooOldCount"`
+	NoSpaceCount              int       `json:"noSpaceCount"`
+}
+
+type UndoSummary struct {
+	UndoTablespace            *string     `json:"undoTablespace,omitempty"`
+	UndoRetentionSeconds      int         `json:"undoRetentionSeconds"`
+	TunedUndoRetentionSeconds int         `json:"tunedUndoRetentionSeconds"`
+	LongestQuerySeconds       int         `json:"longestQuerySeconds"`
+	LongestQuerySQLID         *string     `json:"longestQuerySqlId,omitempty"`
+	SnapshotTooOldCount       int         `json:"snapshotTooOldCount"`
+	NoSpaceCount              int         `json:"noSpaceCount"`
+	UnexpiredStealCount       int         `json:"unexpiredStealCount"`
+	Risk                      UndoRisk    `json:"risk"`
+	RiskReasons               []string    `json:"riskReasons"`
+	History                   []*UndoStat `json:"history"`
+}
+
 type UpdateUserInput struct {
 	UserID   string   `json:"userId"`
 	Email    *string  `json:"email,omitempty"`
@@ -290,50 +1249,63 @@ type User struct {
 	CreatedAt time.Time  `json:"createdAt"`
 }
 
-type AuditStatus string
+type WaitEventStat struct {
+	Event             string   `json:"event"`
+	WaitClass         string   `json:"waitClass"`
+	Waits             int      `json:"waits"`
+	TimeWaitedSeconds float64  `json:"timeWaitedSeconds"`
+	AvgWaitMs         float64  `json:"avgWaitMs"`
+	PctDbTime         *float64 `json:"pctDbTime,omitempty"`
+}
+
+type AlertCondition string
 
 const (
-	AuditStatusSuccess AuditStatus = "SUCCESS"
-	AuditStatusFailure AuditStatus = "FAILURE"
-	AuditStatusDenied  AuditStatus = "DENIED"
+	AlertConditionGt  AlertCondition = "GT"
+	AlertConditionGte AlertCondition = "GTE"
+	AlertConditionLt  AlertCondition = "LT"
+	AlertConditionLte AlertCondition = "LTE"
+	AlertConditionEq  AlertCondition = "EQ"
 )
 
-var AllAuditStatus = []AuditStatus{
-	AuditStatusSuccess,
-	AuditStatusFailure,
-	AuditStatusDenied,
+var AllAlertCondition = []AlertCondition{
+	AlertConditionGt,
+	AlertConditionGte,
+	AlertConditionLt,
+	AlertConditionLte,
+	AlertConditionEq,
 }
 
-func (e AuditStatus) IsValid() bool {
+func (e AlertCondition) IsValid() bool {
 	switch e {
-	case AuditStatusSuccess, AuditStatusFailure, AuditStatusDenied:
+	case AlertConditionGt, AlertConditionGte, AlertConditionLt, AlertConditionLte, AlertConditionEq:
 		return true
 	}
 	return false
 }
 
-func (e AuditStatus) String() string {
+func (e AlertCondition) String() string {
 	return string(e)
 }
 
-func (e *AuditStatus) UnmarshalGQL(v any) error {
+func (e *AlertCondition) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = AuditStatus(str)
+	*e = AlertCondition(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid AuditStatus", str)
+		return fmt.Errorf("%s is not a valid AlertCondition", str)
 	}
 	return nil
 }
 
-func (e AuditStatus) MarshalGQL(w io.Writer) {
+func (e AlertCondition) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *AuditStatus) UnmarshalJSON(b []byte) error {
+func (e *AlertCondition) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -341,56 +1313,60 @@ func (e *AuditStatus) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e AuditStatus) MarshalJSON() ([]byte, error) {
+func (e AlertCondition) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
 
-type SessionStatus string
+type AlertLabel string
 
 const (
-	SessionStatusActive   SessionStatus = "ACTIVE"
-	SessionStatusInactive SessionStatus = "INACTIVE"
-	SessionStatusKilled   SessionStatus = "KILLED"
+	AlertLabelTarget   AlertLabel = "TARGET"
+	AlertLabelRule     AlertLabel = "RULE"
+	AlertLabelMetric   AlertLabel = "METRIC"
+	AlertLabelSeverity AlertLabel = "SEVERITY"
+	AlertLabelObject   AlertLabel = "OBJECT"
 )
 
-var AllSessionStatus = []SessionStatus{
-	SessionStatusActive,
-	SessionStatusInactive,
-	SessionStatusKilled,
+var AllAlertLabel = []AlertLabel{
+	AlertLabelTarget,
+	AlertLabelRule,
+	AlertLabelMetric,
+	AlertLabelSeverity,
+	AlertLabelObject,
 }
 
-func (e SessionStatus) IsValid() bool {
+func (e AlertLabel) IsValid() bool {
 	switch e {
-	case SessionStatusActive, SessionStatusInactive, SessionStatusKilled:
+	case AlertLabelTarget, AlertLabelRule, AlertLabelMetric, AlertLabelSeverity, AlertLabelObject:
 		return true
 	}
 	return false
 }
 
-func (e SessionStatus) String() string {
+func (e AlertLabel) String() string {
 	return string(e)
 }
 
-func (e *SessionStatus) UnmarshalGQL(v any) error {
+func (e *AlertLabel) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = SessionStatus(str)
+	*e = AlertLabel(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid SessionStatus", str)
+		return fmt.Errorf("%s is not a valid AlertLabel", str)
 	}
 	return nil
 }
 
-func (e SessionStatus) MarshalGQL(w io.Writer) {
+func (e AlertLabel) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *SessionStatus) UnmarshalJSON(b []byte) error {
+func (e *AlertLabel) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -398,58 +1374,76 @@ func (e *SessionStatus) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e SessionStatus) MarshalJSON() ([]byte, error) {
+func (e AlertLabel) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
 
-type SQLSortField string
+type AlertMetric string
 
 const (
-	SQLSortFieldElapsedTime SQLSortField = "ELAPSED_TIME"
-	SQLSortFieldCPUTime     SQLSortField = "CPU_TIME"
-	SQLSortFieldExecutions  SQLSortField = "EXECUTIONS"
-	SQLSortFieldDiskReads   SQLSortField = "DISK_READS"
+	AlertMetricTablespaceUsagePct           AlertMetric = "TABLESPACE_USAGE_PCT"
+	AlertMetricTablespaceMaxUsagePct        AlertMetric = "TABLESPACE_MAX_USAGE_PCT"
+	AlertMetricBlockedSeconds               AlertMetric = "BLOCKED_SECONDS"
+	AlertMetricActiveSessionCount           AlertMetric = "ACTIVE_SESSION_COUNT"
+	AlertMetricInvalidObjectCount           AlertMetric = "INVALID_OBJECT_COUNT"
+	AlertMetricSQLElapsedDelta              AlertMetric = "SQL_ELAPSED_DELTA"
+	AlertMetricSQLPlanRegressionPct         AlertMetric = "SQL_PLAN_REGRESSION_PCT"
+	AlertMetricAsmDiskgroupUsagePct         AlertMetric = "ASM_DISKGROUP_USAGE_PCT"
+	AlertMetricFraNonReclaimablePct         AlertMetric = "FRA_NON_RECLAIMABLE_PCT"
+	AlertMetricBackupFullAgeHours           AlertMetric = "BACKUP_FULL_AGE_HOURS"
+	AlertMetricBackupArchivelogAgeHours     AlertMetric = "BACKUP_ARCHIVELOG_AGE_HOURS"
+	AlertMetricDataguardApplyLagSeconds     AlertMetric = "DATAGUARD_APPLY_LAG_SECONDS"
+	AlertMetricDataguardTransportLagSeconds AlertMetric = "DATAGUARD_TRANSPORT_LAG_SECONDS"
 )
 
-var AllSQLSortField = []SQLSortField{
-	SQLSortFieldElapsedTime,
-	SQLSortFieldCPUTime,
-	SQLSortFieldExecutions,
-	SQLSortFieldDiskReads,
+var AllAlertMetric = []AlertMetric{
+	AlertMetricTablespaceUsagePct,
+	AlertMetricTablespaceMaxUsagePct,
+	AlertMetricBlockedSeconds,
+	AlertMetricActiveSessionCount,
+	AlertMetricInvalidObjectCount,
+	AlertMetricSQLElapsedDelta,
+	AlertMetricSQLPlanRegressionPct,
+	AlertMetricAsmDiskgroupUsagePct,
+	AlertMetricFraNonReclaimablePct,
+	AlertMetricBackupFullAgeHours,
+	AlertMetricBackupArchivelogAgeHours,
+	AlertMetricDataguardApplyLagSeconds,
+	AlertMetricDataguardTransportLagSeconds,
 }
 
-func (e SQLSortField) IsValid() bool {
+func (e AlertMetric) IsValid() bool {
 	switch e {
-	case SQLSortFieldElapsedTime, SQLSortFieldCPUTime, SQLSortFieldExecutions, SQLSortFieldDiskReads:
+	case AlertMetricTablespaceUsagePct, AlertMetricTablespaceMaxUsagePct, AlertMetricBlockedSeconds, AlertMetricActiveSessionCount, AlertMetricInvalidObjectCount, AlertMetricSQLElapsedDelta, AlertMetricSQLPlanRegressionPct, AlertMetricAsmDiskgroupUsagePct, AlertMetricFraNonReclaimablePct, AlertMetricBackupFullAgeHours, AlertMetricBackupArchivelogAgeHours, AlertMetricDataguardApplyLagSeconds, AlertMetricDataguardTransportLagSeconds:
 		return true
 	}
 	return false
 }
 
-func (e SQLSortField) String() string {
+func (e AlertMetric) String() string {
 	return string(e)
 }
 
-func (e *SQLSortField) UnmarshalGQL(v any) error {
+func (e *AlertMetric) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = SQLSortField(str)
+	*e = AlertMetric(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid SqlSortField", str)
+		return fmt.Errorf("%s is not a valid AlertMetric", str)
 	}
 	return nil
 }
 
-func (e SQLSortField) MarshalGQL(w io.Writer) {
+func (e AlertMetric) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *SQLSortField) UnmarshalJSON(b []byte) error {
+func (e *AlertMetric) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -457,56 +1451,56 @@ func (e *SQLSortField) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e SQLSortField) MarshalJSON() ([]byte, error) {
+func (e AlertMetric) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
 
-type TablespaceContents string
+type AlertSeverity string
 
 const (
-	TablespaceContentsPermanent TablespaceContents = "PERMANENT"
-	TablespaceContentsTemporary TablespaceContents = "TEMPORARY"
-	TablespaceContentsUndo      TablespaceContents = "UNDO"
+	AlertSeverityInfo     AlertSeverity = "INFO"
+	AlertSeverityWarning  AlertSeverity = "WARNING"
+	AlertSeverityCritical AlertSeverity = "CRITICAL"
 )
 
-var AllTablespaceContents = []TablespaceContents{
-	TablespaceContentsPermanent,
-	TablespaceContentsTemporary,
-	TablespaceContentsUndo,
+var AllAlertSeverity = []AlertSeverity{
+	AlertSeverityInfo,
+	AlertSeverityWarning,
+	AlertSeverityCritical,
 }
 
-func (e TablespaceContents) IsValid() bool {
+func (e AlertSeverity) IsValid() bool {
 	switch e {
-	case TablespaceContentsPermanent, TablespaceContentsTemporary, TablespaceContentsUndo:
+	case AlertSeverityInfo, AlertSeverityWarning, AlertSeverityCritical:
 		return true
 	}
 	return false
 }
 
-func (e TablespaceContents) String() string {
+func (e AlertSeverity) String() string {
 	return string(e)
 }
 
-func (e *TablespaceContents) UnmarshalGQL(v any) error {
+func (e *AlertSeverity) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = TablespaceContents(str)
+	*e = AlertSeverity(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid TablespaceContents", str)
+		return fmt.Errorf("%s is not a valid AlertSeverity", str)
 	}
 	return nil
 }
 
-func (e TablespaceContents) MarshalGQL(w io.Writer) {
+func (e AlertSeverity) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *TablespaceContents) UnmarshalJSON(b []byte) error {
+func (e *AlertSeverity) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -514,7 +1508,878 @@ func (e *TablespaceContents) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e TablespaceContents) MarshalJSON() ([]byte, error) {
+func (e AlertSeverity) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type AlertStatus string
+
+const (
+	AlertStatusFiring       AlertStatus = "FIRING"
+	AlertStatusAcknowledged AlertStatus = "ACKNOWLEDGED"
+	AlertStatusResolved     AlertStatus = "RESOLVED"
+)
+
+var AllAlertStatus = []AlertStatus{
+	AlertStatusFiring,
+	AlertStatusAcknowledged,
+	AlertStatusResolved,
+}
+
+func (e AlertStatus) IsValid() bool {
+	switch e {
+	case AlertStatusFiring, AlertStatusAcknowledged, AlertStatusResolved:
+		return true
+	}
+	return false
+}
+
+func (e AlertStatus) String() string {
+	return string(e)
+}
+
+func (e *AlertStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AlertStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AlertStatus", str)
+	}
+	return nil
+}
+
+func (e AlertStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *AlertStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e AlertStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type AuditStatus string
+
+const (
+	AuditStatusSuccess AuditStatus = "SUCCESS"
+	AuditStatusFailure AuditStatus = "FAILURE"
+	AuditStatusDenied  AuditStatus = "DENIED"
+)
+
+var AllAuditStatus = []AuditStatus{
+	AuditStatusSuccess,
+	AuditStatusFailure,
+	AuditStatusDenied,
+}
+
+func (e AuditStatus) IsValid() bool {
+	switch e {
+	case AuditStatusSuccess, AuditStatusFailure, AuditStatusDenied:
+		return true
+	}
+	return false
+}
+
+func (e AuditStatus) String() string {
+	return string(e)
+}
+
+func (e *AuditStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AuditStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AuditStatus", str)
+	}
+	return nil
+}
+
+func (e AuditStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *AuditStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e AuditStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type BackupSetType string
+
+const (
+	BackupSetTypeFull        BackupSetType = "FULL"
+	BackupSetTypeIncremental BackupSetType = "INCREMENTAL"
+	BackupSetTypeArchivelog  BackupSetType = "ARCHIVELOG"
+)
+
+var AllBackupSetType = []BackupSetType{
+	BackupSetTypeFull,
+	BackupSetTypeIncremental,
+	BackupSetTypeArchivelog,
+}
+
+func (e BackupSetType) IsValid() bool {
+	switch e {
+	case BackupSetTypeFull, BackupSetTypeIncremental, BackupSetTypeArchivelog:
+		return true
+	}
+	return false
+}
+
+func (e BackupSetType) String() string {
+	return string(e)
+}
+
+func (e *BackupSetType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = BackupSetType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid BackupSetType", str)
+	}
+	return nil
+}
+
+func (e BackupSetType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *BackupSetType) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e BackupSetType) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type IndexFindingPriority string
+
+const (
+	IndexFindingPriorityHigh   IndexFindingPriority = "HIGH"
+	IndexFindingPriorityMedium IndexFindingPriority = "MEDIUM"
+	IndexFindingPriorityLow    IndexFindingPriority = "LOW"
+)
+
+var AllIndexFindingPriority = []IndexFindingPriority{
+	IndexFindingPriorityHigh,
+	IndexFindingPriorityMedium,
+	IndexFindingPriorityLow,
+}
+
+func (e IndexFindingPriority) IsValid() bool {
+	switch e {
+	case IndexFindingPriorityHigh, IndexFindingPriorityMedium, IndexFindingPriorityLow:
+		return true
+	}
+	return false
+}
+
+func (e IndexFindingPriority) String() string {
+	return string(e)
+}
+
+func (e *IndexFindingPriority) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = IndexFindingPriority(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid IndexFindingPriority", str)
+	}
+	return nil
+}
+
+func (e IndexFindingPriority) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *IndexFindingPriority) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e IndexFindingPriority) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type IndexFindingType string
+
+const (
+	IndexFindingTypeUnusable            IndexFindingType = "UNUSABLE"
+	IndexFindingTypeUnindexedForeignKey IndexFindingType = "UNINDEXED_FOREIGN_KEY"
+	IndexFindingTypeDuplicate           IndexFindingType = "DUPLICATE"
+	IndexFindingTypeRedundant           IndexFindingType = "REDUNDANT"
+	IndexFindingTypeUnused              IndexFindingType = "UNUSED"
+)
+
+var AllIndexFindingType = []IndexFindingType{
+	IndexFindingTypeUnusable,
+	IndexFindingTypeUnindexedForeignKey,
+	IndexFindingTypeDuplicate,
+	IndexFindingTypeRedundant,
+	IndexFindingTypeUnused,
+}
+
+func (e IndexFindingType) IsValid() bool {
+	switch e {
+	case IndexFindingTypeUnusable, IndexFindingTypeUnindexedForeignKey, IndexFindingTypeDuplicate, IndexFindingTypeRedundant, IndexFindingTypeUnused:
+		return true
+	}
+	return false
+}
+
+func (e IndexFindingType) String() string {
+	return string(e)
+}
+
+func (e *IndexFindingType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = IndexFindingType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid IndexFindingType", str)
+	}
+	return nil
+}
+
+func (e IndexFindingType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *IndexFindingType) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e IndexFindingType) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type MaintenanceRecurrence string
+
+const (
+	MaintenanceRecurrenceOnce    MaintenanceRecurrence = "ONCE"
+	MaintenanceRecurrenceDaily   MaintenanceRecurrence = "DAILY"
+	MaintenanceRecurrenceWeekly  MaintenanceRecurrence = "WEEKLY"
+	MaintenanceRecurrenceMonthly MaintenanceRecurrence = "MONTHLY"
+)
+
+var AllMaintenanceRecurrence = []MaintenanceRecurrence{
+	MaintenanceRecurrenceOnce,
+	MaintenanceRecurrenceDaily,
+	MaintenanceRecurrenceWeekly,
+	MaintenanceRecurrenceMonthly,
+}
+
+func (e MaintenanceRecurrence) IsValid() bool {
+	switch e {
+	case MaintenanceRecurrenceOnce, MaintenanceRecurrenceDaily, MaintenanceRecurrenceWeekly, MaintenanceRecurrenceMonthly:
+		return true
+	}
+	return false
+}
+
+func (e MaintenanceRecurrence) String() string {
+	return string(e)
+}
+
+func (e *MaintenanceRecurrence) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = MaintenanceRecurrence(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid MaintenanceRecurrence", str)
+	}
+	return nil
+}
+
+func (e MaintenanceRecurrence) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *MaintenanceRecurrence) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e MaintenanceRecurrence) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type NotificationChannelType string
+
+const (
+	NotificationChannelTypeWebhook NotificationChannelType = "WEBHOOK"
+	NotificationChannelTypeEmail   NotificationChannelType = "EMAIL"
+	NotificationChannelTypeSLACk   NotificationChannelType = "SLACK"
+	NotificationChannelTypeTeams   NotificationChannelType = "TEAMS"
+)
+
+var AllNotificationChannelType = []NotificationChannelType{
+	NotificationChannelTypeWebhook,
+	NotificationChannelTypeEmail,
+	NotificationChannelTypeSLACk,
+	NotificationChannelTypeTeams,
+}
+
+func (e NotificationChannelType) IsValid() bool {
+	switch e {
+	case NotificationChannelTypeWebhook, NotificationChannelTypeEmail, NotificationChannelTypeSLACk, NotificationChannelTypeTeams:
+		return true
+	}
+	return false
+}
+
+func (e NotificationChannelType) String() string {
+	return string(e)
+}
+
+func (e *NotificationChannelType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = NotificationChannelType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid NotificationChannelType", str)
+	}
+	return nil
+}
+
+func (e NotificationChannelType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *NotificationChannelType) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e NotificationChannelType) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type NotificationStatus string
+
+const (
+	NotificationStatusSent       NotificationStatus = "SENT"
+	NotificationStatusFailed     NotificationStatus = "FAILED"
+	NotificationStatusSuppressed NotificationStatus = "SUPPRESSED"
+)
+
+var AllNotificationStatus = []NotificationStatus{
+	NotificationStatusSent,
+	NotificationStatusFailed,
+	NotificationStatusSuppressed,
+}
+
+func (e NotificationStatus) IsValid() bool {
+	switch e {
+	case NotificationStatusSent, NotificationStatusFailed, NotificationStatusSuppressed:
+		return true
+	}
+	return false
+}
+
+func (e NotificationStatus) String() string {
+	return string(e)
+}
+
+func (e *NotificationStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = NotificationStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid NotificationStatus", str)
+	}
+	return nil
+}
+
+func (e NotificationStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *NotificationStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e NotificationStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type ParameterSystemModifiable string
+
+const (
+	ParameterSystemModifiableImmediate ParameterSystemModifiable = "IMMEDIATE"
+	ParameterSystemModifiableDeferred  ParameterSystemModifiable = "DEFERRED"
+	ParameterSystemModifiableNone      ParameterSystemModifiable = "NONE"
+)
+
+var AllParameterSystemModifiable = []ParameterSystemModifiable{
+	ParameterSystemModifiableImmediate,
+	ParameterSystemModifiableDeferred,
+	ParameterSystemModifiableNone,
+}
+
+func (e ParameterSystemModifiable) IsValid() bool {
+	switch e {
+	case ParameterSystemModifiableImmediate, ParameterSystemModifiableDeferred, ParameterSystemModifiableNone:
+		return true
+	}
+	return false
+}
+
+func (e ParameterSystemModifiable) String() string {
+	return string(e)
+}
+
+func (e *ParameterSystemModifiable) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ParameterSystemModifiable(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ParameterSystemModifiable", str)
+	}
+	return nil
+}
+
+func (e ParameterSystemModifiable) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ParameterSystemModifiable) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ParameterSystemModifiable) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type SessionSortField string
+
+const (
+	SessionSortFieldSid       SessionSortField = "SID"
+	SessionSortFieldLogonTime SessionSortField = "LOGON_TIME"
+	SessionSortFieldIdleTime  SessionSortField = "IDLE_TIME"
+	SessionSortFieldUsername  SessionSortField = "USERNAME"
+	SessionSortFieldMachine   SessionSortField = "MACHINE"
+	SessionSortFieldProgram   SessionSortField = "PROGRAM"
+)
+
+var AllSessionSortField = []SessionSortField{
+	SessionSortFieldSid,
+	SessionSortFieldLogonTime,
+	SessionSortFieldIdleTime,
+	SessionSortFieldUsername,
+	SessionSortFieldMachine,
+	SessionSortFieldProgram,
+}
+
+func (e SessionSortField) IsValid() bool {
+	switch e {
+	case SessionSortFieldSid, SessionSortFieldLogonTime, SessionSortFieldIdleTime, SessionSortFieldUsername, SessionSortFieldMachine, SessionSortFieldProgram:
+		return true
+	}
+	return false
+}
+
+func (e SessionSortField) String() string {
+	return string(e)
+}
+
+func (e *SessionSortField) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SessionSortField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SessionSortField", str)
+	}
+	return nil
+}
+
+func (e SessionSortField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *SessionSortField) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e SessionSortField) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type SessionStatus string
+
+const (
+	SessionStatusActive   SessionStatus = "ACTIVE"
+	SessionStatusInactive SessionStatus = "INACTIVE"
+	SessionStatusKilled   SessionStatus = "KILLED"
+)
+
+var AllSessionStatus = []SessionStatus{
+	SessionStatusActive,
+	SessionStatusInactive,
+	SessionStatusKilled,
+}
+
+func (e SessionStatus) IsValid() bool {
+	switch e {
+	case SessionStatusActive, SessionStatusInactive, SessionStatusKilled:
+		return true
+	}
+	return false
+}
+
+func (e SessionStatus) String() string {
+	return string(e)
+}
+
+func (e *SessionStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SessionStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SessionStatus", str)
+	}
+	return nil
+}
+
+func (e SessionStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *SessionStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e SessionStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type SQLSortField string
+
+const (
+	SQLSortFieldElapsedTime SQLSortField = "ELAPSED_TIME"
+	SQLSortFieldCPUTime     SQLSortField = "CPU_TIME"
+	SQLSortFieldExecutions  SQLSortField = "EXECUTIONS"
+	SQLSortFieldDiskReads   SQLSortField = "DISK_READS"
+)
+
+var AllSQLSortField = []SQLSortField{
+	SQLSortFieldElapsedTime,
+	SQLSortFieldCPUTime,
+	SQLSortFieldExecutions,
+	SQLSortFieldDiskReads,
+}
+
+func (e SQLSortField) IsValid() bool {
+	switch e {
+	case SQLSortFieldElapsedTime, SQLSortFieldCPUTime, SQLSortFieldExecutions, SQLSortFieldDiskReads:
+		return true
+	}
+	return false
+}
+
+func (e SQLSortField) String() string {
+	return string(e)
+}
+
+func (e *SQLSortField) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SQLSortField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SqlSortField", str)
+	}
+	return nil
+}
+
+func (e SQLSortField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *SQLSortField) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e SQLSortField) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type StatisticsStatus string
+
+const (
+	StatisticsStatusCurrent StatisticsStatus = "CURRENT"
+	StatisticsStatusStale   StatisticsStatus = "STALE"
+	StatisticsStatusMissing StatisticsStatus = "MISSING"
+)
+
+var AllStatisticsStatus = []StatisticsStatus{
+	StatisticsStatusCurrent,
+	StatisticsStatusStale,
+	StatisticsStatusMissing,
+}
+
+func (e StatisticsStatus) IsValid() bool {
+	switch e {
+	case StatisticsStatusCurrent, StatisticsStatusStale, StatisticsStatusMissing:
+		return true
+	}
+	return false
+}
+
+func (e StatisticsStatus) String() string {
+	return string(e)
+}
+
+func (e *StatisticsStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = StatisticsStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid StatisticsStatus", str)
+	}
+	return nil
+}
+
+func (e StatisticsStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *StatisticsStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e StatisticsStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type TablespaceContents string
+
+const (
+	TablespaceContentsPermanent TablespaceContents = "PERMANENT"
+	TablespaceContentsTemporary TablespaceContents = "TEMPORARY"
+	TablespaceContentsUndo      TablespaceContents = "UNDO"
+)
+
+var AllTablespaceContents = []TablespaceContents{
+	TablespaceContentsPermanent,
+	TablespaceContentsTemporary,
+	TablespaceContentsUndo,
+}
+
+func (e TablespaceContents) IsValid() bool {
+	switch e {
+	case TablespaceContentsPermanent, TablespaceContentsTemporary, TablespaceContentsUndo:
+		return true
+	}
+	return false
+}
+
+func (e TablespaceContents) String() string {
+	return string(e)
+}
+
+func (e *TablespaceContents) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TablespaceContents(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TablespaceContents", str)
+	}
+	return nil
+}
+
+func (e TablespaceContents) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *TablespaceContents) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e TablespaceContents) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type UndoRisk string
+
+const (
+	UndoRiskLow    UndoRisk = "LOW"
+	UndoRiskMedium UndoRisk = "MEDIUM"
+	UndoRiskHigh   UndoRisk = "HIGH"
+)
+
+var AllUndoRisk = []UndoRisk{
+	UndoRiskLow,
+	UndoRiskMedium,
+	UndoRiskHigh,
+}
+
+func (e UndoRisk) IsValid() bool {
+	switch e {
+	case UndoRiskLow, UndoRiskMedium, UndoRiskHigh:
+		return true
+	}
+	return false
+}
+
+func (e UndoRisk) String() string {
+	return string(e)
+}
+
+func (e *UndoRisk) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = UndoRisk(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid UndoRisk", str)
+	}
+	return nil
+}
+
+func (e UndoRisk) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *UndoRisk) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e UndoRisk) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
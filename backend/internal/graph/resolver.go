@@ -6,17 +6,35 @@ type Resolver struct {
     authService   *service.AuthService
     rbacService   *service.RBACService
     oracleService *service.OracleService
+    alertService  *service.AlertService
+    notificationService *service.NotificationService
+    silenceService *service.SilenceService
+    ashService    *service.ASHService
+    systemStatsService *service.SystemStatsService
+    historyService *service.HistoryService
 }
 
 func NewResolver(
     authService *service.AuthService,
     rbacService *service.RBACService,
     oracleService *service.OracleService,
+    alertService *service.AlertService,
+    notificationService *service.NotificationService,
+    silenceService *service.SilenceService,
+    ashService *service.ASHService,
+    systemStatsService *service.SystemStatsService,
+    historyService *service.HistoryService,
 ) *Resolver {
     return &Resolver{
         authService:   authService,
         rbacService:   rbacService,
         oracleService: oracleService,
+        alertService:  alertService,
+        notificationService: notificationService,
+        silenceService: silenceService,
+        ashService:    ashService,
+        systemStatsService: systemStatsService,
+        historyService: historyService,
     }
 }
 
@@ -7,12 +7,36 @@ package graph
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/aashiq-04/oracle-dba/internal/graph/model"
 	"github.com/aashiq-04/oracle-dba/internal/middleware"
+	"github.com/aashiq-04/oracle-dba/internal/repository"
+	"github.com/aashiq-04/oracle-dba/internal/service"
+	"github.com/aashiq-04/oracle-dba/pkg/oracle"
 	"github.com/google/uuid"
 )
 
+// AcknowledgeAlert is the resolver for the acknowledgeAlert field.
+func (r *mutationResolver) AcknowledgeAlert(ctx context.Context, id string) (*model.Alert, error) {
+	if err := middleware.RequirePermission(ctx, "MANAGE_ALERTS"); err != nil {
+		return nil, err
+	}
+
+	alertID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid alert ID: %w", err)
+	}
+
+	userCtx := middleware.MustGetUserFromContext(ctx)
+	alert, err := r.alertService.AcknowledgeAlert(ctx, userCtx.UserID, userCtx.Username, alertID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to acknowledge alert: %w", err)
+	}
+
+	return toModelAlert(alert), nil
+}
+
 // AssignRole is the resolver for the assignRole field.
 func (r *mutationResolver) AssignRole(ctx context.Context, userID string, roleID string) (*model.User, error) {
 	if err := middleware.RequirePermission(ctx, "MANAGE_USERS"); err != nil {
@@ -21,6 +45,79 @@ func (r *mutationResolver) AssignRole(ctx context.Context, userID string, roleID
 	return nil, fmt.Errorf("not implemented: AssignRole")
 }
 
+// CreateAlertRule is the resolver for the createAlertRule field.
+func (r *mutationResolver) CreateAlertRule(ctx context.Context, input model.AlertRuleInput) (*model.AlertRule, error) {
+	if err := middleware.RequirePermission(ctx, "MANAGE_ALERTS"); err != nil {
+		return nil, err
+	}
+
+	rule, err := alertRuleFromInput(input)
+	if err != nil {
+		return nil, err
+	}
+
+	userCtx := middleware.MustGetUserFromContext(ctx)
+	if err := r.alertService.CreateRule(ctx, userCtx.UserID, rule); err != nil {
+		return nil, err
+	}
+
+	return toModelAlertRule(rule), nil
+}
+
+// CreateMaintenanceWindow is the resolver for the createMaintenanceWindow field.
+func (r *mutationResolver) CreateMaintenanceWindow(ctx context.Context, input model.MaintenanceWindowInput) (*model.MaintenanceWindow, error) {
+	if err := middleware.RequirePermission(ctx, "MANAGE_ALERTS"); err != nil {
+		return nil, err
+	}
+
+	window, err := maintenanceWindowFromInput(input)
+	if err != nil {
+		return nil, err
+	}
+
+	userCtx := middleware.MustGetUserFromContext(ctx)
+	if err := r.silenceService.CreateMaintenanceWindow(ctx, userCtx.UserID, window); err != nil {
+		return nil, err
+	}
+
+	return toModelMaintenanceWindow(window), nil
+}
+
+// CreateNotificationChannel is the resolver for the createNotificationChannel field.
+func (r *mutationResolver) CreateNotificationChannel(ctx context.Context, input model.NotificationChannelInput) (*model.NotificationChannel, error) {
+	if err := middleware.RequirePermission(ctx, "MANAGE_ALERTS"); err != nil {
+		return nil, err
+	}
+
+	channel := notificationChannelFromInput(input)
+
+	userCtx := middleware.MustGetUserFromContext(ctx)
+	if err := r.notificationService.CreateChannel(ctx, userCtx.UserID, channel); err != nil {
+		return nil, err
+	}
+
+	return toModelNotificationChannel(channel), nil
+}
+
+// CreateSilence is the resolver for the createSilence field.
+func (r *mutationResolver) CreateSilence(ctx context.Context, input model.SilenceInput) (*model.Silence, error) {
+	if err := middleware.RequirePermission(ctx, "MANAGE_ALERTS"); err != nil {
+		return nil, err
+	}
+
+	silence, err := silenceFromInput(input)
+	if err != nil {
+		return nil, err
+	}
+
+	userCtx := middleware.MustGetUserFromContext(ctx)
+	if err := r.silenceService.CreateSilence(ctx, userCtx.UserID, userCtx.Username, silence); err != nil {
+		return nil, err
+	}
+
+	return toModelSilence(silence), nil
+}
+
 // CreateUser is the resolver for the createUser field.
 func (r *mutationResolver) CreateUser(ctx context.Context, input model.CreateUserInput) (*model.User, error) {
 	if err := middleware.RequirePermission(ctx, "MANAGE_USERS"); err != nil {
@@ -66,6 +163,63 @@ func (r *mutationResolver) CreateUser(ctx context.Context, input model.CreateUse
 	}, nil
 }
 
+// DeleteAlertRule is the resolver for the deleteAlertRule field.
+func (r *mutationResolver) DeleteAlertRule(ctx context.Context, id string) (bool, error) {
+	if err := middleware.RequirePermission(ctx, "MANAGE_ALERTS"); err != nil {
+		return false, err
+	}
+
+	ruleID, err := uuid.Parse(id)
+	if err != nil {
+		return false, fmt.Errorf("invalid rule ID: %w", err)
+	}
+
+	userCtx := middleware.MustGetUserFromContext(ctx)
+	if err := r.alertService.DeleteRule(ctx, userCtx.UserID, ruleID); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// DeleteMaintenanceWindow is the resolver for the deleteMaintenanceWindow field.
+func (r *mutationResolver) DeleteMaintenanceWindow(ctx context.Context, id string) (bool, error) {
+	if err := middleware.RequirePermission(ctx, "MANAGE_ALERTS"); err != nil {
+		return false, err
+	}
+
+	windowID, err := uuid.Parse(id)
+	if err != nil {
+		return false, fmt.Errorf("invalid maintenance window ID: %w", err)
+	}
+
+	userCtx := middleware.MustGetUserFromContext(ctx)
+	if err := r.silenceService.DeleteMaintenanceWindow(ctx, userCtx.UserID, windowID); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// DeleteNotificationChannel is the resolver for the deleteNotificationChannel field.
+func (r *mutationResolver) DeleteNotificationChannel(ctx context.Context, id string) (bool, error) {
+	if err := middleware.RequirePermission(ctx, "MANAGE_ALERTS"); err != nil {
+		return false, err
+	}
+
+	channelID, err := uuid.Parse(id)
+	if err != nil {
+		return false, fmt.Errorf("invalid channel ID: %w", err)
+	}
+
+	userCtx := middleware.MustGetUserFromContext(ctx)
+	if err := r.notificationService.DeleteChannel(ctx, userCtx.UserID, channelID); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
 // DeleteUser is the resolver for the deleteUser field.
 func (r *mutationResolver) DeleteUser(ctx context.Context, userID string) (bool, error) {
 	if err := middleware.RequirePermission(ctx, "MANAGE_USERS"); err != nil {
@@ -74,12 +228,56 @@ func (r *mutationResolver) DeleteUser(ctx context.Context, userID string) (bool,
 	return false, fmt.Errorf("not implemented: DeleteUser")
 }
 
+// ExpireSilence is the resolver for the expireSilence field.
+func (r *mutationResolver) ExpireSilence(ctx context.Context, id string) (*model.Silence, error) {
+	if err := middleware.RequirePermission(ctx, "MANAGE_ALERTS"); err != nil {
+		return nil, err
+	}
+
+	silenceID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid silence ID: %w", err)
+	}
+
+	userCtx := middleware.MustGetUserFromContext(ctx)
+	silence, err := r.silenceService.ExpireSilence(ctx, userCtx.UserID, silenceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toModelSilence(silence), nil
+}
+
+// GatherTableStats is the resolver for the gatherTableStats field.
+func (r *mutationResolver) GatherTableStats(ctx context.Context, input model.GatherTableStatsInput) (*model.TableStatistics, error) {
+	if err := middleware.RequirePermission(ctx, "GATHER_STATS"); err != nil {
+		return nil, err
+	}
+
+	userCtx := middleware.MustGetUserFromContext(ctx)
+	stats, err := r.oracleService.GatherTableStats(ctx, userCtx.UserID, input.Owner, input.TableName, service.GatherStatsOptions{
+		EstimatePercent: input.EstimatePercent,
+		Cascade:         input.Cascade,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toModelTableStatistics(stats), nil
+}
+
 // KillSession is the resolver for the killSession field.
-func (r *mutationResolver) KillSession(ctx context.Context, sid int, serial int) (bool, error) {
+func (r *mutationResolver) KillSession(ctx context.Context, sid int, serial int, instID *int) (bool, error) {
 	if err := middleware.RequirePermission(ctx, "SESSION_KILL"); err != nil {
 		return false, err
 	}
-	return false, fmt.Errorf("not implemented: KillSession - would execute ALTER SYSTEM KILL SESSION")
+
+	userCtx := middleware.MustGetUserFromContext(ctx)
+	if err := r.oracleService.KillSession(ctx, userCtx.UserID, sid, serial, instID); err != nil {
+		return false, err
+	}
+
+	return true, nil
 }
 
 // Login is the resolver for the login field.
@@ -121,6 +319,41 @@ func (r *mutationResolver) Logout(ctx context.Context) (bool, error) {
 	return true, nil
 }
 
+// RecompileObjects is the resolver for the recompileObjects field.
+func (r *mutationResolver) RecompileObjects(ctx context.Context, schema string, objects []string) ([]*model.RecompileResult, error) {
+	if err := middleware.RequirePermission(ctx, "RECOMPILE_OBJECTS"); err != nil {
+		return nil, err
+	}
+
+	userCtx := middleware.MustGetUserFromContext(ctx)
+	results, err := r.oracleService.RecompileObjects(ctx, userCtx.UserID, schema, objects)
+	if err != nil {
+		return nil, err
+	}
+
+	return toModelRecompileResults(results), nil
+}
+
+// ResolveAlert is the resolver for the resolveAlert field.
+func (r *mutationResolver) ResolveAlert(ctx context.Context, id string) (*model.Alert, error) {
+	if err := middleware.RequirePermission(ctx, "MANAGE_ALERTS"); err != nil {
+		return nil, err
+	}
+
+	alertID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid alert ID: %w", err)
+	}
+
+	userCtx := middleware.MustGetUserFromContext(ctx)
+	alert, err := r.alertService.ResolveAlert(ctx, userCtx.UserID, alertID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve alert: %w", err)
+	}
+
+	return toModelAlert(alert), nil
+}
+
 // RevokeRole is the resolver for the revokeRole field.
 func (r *mutationResolver) RevokeRole(ctx context.Context, userID string, roleID string) (*model.User, error) {
 	if err := middleware.RequirePermission(ctx, "MANAGE_USERS"); err != nil {
@@ -129,188 +362,860 @@ func (r *mutationResolver) RevokeRole(ctx context.Context, userID string, roleID
 	return nil, fmt.Errorf("not implemented: RevokeRole")
 }
 
-// UpdateUser is the resolver for the updateUser field.
-func (r *mutationResolver) UpdateUser(ctx context.Context, input model.UpdateUserInput) (*model.User, error) {
-	if err := middleware.RequirePermission(ctx, "MANAGE_USERS"); err != nil {
+// SendTestNotification is the resolver for the sendTestNotification field.
+func (r *mutationResolver) SendTestNotification(ctx context.Context, channelID string) (*model.NotificationResult, error) {
+	if err := middleware.RequirePermission(ctx, "MANAGE_ALERTS"); err != nil {
 		return nil, err
 	}
-	return nil, fmt.Errorf("not implemented: UpdateUser")
+
+	id, err := uuid.Parse(channelID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid channel ID: %w", err)
+	}
+
+	userCtx := middleware.MustGetUserFromContext(ctx)
+	result, err := r.notificationService.SendTestNotification(ctx, userCtx.UserID, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send test notification: %w", err)
+	}
+
+	return &model.NotificationResult{
+		Success:  result.Success,
+		Attempts: result.Attempts,
+		Error:    result.Error,
+	}, nil
 }
 
-// ActiveSessions is the resolver for the activeSessions field.
-func (r *queryResolver) ActiveSessions(ctx context.Context, filter *model.SessionFilterInput) ([]*model.OracleSession, error) {
-	if err := middleware.RequirePermission(ctx, "VIEW_SESSIONS"); err != nil {
+// UpdateAlertRule is the resolver for the updateAlertRule field.
+func (r *mutationResolver) UpdateAlertRule(ctx context.Context, id string, input model.AlertRuleInput) (*model.AlertRule, error) {
+	if err := middleware.RequirePermission(ctx, "MANAGE_ALERTS"); err != nil {
 		return nil, err
 	}
 
-	userCtx := middleware.MustGetUserFromContext(ctx)
-	sessions, err := r.oracleService.GetActiveSessions(ctx, userCtx.UserID)
+	ruleID, err := uuid.Parse(id)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get active sessions: %w", err)
+		return nil, fmt.Errorf("invalid rule ID: %w", err)
 	}
 
-	result := make([]*model.OracleSession, len(sessions))
-	for i, session := range sessions {
-		status := model.SessionStatus(session.Status)
-		result[i] = &model.OracleSession{
-			Sid:             session.SID,
-			Serial:          session.Serial,
-			Username:        session.Username,
-			SchemaName:      session.SchemaName,
-			OsUser:          session.OSUser,
-			Machine:         session.Machine,
-			Program:         session.Program,
-			Status:          status,
-			SQLID:           session.SQLID,
-			SQLText:         session.SQLText,
-			LogonTime:       session.LogonTime,
-			LastCallSeconds: session.LastCallET,
-			BlockingSession: session.BlockingSession,
-			WaitClass:       session.WaitClass,
-			Event:           session.Event,
-			SecondsInWait:   session.SecondsInWait,
-		}
+	existing, err := r.alertService.GetRule(ctx, ruleID)
+	if err != nil {
+		return nil, err
 	}
 
-	return result, nil
-}
+	rule, err := alertRuleFromInput(input)
+	if err != nil {
+		return nil, err
+	}
+	rule.ID = existing.ID
+	rule.CreatedBy = existing.CreatedBy
+	rule.CreatedAt = existing.CreatedAt
 
-// AuditLog is the resolver for the auditLog field.
-func (r *queryResolver) AuditLog(ctx context.Context, id string) (*model.AuditLog, error) {
-	if err := middleware.RequirePermission(ctx, "AUDIT_READ"); err != nil {
+	userCtx := middleware.MustGetUserFromContext(ctx)
+	if err := r.alertService.UpdateRule(ctx, userCtx.UserID, rule); err != nil {
 		return nil, err
 	}
-	return nil, fmt.Errorf("not implemented: AuditLog")
+
+	return toModelAlertRule(rule), nil
 }
 
-// AuditLogs is the resolver for the auditLogs field.
-func (r *queryResolver) AuditLogs(ctx context.Context, filter *model.AuditLogFilterInput, limit int, offset int) ([]*model.AuditLog, error) {
-	if err := middleware.RequirePermission(ctx, "AUDIT_READ"); err != nil {
+// UpdateMaintenanceWindow is the resolver for the updateMaintenanceWindow field.
+func (r *mutationResolver) UpdateMaintenanceWindow(ctx context.Context, id string, input model.MaintenanceWindowInput) (*model.MaintenanceWindow, error) {
+	if err := middleware.RequirePermission(ctx, "MANAGE_ALERTS"); err != nil {
 		return nil, err
 	}
-	return nil, fmt.Errorf("not implemented: AuditLogs")
-}
 
-// BlockingSessions is the resolver for the blockingSessions field.
-func (r *queryResolver) BlockingSessions(ctx context.Context) ([]*model.BlockingSession, error) {
-	if err := middleware.RequirePermission(ctx, "VIEW_LOCKS"); err != nil {
+	windowID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid maintenance window ID: %w", err)
+	}
+
+	existing, err := r.silenceService.GetMaintenanceWindow(ctx, windowID)
+	if err != nil {
+		return nil, err
+	}
+
+	window, err := maintenanceWindowFromInput(input)
+	if err != nil {
 		return nil, err
 	}
+	window.ID = existing.ID
+	window.CreatedBy = existing.CreatedBy
+	window.CreatedAt = existing.CreatedAt
 
 	userCtx := middleware.MustGetUserFromContext(ctx)
-	blockingSessions, err := r.oracleService.GetBlockingSessions(ctx, userCtx.UserID)
+	if err := r.silenceService.UpdateMaintenanceWindow(ctx, userCtx.UserID, window); err != nil {
+		return nil, err
+	}
+
+	return toModelMaintenanceWindow(window), nil
+}
+
+// UpdateNotificationChannel is the resolver for the updateNotificationChannel field.
+func (r *mutationResolver) UpdateNotificationChannel(ctx context.Context, id string, input model.NotificationChannelInput) (*model.NotificationChannel, error) {
+	if err := middleware.RequirePermission(ctx, "MANAGE_ALERTS"); err != nil {
+		return nil, err
+	}
+
+	channelID, err := uuid.Parse(id)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get blocking sessions: %w", err)
+		return nil, fmt.Errorf("invalid channel ID: %w", err)
 	}
 
-	result := make([]*model.BlockingSession, len(blockingSessions))
-	for i, bs := range blockingSessions {
-		blockingStatus := model.SessionStatus(bs.BlockingStatus)
-		result[i] = &model.BlockingSession{
-			BlockingSid:            bs.BlockingSID,
-			BlockingSerial:         bs.BlockingSerial,
-			BlockingUser:           bs.BlockingUser,
-			BlockingSchema:         bs.BlockingSchema,
-			BlockingStatus:         blockingStatus,
-			BlockingSQLID:          bs.BlockingSQLID,
-			BlockingSQLText:        bs.BlockingSQLText,
-			BlockedSid:             bs.BlockedSID,
-			BlockedSerial:          bs.BlockedSerial,
-			BlockedUser:            bs.BlockedUser,
-			BlockedSchema:          bs.BlockedSchema,
-			BlockedWaitClass:       bs.BlockedWaitClass,
-			BlockedEvent:           bs.BlockedEvent,
-			BlockedDurationSeconds: bs.BlockedDurationSeconds,
-			BlockedSQLText:         bs.BlockedSQLText,
-		}
+	existing, err := r.notificationService.GetChannel(ctx, channelID)
+	if err != nil {
+		return nil, err
 	}
 
-	return result, nil
+	channel := notificationChannelFromInput(input)
+	channel.ID = existing.ID
+	channel.CreatedBy = existing.CreatedBy
+	channel.CreatedAt = existing.CreatedAt
+
+	userCtx := middleware.MustGetUserFromContext(ctx)
+	if err := r.notificationService.UpdateChannel(ctx, userCtx.UserID, channel); err != nil {
+		return nil, err
+	}
+
+	return toModelNotificationChannel(channel), nil
 }
 
-// DatabaseInstance is the resolver for the databaseInstance field.
-func (r *queryResolver) DatabaseInstance(ctx context.Context) (*model.DatabaseInstance, error) {
-	if _, err := middleware.RequireAuth(ctx); err != nil {
+// UpdateUser is the resolver for the updateUser field.
+func (r *mutationResolver) UpdateUser(ctx context.Context, input model.UpdateUserInput) (*model.User, error) {
+	if err := middleware.RequirePermission(ctx, "MANAGE_USERS"); err != nil {
+		return nil, err
+	}
+	return nil, fmt.Errorf("not implemented: UpdateUser")
+}
+
+// ActiveSessions is the resolver for the activeSessions field.
+func (r *queryResolver) ActiveSessions(ctx context.Context, filter *model.SessionFilterInput, sort *model.SessionSortInput, limit *int, offset *int, after *string, pdb *string) (*model.SessionPage, error) {
+	if err := middleware.RequirePermission(ctx, "VIEW_SESSIONS"); err != nil {
 		return nil, err
 	}
 
+	opts := sessionListOptions(filter, sort, limit, offset, after, oracle.SessionSortIdleTime)
+	opts.Filter.Status = string(model.SessionStatusActive)
+	opts.Filter.PDB = derefString(pdb)
+
 	userCtx := middleware.MustGetUserFromContext(ctx)
-	instance, err := r.oracleService.GetDatabaseInstance(ctx, userCtx.UserID)
+	page, err := r.oracleService.ListSessions(ctx, userCtx.UserID, opts)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get database instance: %w", err)
+		return nil, fmt.Errorf("failed to get active sessions: %w", err)
 	}
 
-	return &model.DatabaseInstance{
-		InstanceName:   instance.InstanceName,
-		HostName:       instance.HostName,
-		Version:        instance.Version,
-		StartupTime:    instance.StartupTime,
-		Status:         instance.Status,
-		DatabaseStatus: instance.DatabaseStatus,
-		InstanceRole:   instance.InstanceRole,
-		UptimeDays:     instance.UptimeDays,
-	}, nil
+	return toModelSessionPage(page), nil
 }
 
-// DatabaseSize is the resolver for the databaseSize field.
-func (r *queryResolver) DatabaseSize(ctx context.Context) (*model.DatabaseSize, error) {
-	if _, err := middleware.RequireAuth(ctx); err != nil {
+// ActiveTransactions is the resolver for the activeTransactions field.
+func (r *queryResolver) ActiveTransactions(ctx context.Context, limit *int, pdb *string) ([]*model.ActiveTransaction, error) {
+	if err := middleware.RequirePermission(ctx, "VIEW_SESSIONS"); err != nil {
 		return nil, err
 	}
-	return nil, fmt.Errorf("not implemented: DatabaseSize")
+
+	userCtx := middleware.MustGetUserFromContext(ctx)
+	transactions, err := r.oracleService.GetActiveTransactions(ctx, userCtx.UserID, limitOrDefault(limit, 20), derefString(pdb))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get active transactions: %w", err)
+	}
+
+	return toModelActiveTransactions(transactions), nil
+}
+
+// Alert is the resolver for the alert field.
+func (r *queryResolver) Alert(ctx context.Context, id string) (*model.Alert, error) {
+	if err := middleware.RequirePermission(ctx, "VIEW_ALERTS"); err != nil {
+		return nil, err
+	}
+
+	alertID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid alert ID: %w", err)
+	}
+
+	alert, err := r.alertService.GetAlert(ctx, alertID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toModelAlert(alert), nil
+}
+
+// AlertHistory is the resolver for the alertHistory field.
+func (r *queryResolver) AlertHistory(ctx context.Context, id string) ([]*model.AlertNotification, error) {
+	if err := middleware.RequirePermission(ctx, "VIEW_ALERTS"); err != nil {
+		return nil, err
+	}
+
+	alertID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid alert ID: %w", err)
+	}
+
+	history, err := r.notificationService.AlertHistory(ctx, alertID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get alert history: %w", err)
+	}
+
+	result := make([]*model.AlertNotification, len(history))
+	for i, n := range history {
+		result[i] = toModelAlertNotification(n)
+	}
+
+	return result, nil
+}
+
+// AlertRule is the resolver for the alertRule field.
+func (r *queryResolver) AlertRule(ctx context.Context, id string) (*model.AlertRule, error) {
+	if err := middleware.RequirePermission(ctx, "VIEW_ALERTS"); err != nil {
+		return nil, err
+	}
+
+	ruleID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid rule ID: %w", err)
+	}
+
+	rule, err := r.alertService.GetRule(ctx, ruleID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toModelAlertRule(rule), nil
+}
+
+// AlertRules is the resolver for the alertRules field.
+func (r *queryResolver) AlertRules(ctx context.Context) ([]*model.AlertRule, error) {
+	if err := middleware.RequirePermission(ctx, "VIEW_ALERTS"); err != nil {
+		return nil, err
+	}
+
+	rules, err := r.alertService.ListRules(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get alert rules: %w", err)
+	}
+
+	result := make([]*model.AlertRule, len(rules))
+	for i, rule := range rules {
+		result[i] = toModelAlertRule(rule)
+	}
+	return result, nil
+}
+
+// Alerts is the resolver for the alerts field.
+func (r *queryResolver) Alerts(ctx context.Context, filter *model.AlertFilterInput, limit int, offset int) ([]*model.Alert, error) {
+	if err := middleware.RequirePermission(ctx, "VIEW_ALERTS"); err != nil {
+		return nil, err
+	}
+
+	alertFilter := &repository.AlertFilter{
+		Limit:  limit,
+		Offset: offset,
+	}
+	if filter != nil {
+		ruleID, err := parseOptionalUUID(filter.RuleID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rule ID: %w", err)
+		}
+		alertFilter.RuleID = ruleID
+		alertFilter.Target = filter.Target
+		if filter.Status != nil {
+			status := string(*filter.Status)
+			alertFilter.Status = &status
+		}
+		if filter.Severity != nil {
+			severity := string(*filter.Severity)
+			alertFilter.Severity = &severity
+		}
+	}
+
+	alerts, err := r.alertService.ListAlerts(ctx, alertFilter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get alerts: %w", err)
+	}
+
+	result := make([]*model.Alert, len(alerts))
+	for i, alert := range alerts {
+		result[i] = toModelAlert(alert)
+	}
+	return result, nil
+}
+
+// AshDbTimeByWaitClass is the resolver for the ashDbTimeByWaitClass field.
+func (r *queryResolver) AshDbTimeByWaitClass(ctx context.Context, minutes int) ([]*model.AshBreakdown, error) {
+	if err := middleware.RequirePermission(ctx, "VIEW_SESSIONS"); err != nil {
+		return nil, err
+	}
+
+	breakdown, err := r.ashService.DBTimeByWaitClass(ctx, minutes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DB time by wait class: %w", err)
+	}
+
+	return toModelAshBreakdowns(breakdown), nil
+}
+
+// AshTopEvents is the resolver for the ashTopEvents field.
+func (r *queryResolver) AshTopEvents(ctx context.Context, timeRange model.TimeRangeInput, limit *int) ([]*model.AshBreakdown, error) {
+	if err := middleware.RequirePermission(ctx, "VIEW_SESSIONS"); err != nil {
+		return nil, err
+	}
+
+	breakdown, err := r.ashService.TopEvents(ctx, timeRange.StartTime, timeRange.EndTime, limitOrDefault(limit, 10))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get top events: %w", err)
+	}
+
+	return toModelAshBreakdowns(breakdown), nil
+}
+
+// AshTopSessions is the resolver for the ashTopSessions field.
+func (r *queryResolver) AshTopSessions(ctx context.Context, timeRange model.TimeRangeInput, limit *int) ([]*model.AshBreakdown, error) {
+	if err := middleware.RequirePermission(ctx, "VIEW_SESSIONS"); err != nil {
+		return nil, err
+	}
+
+	breakdown, err := r.ashService.TopSessions(ctx, timeRange.StartTime, timeRange.EndTime, limitOrDefault(limit, 10))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get top sessions: %w", err)
+	}
+
+	return toModelAshBreakdowns(breakdown), nil
+}
+
+// AshTopSQL is the resolver for the ashTopSql field.
+func (r *queryResolver) AshTopSQL(ctx context.Context, timeRange model.TimeRangeInput, limit *int) ([]*model.AshBreakdown, error) {
+	if err := middleware.RequirePermission(ctx, "VIEW_SQL"); err != nil {
+		return nil, err
+	}
+
+	breakdown, err := r.ashService.TopSQL(ctx, timeRange.StartTime, timeRange.EndTime, limitOrDefault(limit, 10))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get top SQL: %w", err)
+	}
+
+	return toModelAshBreakdowns(breakdown), nil
+}
+
+// AsmDiskGroups is the resolver for the asmDiskGroups field.
+func (r *queryResolver) AsmDiskGroups(ctx context.Context) ([]*model.AsmDiskGroup, error) {
+	if err := middleware.RequirePermission(ctx, "VIEW_TABLESPACES"); err != nil {
+		return nil, err
+	}
+
+	userCtx := middleware.MustGetUserFromContext(ctx)
+	groups, err := r.oracleService.GetASMDiskGroups(ctx, userCtx.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ASM disk groups: %w", err)
+	}
+
+	result := make([]*model.AsmDiskGroup, len(groups))
+	for i, g := range groups {
+		result[i] = toModelASMDiskGroup(g)
+	}
+
+	return result, nil
+}
+
+// AuditLog is the resolver for the auditLog field.
+func (r *queryResolver) AuditLog(ctx context.Context, id string) (*model.AuditLog, error) {
+	if err := middleware.RequirePermission(ctx, "AUDIT_READ"); err != nil {
+		return nil, err
+	}
+	return nil, fmt.Errorf("not implemented: AuditLog")
+}
+
+// AuditLogs is the resolver for the auditLogs field.
+func (r *queryResolver) AuditLogs(ctx context.Context, filter *model.AuditLogFilterInput, limit int, offset int) ([]*model.AuditLog, error) {
+	if err := middleware.RequirePermission(ctx, "AUDIT_READ"); err != nil {
+		return nil, err
+	}
+	return nil, fmt.Errorf("not implemented: AuditLogs")
+}
+
+// AutoStatsJobHistory is the resolver for the autoStatsJobHistory field.
+func (r *queryResolver) AutoStatsJobHistory(ctx context.Context, timeRange model.TimeRangeInput) (*model.AutoStatsJobHistory, error) {
+	if err := middleware.RequirePermission(ctx, "VIEW_SCHEMA"); err != nil {
+		return nil, err
+	}
+
+	userCtx := middleware.MustGetUserFromContext(ctx)
+	history, err := r.oracleService.GetAutoStatsJobHistory(ctx, userCtx.UserID, timeRange.StartTime, timeRange.EndTime)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get automatic statistics job history: %w", err)
+	}
+
+	return toModelAutoStatsJobHistory(history), nil
+}
+
+// BackupStatus is the resolver for the backupStatus field.
+func (r *queryResolver) BackupStatus(ctx context.Context, days *int, fullBackupMaxAgeDays *int, archivelogBackupMaxAgeHours *int) (*model.BackupStatus, error) {
+	if err := middleware.RequirePermission(ctx, "VIEW_BACKUPS"); err != nil {
+		return nil, err
+	}
+
+	userCtx := middleware.MustGetUserFromContext(ctx)
+	status, err := r.oracleService.GetBackupStatus(ctx, userCtx.UserID,
+		limitOrDefault(days, 7),
+		limitOrDefault(fullBackupMaxAgeDays, service.DefaultFullBackupMaxAgeDays),
+		limitOrDefault(archivelogBackupMaxAgeHours, service.DefaultArchivelogBackupMaxAgeHours))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get backup status: %w", err)
+	}
+
+	return toModelBackupStatus(status), nil
+}
+
+// BlockingSessions is the resolver for the blockingSessions field.
+func (r *queryResolver) BlockingSessions(ctx context.Context, pdb *string) ([]*model.BlockingSession, error) {
+	if err := middleware.RequirePermission(ctx, "VIEW_LOCKS"); err != nil {
+		return nil, err
+	}
+
+	userCtx := middleware.MustGetUserFromContext(ctx)
+	blockingSessions, err := r.oracleService.GetBlockingSessions(ctx, userCtx.UserID, derefString(pdb))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get blocking sessions: %w", err)
+	}
+
+	result := make([]*model.BlockingSession, len(blockingSessions))
+	for i, bs := range blockingSessions {
+		blockingStatus := model.SessionStatus(bs.BlockingStatus)
+		result[i] = &model.BlockingSession{
+			BlockingSid:            bs.BlockingSID,
+			BlockingSerial:         bs.BlockingSerial,
+			BlockingUser:           bs.BlockingUser,
+			BlockingSchema:         bs.BlockingSchema,
+			BlockingStatus:         blockingStatus,
+			BlockingSQLID:          bs.BlockingSQLID,
+			BlockingSQLText:        bs.BlockingSQLText,
+			BlockedSid:             bs.BlockedSID,
+			BlockedSerial:          bs.BlockedSerial,
+			BlockedUser:            bs.BlockedUser,
+			BlockedSchema:          bs.BlockedSchema,
+			BlockedWaitClass:       bs.BlockedWaitClass,
+			BlockedEvent:           bs.BlockedEvent,
+			BlockedDurationSeconds: bs.BlockedDurationSeconds,
+			BlockedSQLText:         bs.BlockedSQLText,
+			BlockingInstID:         bs.BlockingInstID,
+			BlockedInstID:          bs.BlockedInstID,
+		}
+	}
+
+	return result, nil
+}
+
+// CompareParameters is the resolver for the compareParameters field.
+func (r *queryResolver) CompareParameters(ctx context.Context, base *model.ParameterSetInput, other model.ParameterSetInput) ([]*model.ParameterDifference, error) {
+	if err := middleware.RequirePermission(ctx, "VIEW_PARAMETERS"); err != nil {
+		return nil, err
+	}
+
+	baseSet := service.ParameterSet{}
+	if base != nil {
+		baseSet = service.ParameterSet{Target: derefString(base.Target), At: base.At}
+	}
+	otherSet := service.ParameterSet{Target: derefString(other.Target), At: other.At}
+
+	differences, err := r.historyService.CompareParameters(ctx, baseSet, otherSet)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compare parameters: %w", err)
+	}
+
+	result := make([]*model.ParameterDifference, len(differences))
+	for i, diff := range differences {
+		result[i] = toModelParameterDifference(diff)
+	}
+
+	return result, nil
+}
+
+// DataGuardLagHistory is the resolver for the dataGuardLagHistory field.
+func (r *queryResolver) DataGuardLagHistory(ctx context.Context, timeRange model.TimeRangeInput) ([]*model.DataGuardLagMetric, error) {
+	if err := middleware.RequirePermission(ctx, "VIEW_BACKUPS"); err != nil {
+		return nil, err
+	}
+
+	metrics, err := r.historyService.DataGuardLagHistory(ctx, timeRange.StartTime, timeRange.EndTime)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Data Guard lag history: %w", err)
+	}
+
+	result := make([]*model.DataGuardLagMetric, len(metrics))
+	for i, metric := range metrics {
+		result[i] = &model.DataGuardLagMetric{
+			CapturedAt:          metric.CapturedAt,
+			TransportLagSeconds: metric.TransportLagSeconds,
+			ApplyLagSeconds:     metric.ApplyLagSeconds,
+		}
+	}
+
+	return result, nil
+}
+
+// DataGuardStatus is the resolver for the dataGuardStatus field.
+func (r *queryResolver) DataGuardStatus(ctx context.Context) (*model.DataGuardStatus, error) {
+	if err := middleware.RequirePermission(ctx, "VIEW_BACKUPS"); err != nil {
+		return nil, err
+	}
+
+	userCtx := middleware.MustGetUserFromContext(ctx)
+	status, err := r.oracleService.GetDataGuardStatus(ctx, userCtx.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Data Guard status: %w", err)
+	}
+
+	return toModelDataGuardStatus(status), nil
+}
+
+// DatabaseInstance is the resolver for the databaseInstance field.
+func (r *queryResolver) DatabaseInstance(ctx context.Context) ([]*model.DatabaseInstance, error) {
+	if _, err := middleware.RequireAuth(ctx); err != nil {
+		return nil, err
+	}
+
+	userCtx := middleware.MustGetUserFromContext(ctx)
+	instances, err := r.oracleService.GetDatabaseInstances(ctx, userCtx.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database instances: %w", err)
+	}
+
+	result := make([]*model.DatabaseInstance, len(instances))
+	for i, instance := range instances {
+		result[i] = &model.DatabaseInstance{
+			InstID:         instance.InstID,
+			InstanceName:   instance.InstanceName,
+			HostName:       instance.HostName,
+			Version:        instance.Version,
+			StartupTime:    instance.StartupTime,
+			Status:         instance.Status,
+			DatabaseStatus: instance.DatabaseStatus,
+			InstanceRole:   instance.InstanceRole,
+			UptimeDays:     instance.UptimeDays,
+		}
+	}
+
+	return result, nil
+}
+
+// DatabaseSize is the resolver for the databaseSize field.
+func (r *queryResolver) DatabaseSize(ctx context.Context) (*model.DatabaseSize, error) {
+	if _, err := middleware.RequireAuth(ctx); err != nil {
+		return nil, err
+	}
+	return nil, fmt.Errorf("not implemented: DatabaseSize")
+}
+
+// DbTimeHistory is the resolver for the dbTimeHistory field.
+func (r *queryResolver) DbTimeHistory(ctx context.Context, timeRange model.TimeRangeInput) ([]*model.DbTimePoint, error) {
+	if err := middleware.RequirePermission(ctx, "VIEW_SQL"); err != nil {
+		return nil, err
+	}
+
+	points, err := r.systemStatsService.DBTimeHistory(ctx, timeRange.StartTime, timeRange.EndTime)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DB time history: %w", err)
+	}
+
+	return toModelDbTimePoints(points), nil
+}
+
+// DbTimeSummary is the resolver for the dbTimeSummary field.
+func (r *queryResolver) DbTimeSummary(ctx context.Context, minutes int) (*model.DbTimeSummary, error) {
+	if err := middleware.RequirePermission(ctx, "VIEW_SQL"); err != nil {
+		return nil, err
+	}
+
+	summary, err := r.systemStatsService.DBTimeSummary(ctx, minutes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DB time summary: %w", err)
+	}
+
+	return toModelDbTimeSummary(summary), nil
+}
+
+// ExecutionPlan is the resolver for the executionPlan field.
+func (r *queryResolver) ExecutionPlan(ctx context.Context, sqlID string, childNumber *int) (*model.ExecutionPlan, error) {
+	if err := middleware.RequirePermission(ctx, "VIEW_SQL"); err != nil {
+		return nil, err
+	}
+
+	userCtx := middleware.MustGetUserFromContext(ctx)
+	plan, err := r.oracleService.GetExecutionPlan(ctx, userCtx.UserID, sqlID, childNumber)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get execution plan: %w", err)
+	}
+
+	return toModelExecutionPlan(plan), nil
+}
+
+// IndexAnalysis is the resolver for the indexAnalysis field.
+func (r *queryResolver) IndexAnalysis(ctx context.Context, schemaName *string, pdb *string) (*model.IndexAnalysis, error) {
+	if err := middleware.RequirePermission(ctx, "VIEW_SCHEMA"); err != nil {
+		return nil, err
+	}
+
+	userCtx := middleware.MustGetUserFromContext(ctx)
+	analysis, err := r.oracleService.GetIndexAnalysis(ctx, userCtx.UserID, derefString(schemaName), derefString(pdb))
+	if err != nil {
+		return nil, fmt.Errorf("failed to analyze indexes: %w", err)
+	}
+
+	return toModelIndexAnalysis(analysis), nil
+}
+
+// InvalidObjects is the resolver for the invalidObjects field.
+func (r *queryResolver) InvalidObjects(ctx context.Context, schemaName *string, pdb *string) ([]*model.InvalidObject, error) {
+	if err := middleware.RequirePermission(ctx, "VIEW_SCHEMA"); err != nil {
+		return nil, err
+	}
+
+	userCtx := middleware.MustGetUserFromContext(ctx)
+	objects, err := r.oracleService.GetInvalidObjects(ctx, userCtx.UserID, derefString(schemaName), derefString(pdb))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get invalid objects: %w", err)
+	}
+
+	return toModelInvalidObjects(objects), nil
+}
+
+// Locks is the resolver for the locks field.
+func (r *queryResolver) Locks(ctx context.Context, schemaName *string) ([]*model.LockInfo, error) {
+	if err := middleware.RequirePermission(ctx, "VIEW_LOCKS"); err != nil {
+		return nil, err
+	}
+	return nil, fmt.Errorf("not implemented: Locks")
+}
+
+// LongOperations is the resolver for the longOperations field.
+func (r *queryResolver) LongOperations(ctx context.Context, includeCompleted *bool, pdb *string) ([]*model.LongOperation, error) {
+	if err := middleware.RequirePermission(ctx, "VIEW_SESSIONS"); err != nil {
+		return nil, err
+	}
+
+	userCtx := middleware.MustGetUserFromContext(ctx)
+	ops, err := r.oracleService.GetLongOperations(ctx, userCtx.UserID, includeCompleted != nil && *includeCompleted, derefString(pdb))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get long operations: %w", err)
+	}
+
+	result := make([]*model.LongOperation, len(ops))
+	for i, op := range ops {
+		result[i] = toModelLongOperation(op)
+	}
+
+	return result, nil
+}
+
+// MaintenanceWindows is the resolver for the maintenanceWindows field.
+func (r *queryResolver) MaintenanceWindows(ctx context.Context) ([]*model.MaintenanceWindow, error) {
+	if err := middleware.RequirePermission(ctx, "VIEW_ALERTS"); err != nil {
+		return nil, err
+	}
+
+	windows, err := r.silenceService.ListMaintenanceWindows(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list maintenance windows: %w", err)
+	}
+
+	result := make([]*model.MaintenanceWindow, len(windows))
+	for i, window := range windows {
+		result[i] = toModelMaintenanceWindow(window)
+	}
+
+	return result, nil
+}
+
+// Me is the resolver for the me field.
+func (r *queryResolver) Me(ctx context.Context) (*model.User, error) {
+	userCtx, err := middleware.RequireAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := r.authService.GetUserByID(ctx, userCtx.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	roles, err := r.rbacService.GetUserRoles(ctx, userCtx.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user roles: %w", err)
+	}
+
+	graphqlRoles := make([]*model.Role, len(roles))
+	for i, role := range roles {
+		graphqlRoles[i] = &model.Role{
+			ID:          role.ID.String(),
+			Name:        role.Name,
+			Description: role.Description,
+		}
+	}
+
+	return &model.User{
+		ID:        user.ID.String(),
+		Username:  user.Username,
+		Email:     user.Email,
+		IsActive:  user.IsActive,
+		Roles:     graphqlRoles,
+		LastLogin: user.LastLogin,
+		CreatedAt: user.CreatedAt,
+	}, nil
+}
+
+// Memory is the resolver for the memory field.
+func (r *queryResolver) Memory(ctx context.Context) (*model.MemorySummary, error) {
+	if err := middleware.RequirePermission(ctx, "VIEW_SQL"); err != nil {
+		return nil, err
+	}
+
+	userCtx := middleware.MustGetUserFromContext(ctx)
+	summary, err := r.oracleService.GetMemorySummary(ctx, userCtx.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get memory summary: %w", err)
+	}
+
+	return toModelMemorySummary(summary), nil
+}
+
+// MemoryAdvice is the resolver for the memoryAdvice field.
+func (r *queryResolver) MemoryAdvice(ctx context.Context) (*model.MemoryAdvice, error) {
+	if err := middleware.RequirePermission(ctx, "VIEW_SQL"); err != nil {
+		return nil, err
+	}
+
+	userCtx := middleware.MustGetUserFromContext(ctx)
+	advice, err := r.oracleService.GetMemoryAdvice(ctx, userCtx.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get memory advice: %w", err)
+	}
+
+	return toModelMemoryAdvice(advice), nil
+}
+
+// MemoryResizeOperations is the resolver for the memoryResizeOperations field.
+func (r *queryResolver) MemoryResizeOperations(ctx context.Context, timeRange model.TimeRangeInput) ([]*model.MemoryResizeOperation, error) {
+	if err := middleware.RequirePermission(ctx, "VIEW_SQL"); err != nil {
+		return nil, err
+	}
+
+	userCtx := middleware.MustGetUserFromContext(ctx)
+	operations, err := r.oracleService.GetMemoryResizeOperations(ctx, userCtx.UserID, timeRange.StartTime, timeRange.EndTime)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get memory resize operations: %w", err)
+	}
+
+	result := make([]*model.MemoryResizeOperation, len(operations))
+	for i, op := range operations {
+		result[i] = toModelMemoryResizeOperation(op)
+	}
+
+	return result, nil
+}
+
+// NotificationChannel is the resolver for the notificationChannel field.
+func (r *queryResolver) NotificationChannel(ctx context.Context, id string) (*model.NotificationChannel, error) {
+	if err := middleware.RequirePermission(ctx, "MANAGE_ALERTS"); err != nil {
+		return nil, err
+	}
+
+	channelID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid channel ID: %w", err)
+	}
+
+	channel, err := r.notificationService.GetChannel(ctx, channelID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toModelNotificationChannel(channel), nil
+}
+
+// NotificationChannels is the resolver for the notificationChannels field.
+func (r *queryResolver) NotificationChannels(ctx context.Context) ([]*model.NotificationChannel, error) {
+	if err := middleware.RequirePermission(ctx, "MANAGE_ALERTS"); err != nil {
+		return nil, err
+	}
+
+	channels, err := r.notificationService.ListChannels(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list notification channels: %w", err)
+	}
+
+	result := make([]*model.NotificationChannel, len(channels))
+	for i, channel := range channels {
+		result[i] = toModelNotificationChannel(channel)
+	}
+
+	return result, nil
 }
 
-// InvalidObjects is the resolver for the invalidObjects field.
-func (r *queryResolver) InvalidObjects(ctx context.Context, schemaName *string) ([]*model.InvalidObject, error) {
-	if err := middleware.RequirePermission(ctx, "VIEW_SCHEMA"); err != nil {
+// ParameterHistory is the resolver for the parameterHistory field.
+func (r *queryResolver) ParameterHistory(ctx context.Context, timeRange model.TimeRangeInput, name *string, target *string) ([]*model.ParameterChange, error) {
+	if err := middleware.RequirePermission(ctx, "VIEW_PARAMETERS"); err != nil {
 		return nil, err
 	}
-	return nil, fmt.Errorf("not implemented: InvalidObjects")
-}
 
-// Locks is the resolver for the locks field.
-func (r *queryResolver) Locks(ctx context.Context, schemaName *string) ([]*model.LockInfo, error) {
-	if err := middleware.RequirePermission(ctx, "VIEW_LOCKS"); err != nil {
-		return nil, err
+	changes, err := r.historyService.ParameterHistory(ctx, derefString(target), derefString(name), timeRange.StartTime, timeRange.EndTime)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get parameter history: %w", err)
 	}
-	return nil, fmt.Errorf("not implemented: Locks")
+
+	result := make([]*model.ParameterChange, len(changes))
+	for i, change := range changes {
+		result[i] = toModelParameterChange(change)
+	}
+
+	return result, nil
 }
 
-// Me is the resolver for the me field.
-func (r *queryResolver) Me(ctx context.Context) (*model.User, error) {
-	userCtx, err := middleware.RequireAuth(ctx)
-	if err != nil {
+// Parameters is the resolver for the parameters field.
+func (r *queryResolver) Parameters(ctx context.Context, name *string, nonDefaultOnly *bool) ([]*model.Parameter, error) {
+	if err := middleware.RequirePermission(ctx, "VIEW_PARAMETERS"); err != nil {
 		return nil, err
 	}
 
-	user, err := r.authService.GetUserByID(ctx, userCtx.UserID)
+	userCtx := middleware.MustGetUserFromContext(ctx)
+	params, err := r.oracleService.GetParameters(ctx, userCtx.UserID, derefString(name), nonDefaultOnly != nil && *nonDefaultOnly)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get user: %w", err)
+		return nil, fmt.Errorf("failed to get parameters: %w", err)
 	}
 
-	roles, err := r.rbacService.GetUserRoles(ctx, userCtx.UserID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get user roles: %w", err)
+	result := make([]*model.Parameter, len(params))
+	for i, p := range params {
+		result[i] = toModelParameter(p)
 	}
 
-	graphqlRoles := make([]*model.Role, len(roles))
-	for i, role := range roles {
-		graphqlRoles[i] = &model.Role{
-			ID:          role.ID.String(),
-			Name:        role.Name,
-			Description: role.Description,
+	return result, nil
+}
+
+// Pdbs is the resolver for the pdbs field.
+func (r *queryResolver) Pdbs(ctx context.Context) ([]*model.Pdb, error) {
+	if _, err := middleware.RequireAuth(ctx); err != nil {
+		return nil, err
+	}
+
+	userCtx := middleware.MustGetUserFromContext(ctx)
+	pdbs, err := r.oracleService.GetPDBs(ctx, userCtx.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get PDBs: %w", err)
+	}
+
+	result := make([]*model.Pdb, len(pdbs))
+	for i, p := range pdbs {
+		result[i] = &model.Pdb{
+			ConID:       p.ConID,
+			Name:        p.Name,
+			Dbid:        int(p.DBID),
+			OpenMode:    p.OpenMode,
+			Restricted:  p.Restricted,
+			OpenTime:    p.OpenTime,
+			TotalSizeMb: p.TotalSizeMB,
+			InstID:      p.InstID,
 		}
 	}
 
-	return &model.User{
-		ID:        user.ID.String(),
-		Username:  user.Username,
-		Email:     user.Email,
-		IsActive:  user.IsActive,
-		Roles:     graphqlRoles,
-		LastLogin: user.LastLogin,
-		CreatedAt: user.CreatedAt,
-	}, nil
+	return result, nil
 }
 
 // Permissions is the resolver for the permissions field.
@@ -335,6 +1240,26 @@ func (r *queryResolver) Permissions(ctx context.Context) ([]*model.Permission, e
 	return result, nil
 }
 
+// ProcessMemory is the resolver for the processMemory field.
+func (r *queryResolver) ProcessMemory(ctx context.Context, limit *int) ([]*model.ProcessMemory, error) {
+	if err := middleware.RequirePermission(ctx, "VIEW_SQL"); err != nil {
+		return nil, err
+	}
+
+	userCtx := middleware.MustGetUserFromContext(ctx)
+	processes, err := r.oracleService.GetProcessMemory(ctx, userCtx.UserID, limitOrDefault(limit, 20))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get process memory: %w", err)
+	}
+
+	result := make([]*model.ProcessMemory, len(processes))
+	for i, p := range processes {
+		result[i] = toModelProcessMemory(p)
+	}
+
+	return result, nil
+}
+
 // RecentSchemaChanges is the resolver for the recentSchemaChanges field.
 func (r *queryResolver) RecentSchemaChanges(ctx context.Context, schemaName *string, days int) ([]*model.SchemaChange, error) {
 	if err := middleware.RequirePermission(ctx, "VIEW_SCHEMA"); err != nil {
@@ -343,6 +1268,59 @@ func (r *queryResolver) RecentSchemaChanges(ctx context.Context, schemaName *str
 	return nil, fmt.Errorf("not implemented: RecentSchemaChanges")
 }
 
+// RecoveryArea is the resolver for the recoveryArea field.
+func (r *queryResolver) RecoveryArea(ctx context.Context) (*model.RecoveryArea, error) {
+	if err := middleware.RequirePermission(ctx, "VIEW_TABLESPACES"); err != nil {
+		return nil, err
+	}
+
+	userCtx := middleware.MustGetUserFromContext(ctx)
+	area, err := r.oracleService.GetRecoveryArea(ctx, userCtx.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get recovery area: %w", err)
+	}
+	if area == nil {
+		return nil, nil
+	}
+
+	return toModelRecoveryArea(area), nil
+}
+
+// RecoveryAreaHistory is the resolver for the recoveryAreaHistory field.
+func (r *queryResolver) RecoveryAreaHistory(ctx context.Context, timeRange model.TimeRangeInput) ([]*model.RecoveryAreaMetric, error) {
+	if err := middleware.RequirePermission(ctx, "VIEW_TABLESPACES"); err != nil {
+		return nil, err
+	}
+
+	metrics, err := r.historyService.RecoveryAreaHistory(ctx, timeRange.StartTime, timeRange.EndTime)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get recovery area history: %w", err)
+	}
+
+	result := make([]*model.RecoveryAreaMetric, len(metrics))
+	for i, metric := range metrics {
+		result[i] = toModelRecoveryAreaMetric(metric)
+	}
+
+	return result, nil
+}
+
+// RedoSummary is the resolver for the redoSummary field.
+func (r *queryResolver) RedoSummary(ctx context.Context, days *int, maxSwitchesPerHour *int) (*model.RedoSummary, error) {
+	if err := middleware.RequirePermission(ctx, "VIEW_TABLESPACES"); err != nil {
+		return nil, err
+	}
+
+	userCtx := middleware.MustGetUserFromContext(ctx)
+	summary, err := r.oracleService.GetRedoSummary(ctx, userCtx.UserID,
+		limitOrDefault(days, 7), limitOrDefault(maxSwitchesPerHour, service.DefaultMaxLogSwitchesPerHour))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get redo summary: %w", err)
+	}
+
+	return toModelRedoSummary(summary), nil
+}
+
 // Roles is the resolver for the roles field.
 func (r *queryResolver) Roles(ctx context.Context) ([]*model.Role, error) {
 	if _, err := middleware.RequireAuth(ctx); err != nil {
@@ -373,14 +1351,42 @@ func (r *queryResolver) SchemaInfo(ctx context.Context, name string) (*model.Sch
 	return nil, fmt.Errorf("not implemented: SchemaInfo")
 }
 
+// SchemaStatistics is the resolver for the schemaStatistics field.
+func (r *queryResolver) SchemaStatistics(ctx context.Context) ([]*model.SchemaStatistics, error) {
+	if err := middleware.RequirePermission(ctx, "VIEW_SCHEMA"); err != nil {
+		return nil, err
+	}
+
+	userCtx := middleware.MustGetUserFromContext(ctx)
+	schemas, err := r.oracleService.GetSchemaStatistics(ctx, userCtx.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get schema statistics: %w", err)
+	}
+
+	result := make([]*model.SchemaStatistics, len(schemas))
+	for i, schema := range schemas {
+		result[i] = &model.SchemaStatistics{
+			Owner:          schema.Owner,
+			Tables:         schema.Tables,
+			StaleTables:    schema.StaleTables,
+			MissingTables:  schema.MissingTables,
+			LockedTables:   schema.LockedTables,
+			OldestAnalyzed: schema.OldestAnalyzed,
+			ConID:          schema.ConID,
+		}
+	}
+
+	return result, nil
+}
+
 // Schemas is the resolver for the schemas field.
-func (r *queryResolver) Schemas(ctx context.Context) ([]*model.SchemaInfo, error) {
+func (r *queryResolver) Schemas(ctx context.Context, pdb *string) ([]*model.SchemaInfo, error) {
 	if err := middleware.RequirePermission(ctx, "VIEW_SCHEMA"); err != nil {
 		return nil, err
 	}
 
 	userCtx := middleware.MustGetUserFromContext(ctx)
-	schemas, err := r.oracleService.GetSchemas(ctx, userCtx.UserID)
+	schemas, err := r.oracleService.GetSchemas(ctx, userCtx.UserID, derefString(pdb))
 	if err != nil {
 		return nil, fmt.Errorf("failed to get schemas: %w", err)
 	}
@@ -396,17 +1402,73 @@ func (r *queryResolver) Schemas(ctx context.Context) ([]*model.SchemaInfo, error
 			ProcedureCount: schema.ProcedureCount,
 			FunctionCount:  schema.FunctionCount,
 			PackageCount:   schema.PackageCount,
+			ConID:          schema.ConID,
+		}
+	}
+	return result, nil
+}
+
+// SegmentHistory is the resolver for the segmentHistory field.
+func (r *queryResolver) SegmentHistory(ctx context.Context, owner string, segmentName string, partitionName *string, timeRange model.TimeRangeInput) ([]*model.SegmentSnapshot, error) {
+	if err := middleware.RequirePermission(ctx, "VIEW_TABLESPACES"); err != nil {
+		return nil, err
+	}
+
+	snapshots, err := r.historyService.SegmentHistory(ctx, owner, segmentName, partitionName, timeRange.StartTime, timeRange.EndTime)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get segment history: %w", err)
+	}
+
+	result := make([]*model.SegmentSnapshot, len(snapshots))
+	for i, snapshot := range snapshots {
+		result[i] = &model.SegmentSnapshot{
+			CapturedAt:     snapshot.CapturedAt,
+			SegmentType:    snapshot.SegmentType,
+			TablespaceName: snapshot.TablespaceName,
+			SizeMb:         snapshot.SizeMB,
+			Extents:        snapshot.Extents,
 		}
 	}
+
+	return result, nil
+}
+
+// Segments is the resolver for the segments field.
+func (r *queryResolver) Segments(ctx context.Context, tablespace *string, owner *string, limit *int, pdb *string) ([]*model.Segment, error) {
+	if err := middleware.RequirePermission(ctx, "VIEW_TABLESPACES"); err != nil {
+		return nil, err
+	}
+
+	userCtx := middleware.MustGetUserFromContext(ctx)
+	segments, err := r.oracleService.GetSegments(ctx, userCtx.UserID, derefString(tablespace), derefString(owner), limitOrDefault(limit, 20), derefString(pdb))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get segments: %w", err)
+	}
+
+	result := make([]*model.Segment, len(segments))
+	for i, seg := range segments {
+		result[i] = toModelSegment(seg)
+	}
+
 	return result, nil
 }
 
 // Session is the resolver for the session field.
-func (r *queryResolver) Session(ctx context.Context, sid int) (*model.OracleSession, error) {
+func (r *queryResolver) Session(ctx context.Context, sid int, instID *int) (*model.SessionDetail, error) {
 	if err := middleware.RequirePermission(ctx, "VIEW_SESSIONS"); err != nil {
 		return nil, err
 	}
-	return nil, fmt.Errorf("not implemented: Session")
+
+	userCtx := middleware.MustGetUserFromContext(ctx)
+	detail, err := r.oracleService.GetSessionDetail(ctx, userCtx.UserID, sid, instID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get session: %w", err)
+	}
+	if detail == nil {
+		return nil, nil
+	}
+
+	return toModelSessionDetail(detail), nil
 }
 
 // SessionSummary is the resolver for the sessionSummary field.
@@ -466,43 +1528,40 @@ func (r *queryResolver) SessionSummary(ctx context.Context) (*model.SessionSumma
 }
 
 // Sessions is the resolver for the sessions field.
-func (r *queryResolver) Sessions(ctx context.Context, filter *model.SessionFilterInput) ([]*model.OracleSession, error) {
+func (r *queryResolver) Sessions(ctx context.Context, filter *model.SessionFilterInput, sort *model.SessionSortInput, limit *int, offset *int, after *string, pdb *string) (*model.SessionPage, error) {
 	if err := middleware.RequirePermission(ctx, "VIEW_SESSIONS"); err != nil {
 		return nil, err
 	}
 
+	opts := sessionListOptions(filter, sort, limit, offset, after, oracle.SessionSortLogonTime)
+	opts.Filter.PDB = derefString(pdb)
+
 	userCtx := middleware.MustGetUserFromContext(ctx)
-	var sessions []*model.OracleSession
-	
-	// Get all sessions and filter in memory (simplified)
-	allSessions, err := r.oracleService.GetAllSessions(ctx, userCtx.UserID)
+	page, err := r.oracleService.ListSessions(ctx, userCtx.UserID, opts)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get sessions: %w", err)
 	}
 
-	for _, session := range allSessions {
-		status := model.SessionStatus(session.Status)
-		sessions = append(sessions, &model.OracleSession{
-			Sid:             session.SID,
-			Serial:          session.Serial,
-			Username:        session.Username,
-			SchemaName:      session.SchemaName,
-			OsUser:          session.OSUser,
-			Machine:         session.Machine,
-			Program:         session.Program,
-			Status:          status,
-			SQLID:           session.SQLID,
-			SQLText:         session.SQLText,
-			LogonTime:       session.LogonTime,
-			LastCallSeconds: session.LastCallET,
-			BlockingSession: session.BlockingSession,
-			WaitClass:       session.WaitClass,
-			Event:           session.Event,
-			SecondsInWait:   session.SecondsInWait,
-		})
+	return toModelSessionPage(page), nil
+}
+
+// Silences is the resolver for the silences field.
+func (r *queryResolver) Silences(ctx context.Context, includeExpired *bool) ([]*model.Silence, error) {
+	if err := middleware.RequirePermission(ctx, "VIEW_ALERTS"); err != nil {
+		return nil, err
+	}
+
+	silences, err := r.silenceService.ListSilences(ctx, includeExpired != nil && *includeExpired)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list silences: %w", err)
+	}
+
+	result := make([]*model.Silence, len(silences))
+	for i, silence := range silences {
+		result[i] = toModelSilence(silence)
 	}
 
-	return sessions, nil
+	return result, nil
 }
 
 // SqlByID is the resolver for the sqlById field.
@@ -510,7 +1569,17 @@ func (r *queryResolver) SQLByID(ctx context.Context, sqlID string) (*model.SQLPe
 	if err := middleware.RequirePermission(ctx, "VIEW_SQL"); err != nil {
 		return nil, err
 	}
-	return nil, fmt.Errorf("not implemented: SqlByID")
+
+	userCtx := middleware.MustGetUserFromContext(ctx)
+	sp, err := r.oracleService.GetSQLByID(ctx, userCtx.UserID, sqlID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get SQL: %w", err)
+	}
+	if sp == nil {
+		return nil, nil
+	}
+
+	return toModelSQLPerformance(sp), nil
 }
 
 // SqlHistory is the resolver for the sqlHistory field.
@@ -529,12 +1598,75 @@ func (r *queryResolver) SQLPerformance(ctx context.Context, filter *model.SQLPer
 	return nil, fmt.Errorf("not implemented: SqlPerformance")
 }
 
+// SQLPlanChanges is the resolver for the sqlPlanChanges field.
+func (r *queryResolver) SQLPlanChanges(ctx context.Context, timeRange model.TimeRangeInput, regressedOnly *bool) ([]*model.SQLPlanChange, error) {
+	if err := middleware.RequirePermission(ctx, "VIEW_SQL"); err != nil {
+		return nil, err
+	}
+
+	changes, err := r.alertService.ListSQLPlanChanges(ctx, timeRange.StartTime, timeRange.EndTime, regressedOnly != nil && *regressedOnly)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list SQL plan changes: %w", err)
+	}
+
+	result := make([]*model.SQLPlanChange, len(changes))
+	for i, change := range changes {
+		result[i] = toModelSQLPlanChange(change)
+	}
+
+	return result, nil
+}
+
+// SystemRatios is the resolver for the systemRatios field.
+func (r *queryResolver) SystemRatios(ctx context.Context, minutes int) (*model.SystemRatios, error) {
+	if err := middleware.RequirePermission(ctx, "VIEW_SQL"); err != nil {
+		return nil, err
+	}
+
+	ratios, err := r.systemStatsService.SystemRatios(ctx, minutes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get system ratios: %w", err)
+	}
+
+	return toModelSystemRatios(ratios), nil
+}
+
+// TableStatistics is the resolver for the tableStatistics field.
+func (r *queryResolver) TableStatistics(ctx context.Context, schemaName *string, problemsOnly *bool, pdb *string) ([]*model.TableStatistics, error) {
+	if err := middleware.RequirePermission(ctx, "VIEW_SCHEMA"); err != nil {
+		return nil, err
+	}
+
+	userCtx := middleware.MustGetUserFromContext(ctx)
+	tables, err := r.oracleService.GetTableStatistics(ctx, userCtx.UserID, derefString(schemaName), problemsOnly != nil && *problemsOnly, derefString(pdb))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get table statistics: %w", err)
+	}
+
+	result := make([]*model.TableStatistics, len(tables))
+	for i, t := range tables {
+		result[i] = toModelTableStatistics(t)
+	}
+
+	return result, nil
+}
+
 // Tablespace is the resolver for the tablespace field.
-func (r *queryResolver) Tablespace(ctx context.Context, name string) (*model.Tablespace, error) {
+func (r *queryResolver) Tablespace(ctx context.Context, name string, pdb *string) (*model.Tablespace, error) {
 	if err := middleware.RequirePermission(ctx, "VIEW_TABLESPACES"); err != nil {
 		return nil, err
 	}
-	return nil, fmt.Errorf("not implemented: Tablespace")
+
+	userCtx := middleware.MustGetUserFromContext(ctx)
+	tablespace, err := r.oracleService.GetTablespace(ctx, userCtx.UserID, name, derefString(pdb))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tablespace: %w", err)
+	}
+	if tablespace == nil {
+		return nil, nil
+	}
+
+	return toModelTablespace(tablespace), nil
 }
 
 // TablespaceGrowth is the resolver for the tablespaceGrowth field.
@@ -554,43 +1686,69 @@ func (r *queryResolver) TablespaceHistory(ctx context.Context, name string, time
 }
 
 // Tablespaces is the resolver for the tablespaces field.
-func (r *queryResolver) Tablespaces(ctx context.Context, filter *model.TablespaceFilterInput) ([]*model.Tablespace, error) {
+func (r *queryResolver) Tablespaces(ctx context.Context, filter *model.TablespaceFilterInput, pdb *string) ([]*model.Tablespace, error) {
 	if err := middleware.RequirePermission(ctx, "VIEW_TABLESPACES"); err != nil {
 		return nil, err
 	}
 
 	userCtx := middleware.MustGetUserFromContext(ctx)
-	tablespaces, err := r.oracleService.GetTablespaces(ctx, userCtx.UserID)
+	tablespaces, err := r.oracleService.GetTablespaces(ctx, userCtx.UserID, derefString(pdb))
 	if err != nil {
 		return nil, fmt.Errorf("failed to get tablespaces: %w", err)
 	}
 
 	result := make([]*model.Tablespace, len(tablespaces))
 	for i, ts := range tablespaces {
-		contents := model.TablespaceContents(ts.Contents)
-		result[i] = &model.Tablespace{
-			Name:            ts.Name,
-			TotalSizeMb:     ts.TotalSizeMB,
-			UsedSizeMb:      ts.UsedSizeMB,
-			FreeSizeMb:      ts.FreeSizeMB,
-			UsagePercentage: ts.UsagePercentage,
-			Status:          ts.Status,
-			Contents:        contents,
-			DatafileCount:   ts.DatafileCount,
-		}
+		result[i] = toModelTablespace(ts)
+	}
+
+	return result, nil
+}
+
+// TempUsage is the resolver for the tempUsage field.
+func (r *queryResolver) TempUsage(ctx context.Context, limit *int, pdb *string) ([]*model.TempUsage, error) {
+	if err := middleware.RequirePermission(ctx, "VIEW_TABLESPACES"); err != nil {
+		return nil, err
+	}
+
+	userCtx := middleware.MustGetUserFromContext(ctx)
+	usage, err := r.oracleService.GetTempUsage(ctx, userCtx.UserID, limitOrDefault(limit, 20), derefString(pdb))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get temp usage: %w", err)
+	}
+
+	return toModelTempUsage(usage), nil
+}
+
+// TopGrowingSegments is the resolver for the topGrowingSegments field.
+func (r *queryResolver) TopGrowingSegments(ctx context.Context, days *int, limit *int, tablespace *string, owner *string) ([]*model.SegmentGrowth, error) {
+	if err := middleware.RequirePermission(ctx, "VIEW_TABLESPACES"); err != nil {
+		return nil, err
+	}
+
+	end := time.Now()
+	start := end.AddDate(0, 0, -limitOrDefault(days, 7))
+	growth, err := r.historyService.TopGrowingSegments(ctx, derefString(tablespace), derefString(owner), start, end, limitOrDefault(limit, 10))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get segment growth: %w", err)
+	}
+
+	result := make([]*model.SegmentGrowth, len(growth))
+	for i, g := range growth {
+		result[i] = toModelSegmentGrowth(g)
 	}
 
 	return result, nil
 }
 
 // TopSQLByCPUTime is the resolver for the topSQLByCPUTime field.
-func (r *queryResolver) TopSQLByCPUTime(ctx context.Context, limit int) ([]*model.SQLPerformance, error) {
+func (r *queryResolver) TopSQLByCPUTime(ctx context.Context, limit int, pdb *string) ([]*model.SQLPerformance, error) {
 	if err := middleware.RequirePermission(ctx, "VIEW_SQL"); err != nil {
 		return nil, err
 	}
 
 	userCtx := middleware.MustGetUserFromContext(ctx)
-	sqlPerf, err := r.oracleService.GetTopSQLByCPU(ctx, userCtx.UserID, limit)
+	sqlPerf, err := r.oracleService.GetTopSQLByCPU(ctx, userCtx.UserID, limit, derefString(pdb))
 	if err != nil {
 		return nil, fmt.Errorf("failed to get top SQL by CPU: %w", err)
 	}
@@ -612,6 +1770,8 @@ func (r *queryResolver) TopSQLByCPUTime(ctx context.Context, limit int) ([]*mode
 			RowsProcessed:  sp.RowsProcessed,
 			FirstLoadTime:  sp.FirstLoadTime,
 			LastActiveTime: sp.LastActiveTime,
+			InstID:         sp.InstID,
+			ConID:          sp.ConID,
 		}
 	}
 	return result, nil
@@ -626,13 +1786,13 @@ func (r *queryResolver) TopSQLByDiskReads(ctx context.Context, limit int) ([]*mo
 }
 
 // TopSQLByElapsedTime is the resolver for the topSQLByElapsedTime field.
-func (r *queryResolver) TopSQLByElapsedTime(ctx context.Context, limit int) ([]*model.SQLPerformance, error) {
+func (r *queryResolver) TopSQLByElapsedTime(ctx context.Context, limit int, pdb *string) ([]*model.SQLPerformance, error) {
 	if err := middleware.RequirePermission(ctx, "VIEW_SQL"); err != nil {
 		return nil, err
 	}
 
 	userCtx := middleware.MustGetUserFromContext(ctx)
-	sqlPerf, err := r.oracleService.GetTopSQLByElapsedTime(ctx, userCtx.UserID, limit)
+	sqlPerf, err := r.oracleService.GetTopSQLByElapsedTime(ctx, userCtx.UserID, limit, derefString(pdb))
 	if err != nil {
 		return nil, fmt.Errorf("failed to get top SQL by elapsed time: %w", err)
 	}
@@ -654,6 +1814,8 @@ func (r *queryResolver) TopSQLByElapsedTime(ctx context.Context, limit int) ([]*
 			RowsProcessed:  sp.RowsProcessed,
 			FirstLoadTime:  sp.FirstLoadTime,
 			LastActiveTime: sp.LastActiveTime,
+			InstID:         sp.InstID,
+			ConID:          sp.ConID,
 		}
 	}
 	return result, nil
@@ -667,6 +1829,35 @@ func (r *queryResolver) TopSQLByExecutions(ctx context.Context, limit int) ([]*m
 	return nil, fmt.Errorf("not implemented: TopSqlByExecutions")
 }
 
+// TopWaitEvents is the resolver for the topWaitEvents field.
+func (r *queryResolver) TopWaitEvents(ctx context.Context, minutes int, limit *int) ([]*model.WaitEventStat, error) {
+	if err := middleware.RequirePermission(ctx, "VIEW_SQL"); err != nil {
+		return nil, err
+	}
+
+	stats, err := r.systemStatsService.TopWaitEvents(ctx, minutes, limitOrDefault(limit, 10))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get top wait events: %w", err)
+	}
+
+	return toModelWaitEventStats(stats), nil
+}
+
+// UndoSummary is the resolver for the undoSummary field.
+func (r *queryResolver) UndoSummary(ctx context.Context, hours *int) (*model.UndoSummary, error) {
+	if err := middleware.RequirePermission(ctx, "VIEW_TABLESPACES"); err != nil {
+		return nil, err
+	}
+
+	userCtx := middleware.MustGetUserFromContext(ctx)
+	summary, err := r.oracleService.GetUndoSummary(ctx, userCtx.UserID, limitOrDefault(hours, 24))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get undo summary: %w", err)
+	}
+
+	return toModelUndoSummary(summary), nil
+}
+
 // User is the resolver for the user field.
 func (r *queryResolver) User(ctx context.Context, id string) (*model.User, error) {
 	if err := middleware.RequirePermission(ctx, "MANAGE_USERS"); err != nil {
@@ -688,6 +1879,35 @@ func (r *subscriptionResolver) BlockingDetected(ctx context.Context) (<-chan *mo
 	panic(fmt.Errorf("not implemented: BlockingDetected - blockingDetected"))
 }
 
+// LongOperationProgress is the resolver for the longOperationProgress field.
+func (r *subscriptionResolver) LongOperationProgress(ctx context.Context, sid int, serial int, instID *int, intervalSeconds *int) (<-chan *model.LongOperation, error) {
+	if err := middleware.RequirePermission(ctx, "VIEW_SESSIONS"); err != nil {
+		return nil, err
+	}
+
+	interval := time.Duration(limitOrDefault(intervalSeconds, 5)) * time.Second
+
+	userCtx := middleware.MustGetUserFromContext(ctx)
+	updates, err := r.oracleService.WatchLongOperations(ctx, userCtx.UserID, sid, serial, instID, interval)
+	if err != nil {
+		return nil, fmt.Errorf("failed to watch long operations: %w", err)
+	}
+
+	result := make(chan *model.LongOperation)
+	go func() {
+		defer close(result)
+		for op := range updates {
+			select {
+			case result <- toModelLongOperation(op):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return result, nil
+}
+
 // SessionAdded is the resolver for the sessionAdded field.
 func (r *subscriptionResolver) SessionAdded(ctx context.Context) (<-chan *model.OracleSession, error) {
 	panic(fmt.Errorf("not implemented: SessionAdded - sessionAdded"))
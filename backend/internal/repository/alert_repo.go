@@ -0,0 +1,247 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Alert lifecycle states
+const (
+	AlertStatusFiring       = "FIRING"
+	AlertStatusAcknowledged = "ACKNOWLEDGED"
+	AlertStatusResolved     = "RESOLVED"
+)
+
+type alertRepository struct {
+	db *sql.DB
+}
+
+// NewAlertRepository creates a new alert repository
+func NewAlertRepository(db *sql.DB) AlertRepository {
+	return &alertRepository{db: db}
+}
+
+func (r *alertRepository) Create(ctx context.Context, alert *Alert) error {
+	query := `
+		INSERT INTO alerting.alerts (
+			id, rule_id, rule_name, metric, severity, status, target, object_key,
+			value, threshold, message, fired_at, last_evaluated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+	`
+
+	alert.ID = uuid.New()
+	alert.FiredAt = time.Now()
+	alert.LastEvaluatedAt = alert.FiredAt
+	if alert.Status == "" {
+		alert.Status = AlertStatusFiring
+	}
+
+	_, err := r.db.ExecContext(ctx, query,
+		alert.ID,
+		alert.RuleID,
+		alert.RuleName,
+		alert.Metric,
+		alert.Severity,
+		alert.Status,
+		alert.Target,
+		alert.ObjectKey,
+		alert.Value,
+		alert.Threshold,
+		alert.Message,
+		alert.FiredAt,
+		alert.LastEvaluatedAt,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to create alert: %w", err)
+	}
+
+	return nil
+}
+
+func (r *alertRepository) GetByID(ctx context.Context, id uuid.UUID) (*Alert, error) {
+	query := alertSelect + ` WHERE id = $1`
+
+	alert, err := scanAlert(r.db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("alert not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get alert: %w", err)
+	}
+
+	return alert, nil
+}
+
+func (r *alertRepository) List(ctx context.Context, filter *AlertFilter) ([]*Alert, error) {
+	query := alertSelect + ` WHERE 1=1`
+
+	args := []interface{}{}
+	argCounter := 1
+
+	// Build dynamic WHERE clause
+	if filter.RuleID != nil {
+		query += fmt.Sprintf(" AND rule_id = $%d", argCounter)
+		args = append(args, *filter.RuleID)
+		argCounter++
+	}
+
+	if filter.Status != nil {
+		query += fmt.Sprintf(" AND status = $%d", argCounter)
+		args = append(args, *filter.Status)
+		argCounter++
+	}
+
+	if filter.Severity != nil {
+		query += fmt.Sprintf(" AND severity = $%d", argCounter)
+		args = append(args, *filter.Severity)
+		argCounter++
+	}
+
+	if filter.Target != nil {
+		query += fmt.Sprintf(" AND target = $%d", argCounter)
+		args = append(args, *filter.Target)
+		argCounter++
+	}
+
+	query += " ORDER BY fired_at DESC"
+
+	if filter.Limit > 0 {
+		query += fmt.Sprintf(" LIMIT $%d", argCounter)
+		args = append(args, filter.Limit)
+		argCounter++
+	}
+
+	if filter.Offset > 0 {
+		query += fmt.Sprintf(" OFFSET $%d", argCounter)
+		args = append(args, filter.Offset)
+		argCounter++
+	}
+
+	return r.list(ctx, query, args...)
+}
+
+// ListActive returns firing and acknowledged alerts for a target
+func (r *alertRepository) ListActive(ctx context.Context, target string) ([]*Alert, error) {
+	query := alertSelect + `
+		WHERE target = $1 AND status IN ('FIRING', 'ACKNOWLEDGED')
+		ORDER BY fired_at
+	`
+
+	return r.list(ctx, query, target)
+}
+
+func (r *alertRepository) UpdateValue(ctx context.Context, id uuid.UUID, value float64, evaluatedAt time.Time) error {
+	query := `UPDATE alerting.alerts SET value = $1, last_evaluated_at = $2 WHERE id = $3`
+
+	_, err := r.db.ExecContext(ctx, query, value, evaluatedAt, id)
+	if err != nil {
+		return fmt.Errorf("failed to update alert value: %w", err)
+	}
+
+	return nil
+}
+
+func (r *alertRepository) Acknowledge(ctx context.Context, id uuid.UUID, acknowledgedBy string) error {
+	query := `
+		UPDATE alerting.alerts
+		SET status = 'ACKNOWLEDGED', acknowledged_at = $1, acknowledged_by = $2
+		WHERE id = $3 AND status = 'FIRING'
+	`
+
+	result, err := r.db.ExecContext(ctx, query, time.Now(), acknowledgedBy, id)
+	if err != nil {
+		return fmt.Errorf("failed to acknowledge alert: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rows == 0 {
+		return fmt.Errorf("alert not found or not firing")
+	}
+
+	return nil
+}
+
+func (r *alertRepository) Resolve(ctx context.Context, id uuid.UUID) error {
+	query := `
+		UPDATE alerting.alerts
+		SET status = 'RESOLVED', resolved_at = $1
+		WHERE id = $2 AND status IN ('FIRING', 'ACKNOWLEDGED')
+	`
+
+	result, err := r.db.ExecContext(ctx, query, time.Now(), id)
+	if err != nil {
+		return fmt.Errorf("failed to resolve alert: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rows == 0 {
+		return fmt.Errorf("alert not found or already resolved")
+	}
+
+	return nil
+}
+
+const alertSelect = `
+		SELECT id, rule_id, rule_name, metric, severity, status, target, object_key,
+			value, threshold, message, fired_at, acknowledged_at, acknowledged_by,
+			resolved_at, last_evaluated_at
+		FROM alerting.alerts
+`
+
+func (r *alertRepository) list(ctx context.Context, query string, args ...interface{}) ([]*Alert, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list alerts: %w", err)
+	}
+	defer rows.Close()
+
+	alerts := []*Alert{}
+	for rows.Next() {
+		alert, err := scanAlert(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan alert: %w", err)
+		}
+		alerts = append(alerts, alert)
+	}
+
+	return alerts, nil
+}
+
+func scanAlert(row rowScanner) (*Alert, error) {
+	alert := &Alert{}
+	err := row.Scan(
+		&alert.ID,
+		&alert.RuleID,
+		&alert.RuleName,
+		&alert.Metric,
+		&alert.Severity,
+		&alert.Status,
+		&alert.Target,
+		&alert.ObjectKey,
+		&alert.Value,
+		&alert.Threshold,
+		&alert.Message,
+		&alert.FiredAt,
+		&alert.AcknowledgedAt,
+		&alert.AcknowledgedBy,
+		&alert.ResolvedAt,
+		&alert.LastEvaluatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return alert, nil
+}
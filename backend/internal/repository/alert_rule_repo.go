@@ -0,0 +1,193 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type alertRuleRepository struct {
+	db *sql.DB
+}
+
+// NewAlertRuleRepository creates a new alert rule repository
+func NewAlertRuleRepository(db *sql.DB) AlertRuleRepository {
+	return &alertRuleRepository{db: db}
+}
+
+func (r *alertRuleRepository) Create(ctx context.Context, rule *AlertRule) error {
+	query := `
+		INSERT INTO alerting.rules (
+			id, name, description, metric, condition, threshold, duration_seconds,
+			severity, target_filter, object_filter, enabled, created_by, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+	`
+
+	rule.ID = uuid.New()
+	rule.CreatedAt = time.Now()
+	rule.UpdatedAt = rule.CreatedAt
+
+	_, err := r.db.ExecContext(ctx, query,
+		rule.ID,
+		rule.Name,
+		rule.Description,
+		rule.Metric,
+		rule.Condition,
+		rule.Threshold,
+		rule.DurationSeconds,
+		rule.Severity,
+		rule.TargetFilter,
+		rule.ObjectFilter,
+		rule.Enabled,
+		rule.CreatedBy,
+		rule.CreatedAt,
+		rule.UpdatedAt,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to create alert rule: %w", err)
+	}
+
+	return nil
+}
+
+func (r *alertRuleRepository) GetByID(ctx context.Context, id uuid.UUID) (*AlertRule, error) {
+	query := alertRuleSelect + ` WHERE id = $1`
+
+	rule, err := scanAlertRule(r.db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("alert rule not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get alert rule: %w", err)
+	}
+
+	return rule, nil
+}
+
+func (r *alertRuleRepository) List(ctx context.Context) ([]*AlertRule, error) {
+	return r.list(ctx, alertRuleSelect+` ORDER BY name`)
+}
+
+func (r *alertRuleRepository) ListEnabled(ctx context.Context) ([]*AlertRule, error) {
+	return r.list(ctx, alertRuleSelect+` WHERE enabled = true ORDER BY name`)
+}
+
+func (r *alertRuleRepository) Update(ctx context.Context, rule *AlertRule) error {
+	query := `
+		UPDATE alerting.rules
+		SET name = $1, description = $2, metric = $3, condition = $4, threshold = $5,
+			duration_seconds = $6, severity = $7, target_filter = $8, object_filter = $9,
+			enabled = $10, updated_at = $11
+		WHERE id = $12
+	`
+
+	rule.UpdatedAt = time.Now()
+
+	result, err := r.db.ExecContext(ctx, query,
+		rule.Name,
+		rule.Description,
+		rule.Metric,
+		rule.Condition,
+		rule.Threshold,
+		rule.DurationSeconds,
+		rule.Severity,
+		rule.TargetFilter,
+		rule.ObjectFilter,
+		rule.Enabled,
+		rule.UpdatedAt,
+		rule.ID,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to update alert rule: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rows == 0 {
+		return fmt.Errorf("alert rule not found")
+	}
+
+	return nil
+}
+
+func (r *alertRuleRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	query := `DELETE FROM alerting.rules WHERE id = $1`
+
+	result, err := r.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete alert rule: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rows == 0 {
+		return fmt.Errorf("alert rule not found")
+	}
+
+	return nil
+}
+
+const alertRuleSelect = `
+		SELECT id, name, description, metric, condition, threshold, duration_seconds,
+			severity, target_filter, object_filter, enabled, created_by, created_at, updated_at
+		FROM alerting.rules
+`
+
+func (r *alertRuleRepository) list(ctx context.Context, query string, args ...interface{}) ([]*AlertRule, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list alert rules: %w", err)
+	}
+	defer rows.Close()
+
+	rules := []*AlertRule{}
+	for rows.Next() {
+		rule, err := scanAlertRule(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan alert rule: %w", err)
+		}
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+// rowScanner is satisfied by both *sql.Row and *sql.Rows
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanAlertRule(row rowScanner) (*AlertRule, error) {
+	rule := &AlertRule{}
+	err := row.Scan(
+		&rule.ID,
+		&rule.Name,
+		&rule.Description,
+		&rule.Metric,
+		&rule.Condition,
+		&rule.Threshold,
+		&rule.DurationSeconds,
+		&rule.Severity,
+		&rule.TargetFilter,
+		&rule.ObjectFilter,
+		&rule.Enabled,
+		&rule.CreatedBy,
+		&rule.CreatedAt,
+		&rule.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
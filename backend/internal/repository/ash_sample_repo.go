@@ -0,0 +1,142 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ASH aggregation dimensions
+const (
+	ASHDimensionWaitClass = "WAIT_CLASS"
+	ASHDimensionEvent     = "EVENT"
+	ASHDimensionSQLID     = "SQL_ID"
+	ASHDimensionSession   = "SESSION"
+)
+
+// Grouping key, label and extra filter per dimension. Only these
+// expressions are ever interpolated into SQL.
+var ashDimensions = map[string]struct {
+	key    string
+	label  string
+	filter string
+}{
+	ASHDimensionWaitClass: {key: "wait_class", label: "NULL"},
+	ASHDimensionEvent:     {key: "event", label: "MAX(wait_class)"},
+	ASHDimensionSQLID:     {key: "sql_id", label: "MAX(username)", filter: " AND sql_id IS NOT NULL"},
+	ASHDimensionSession:   {key: "sid || ',' || serial || ',@' || inst_id", label: "MAX(username)"},
+}
+
+// Rows per INSERT statement, well below PostgreSQL's bind parameter limit
+const ashInsertChunk = 1000
+
+type ashSampleRepository struct {
+	db *sql.DB
+}
+
+// NewASHSampleRepository creates a new ASH sample repository
+func NewASHSampleRepository(db *sql.DB) ASHSampleRepository {
+	return &ashSampleRepository{db: db}
+}
+
+func (r *ashSampleRepository) CreateBatch(ctx context.Context, samples []*ASHSample) error {
+	for start := 0; start < len(samples); start += ashInsertChunk {
+		end := start + ashInsertChunk
+		if end > len(samples) {
+			end = len(samples)
+		}
+		if err := r.insert(ctx, samples[start:end]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *ashSampleRepository) insert(ctx context.Context, samples []*ASHSample) error {
+	const columnsPerRow = 13
+	placeholders := make([]string, 0, len(samples))
+	args := make([]interface{}, 0, len(samples)*columnsPerRow)
+
+	for i, sample := range samples {
+		base := i * columnsPerRow
+		placeholders = append(placeholders, fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d)",
+			base+1, base+2, base+3, base+4, base+5, base+6, base+7, base+8, base+9, base+10, base+11, base+12, base+13))
+		args = append(args,
+			sample.SampleTime,
+			sample.Target,
+			sample.SID,
+			sample.Serial,
+			sample.Username,
+			sample.SQLID,
+			sample.WaitClass,
+			sample.Event,
+			sample.Module,
+			sample.Program,
+			sample.Machine,
+			sample.BlockingSession,
+			sample.InstID,
+		)
+	}
+
+	query := `
+		INSERT INTO monitoring.ash_samples (
+			sample_time, oracle_db, sid, serial, username, sql_id, wait_class, event,
+			module, program, machine, blocking_session, inst_id
+		) VALUES ` + strings.Join(placeholders, ", ")
+
+	if _, err := r.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to insert ASH samples: %w", err)
+	}
+
+	return nil
+}
+
+// Aggregate counts samples per dimension value in [start, end), busiest first
+func (r *ashSampleRepository) Aggregate(ctx context.Context, target, dimension string, start, end time.Time) ([]*ASHAggregate, error) {
+	dim, ok := ashDimensions[dimension]
+	if !ok {
+		return nil, fmt.Errorf("unknown ASH dimension: %s", dimension)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT %s AS key, %s AS label, COUNT(*) AS samples
+		FROM monitoring.ash_samples
+		WHERE oracle_db = $1 AND sample_time >= $2 AND sample_time < $3%s
+		GROUP BY 1
+		ORDER BY samples DESC, key
+	`, dim.key, dim.label, dim.filter)
+
+	rows, err := r.db.QueryContext(ctx, query, target, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate ASH samples: %w", err)
+	}
+	defer rows.Close()
+
+	aggregates := []*ASHAggregate{}
+	for rows.Next() {
+		agg := &ASHAggregate{}
+		if err := rows.Scan(&agg.Key, &agg.Label, &agg.Samples); err != nil {
+			return nil, fmt.Errorf("failed to scan ASH aggregate: %w", err)
+		}
+		aggregates = append(aggregates, agg)
+	}
+
+	return aggregates, nil
+}
+
+// DeleteBefore removes samples older than the retention cutoff
+func (r *ashSampleRepository) DeleteBefore(ctx context.Context, before time.Time) (int64, error) {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM monitoring.ash_samples WHERE sample_time < $1`, before)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete ASH samples: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return rows, nil
+}
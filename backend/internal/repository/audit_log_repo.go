@@ -26,32 +26,38 @@ func (r *auditLogRepository) Create(ctx context.Context, log *AuditLog) error {
 		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 	`
 
-	log.ID = uuid.New()
-	log.Timestamp = time.Now()
+	_, err := r.db.ExecContext(ctx, query, auditLogArgs(log)...)
+	if err != nil {
+		return fmt.Errorf("failed to create audit log: %w", err)
+	}
 
-	// Convert status to boolean for success field
-	success := log.Status == "SUCCESS"
+	return nil
+}
 
-	// Build metadata JSON (simplified - you can enhance this)
-	metadata := fmt.Sprintf(`{"resource_type": "%s", "resource_id": "%s", "oracle_schema": "%s"}`,
-		log.ResourceType,
-		safeString(log.ResourceID),
-		safeString(log.OracleSchema),
-	)
+// CreateBatch inserts multiple audit logs with a single multi-row INSERT
+func (r *auditLogRepository) CreateBatch(ctx context.Context, logs []*AuditLog) error {
+	if len(logs) == 0 {
+		return nil
+	}
 
-	_, err := r.db.ExecContext(ctx, query,
-		log.ID,
-		log.UserID,
-		log.Username,
-		log.Action,
-		log.ResourceType, // Using ResourceType as target
-		success,
-		metadata,
-		log.Timestamp,
-	)
+	const columnsPerRow = 8
+	placeholders := make([]string, 0, len(logs))
+	args := make([]interface{}, 0, len(logs)*columnsPerRow)
 
-	if err != nil {
-		return fmt.Errorf("failed to create audit log: %w", err)
+	for i, log := range logs {
+		base := i * columnsPerRow
+		placeholders = append(placeholders, fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d)",
+			base+1, base+2, base+3, base+4, base+5, base+6, base+7, base+8))
+		args = append(args, auditLogArgs(log)...)
+	}
+
+	query := `
+		INSERT INTO audit.logs (
+			id, user_id, username, action, target, success, metadata, created_at
+		) VALUES ` + strings.Join(placeholders, ", ")
+
+	if _, err := r.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to create audit log batch: %w", err)
 	}
 
 	return nil
@@ -251,4 +257,36 @@ func safeString(s *string) string {
 		return ""
 	}
 	return strings.ReplaceAll(*s, `"`, `\"`)
+}
+
+// auditLogArgs assigns the ID and timestamp (if not already set) and returns
+// the INSERT arguments in audit.logs column order
+func auditLogArgs(log *AuditLog) []interface{} {
+	if log.ID == uuid.Nil {
+		log.ID = uuid.New()
+	}
+	if log.Timestamp.IsZero() {
+		log.Timestamp = time.Now()
+	}
+
+	// Convert status to boolean for success field
+	success := log.Status == "SUCCESS"
+
+	// Build metadata JSON (simplified - you can enhance this)
+	metadata := fmt.Sprintf(`{"resource_type": "%s", "resource_id": "%s", "oracle_schema": "%s"}`,
+		log.ResourceType,
+		safeString(log.ResourceID),
+		safeString(log.OracleSchema),
+	)
+
+	return []interface{}{
+		log.ID,
+		log.UserID,
+		log.Username,
+		log.Action,
+		log.ResourceType, // Using ResourceType as target
+		success,
+		metadata,
+		log.Timestamp,
+	}
 }
\ No newline at end of file
@@ -0,0 +1,238 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aashiq-04/oracle-dba/pkg/logger"
+)
+
+// Overflow policies for the audit log writer queue
+const (
+	OverflowBlock = "block"
+	OverflowDrop  = "drop"
+)
+
+// ErrAuditQueueFull is returned when an audit log is dropped because the queue is full
+var ErrAuditQueueFull = errors.New("audit queue full, log dropped")
+
+// AuditLogWriterConfig holds asynchronous audit writer settings
+type AuditLogWriterConfig struct {
+	QueueSize      int
+	BatchSize      int
+	FlushInterval  time.Duration
+	OverflowPolicy string
+	SyncActions    []string
+}
+
+// AuditLogWriter is an AuditLogRepository that queues audit logs and writes
+// them in batches off the request path. Actions listed in SyncActions bypass
+// the queue and are written before Create returns.
+type AuditLogWriter struct {
+	repo        AuditLogRepository
+	cfg         AuditLogWriterConfig
+	logger      logger.Logger
+	syncActions map[string]bool
+
+	queue  chan *AuditLog
+	done   chan struct{}
+	mu     sync.RWMutex
+	closed bool
+
+	dropped         atomic.Int64
+	reportedDropped int64
+}
+
+// NewAuditLogWriter creates an asynchronous audit writer on top of repo and
+// starts its background flush loop
+func NewAuditLogWriter(repo AuditLogRepository, cfg AuditLogWriterConfig, log logger.Logger) *AuditLogWriter {
+	syncActions := make(map[string]bool, len(cfg.SyncActions))
+	for _, action := range cfg.SyncActions {
+		syncActions[action] = true
+	}
+
+	w := &AuditLogWriter{
+		repo:        repo,
+		cfg:         cfg,
+		logger:      log,
+		syncActions: syncActions,
+		queue:       make(chan *AuditLog, cfg.QueueSize),
+		done:        make(chan struct{}),
+	}
+
+	go w.run()
+	return w
+}
+
+// Create queues an audit log, or writes it synchronously for security-critical actions
+func (w *AuditLogWriter) Create(ctx context.Context, log *AuditLog) error {
+	if w.syncActions[log.Action] {
+		return w.repo.Create(ctx, log)
+	}
+
+	// Stamp the event time now rather than when the batch is flushed
+	if log.ID == uuid.Nil {
+		log.ID = uuid.New()
+	}
+	if log.Timestamp.IsZero() {
+		log.Timestamp = time.Now()
+	}
+
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	// After shutdown has started, fall back to direct writes
+	if w.closed {
+		return w.repo.Create(ctx, log)
+	}
+
+	if w.cfg.OverflowPolicy == OverflowDrop {
+		select {
+		case w.queue <- log:
+			return nil
+		default:
+			w.dropped.Add(1)
+			return ErrAuditQueueFull
+		}
+	}
+
+	select {
+	case w.queue <- log:
+		return nil
+	case <-ctx.Done():
+		w.dropped.Add(1)
+		return fmt.Errorf("failed to queue audit log: %w", ctx.Err())
+	}
+}
+
+// CreateBatch writes a batch of audit logs directly
+func (w *AuditLogWriter) CreateBatch(ctx context.Context, logs []*AuditLog) error {
+	return w.repo.CreateBatch(ctx, logs)
+}
+
+// GetByID retrieves an audit log by ID
+func (w *AuditLogWriter) GetByID(ctx context.Context, id uuid.UUID) (*AuditLog, error) {
+	return w.repo.GetByID(ctx, id)
+}
+
+// List retrieves audit logs matching the filter
+func (w *AuditLogWriter) List(ctx context.Context, filter *AuditLogFilter) ([]*AuditLog, error) {
+	return w.repo.List(ctx, filter)
+}
+
+// Count counts audit logs matching the filter
+func (w *AuditLogWriter) Count(ctx context.Context, filter *AuditLogFilter) (int, error) {
+	return w.repo.Count(ctx, filter)
+}
+
+// Dropped returns the number of audit logs dropped since startup
+func (w *AuditLogWriter) Dropped() int64 {
+	return w.dropped.Load()
+}
+
+// Close stops accepting queued logs and drains the queue, waiting until
+// everything is written or ctx expires
+func (w *AuditLogWriter) Close(ctx context.Context) error {
+	w.mu.Lock()
+	if !w.closed {
+		w.closed = true
+		close(w.queue)
+	}
+	w.mu.Unlock()
+
+	select {
+	case <-w.done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("audit writer drain interrupted: %w", ctx.Err())
+	}
+}
+
+// run batches queued logs and flushes on size or interval
+func (w *AuditLogWriter) run() {
+	defer close(w.done)
+
+	ticker := time.NewTicker(w.cfg.FlushInterval)
+	defer ticker.Stop()
+
+	batch := make([]*AuditLog, 0, w.cfg.BatchSize)
+	for {
+		select {
+		case log, ok := <-w.queue:
+			if !ok {
+				w.flush(batch)
+				return
+			}
+			batch = append(batch, log)
+			if len(batch) >= w.cfg.BatchSize {
+				w.flush(batch)
+				batch = make([]*AuditLog, 0, w.cfg.BatchSize)
+			}
+		case <-ticker.C:
+			if len(batch) > 0 {
+				w.flush(batch)
+				batch = make([]*AuditLog, 0, w.cfg.BatchSize)
+			}
+			w.reportDropped()
+		}
+	}
+}
+
+func (w *AuditLogWriter) flush(batch []*AuditLog) {
+	if len(batch) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	err := w.repo.CreateBatch(ctx, batch)
+	if err == nil {
+		return
+	}
+
+	// One bad row fails the whole multi-row INSERT, so retry row by row to
+	// keep every other log of the batch
+	w.logger.Warn("Failed to flush audit log batch, retrying row by row",
+		logger.String("count", strconv.Itoa(len(batch))),
+		logger.Error(err),
+	)
+
+	retryCtx, retryCancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer retryCancel()
+
+	failed := 0
+	for _, log := range batch {
+		if err := w.repo.Create(retryCtx, log); err != nil {
+			failed++
+			w.logger.Error("Failed to write audit log",
+				logger.String("action", log.Action),
+				logger.Error(err),
+			)
+		}
+	}
+	if failed > 0 {
+		w.logger.Error("Failed to flush audit logs",
+			logger.String("count", strconv.Itoa(failed)),
+		)
+	}
+}
+
+func (w *AuditLogWriter) reportDropped() {
+	dropped := w.dropped.Load()
+	if dropped == w.reportedDropped {
+		return
+	}
+	w.logger.Warn("Audit logs dropped due to full queue",
+		logger.String("new", strconv.FormatInt(dropped-w.reportedDropped, 10)),
+		logger.String("total", strconv.FormatInt(dropped, 10)),
+	)
+	w.reportedDropped = dropped
+}
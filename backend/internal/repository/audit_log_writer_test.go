@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aashiq-04/oracle-dba/pkg/logger"
+)
+
+// fakeAuditLogRepo fails batch inserts and single inserts of the bad action
+type fakeAuditLogRepo struct {
+	mu        sync.Mutex
+	failBatch bool
+	badAction string
+	written   []string
+}
+
+func (r *fakeAuditLogRepo) Create(ctx context.Context, log *AuditLog) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if log.Action == r.badAction {
+		return errors.New("invalid row")
+	}
+	r.written = append(r.written, log.Action)
+	return nil
+}
+
+func (r *fakeAuditLogRepo) CreateBatch(ctx context.Context, logs []*AuditLog) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.failBatch {
+		return errors.New("batch failed")
+	}
+	for _, log := range logs {
+		r.written = append(r.written, log.Action)
+	}
+	return nil
+}
+
+func (r *fakeAuditLogRepo) GetByID(ctx context.Context, id uuid.UUID) (*AuditLog, error) {
+	return nil, nil
+}
+
+func (r *fakeAuditLogRepo) List(ctx context.Context, filter *AuditLogFilter) ([]*AuditLog, error) {
+	return nil, nil
+}
+
+func (r *fakeAuditLogRepo) Count(ctx context.Context, filter *AuditLogFilter) (int, error) {
+	return 0, nil
+}
+
+func newTestAuditLogWriter(repo AuditLogRepository) *AuditLogWriter {
+	return NewAuditLogWriter(repo, AuditLogWriterConfig{
+		QueueSize:      10,
+		BatchSize:      10,
+		FlushInterval:  time.Hour,
+		OverflowPolicy: OverflowBlock,
+	}, logger.NewLogger())
+}
+
+func TestAuditLogWriterFlushesBatch(t *testing.T) {
+	repo := &fakeAuditLogRepo{}
+	w := newTestAuditLogWriter(repo)
+
+	for _, action := range []string{"LOGIN", "GET_SESSIONS"} {
+		if err := w.Create(context.Background(), &AuditLog{Action: action}); err != nil {
+			t.Fatalf("Create(%s) error = %v", action, err)
+		}
+	}
+	if err := w.Close(context.Background()); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	if len(repo.written) != 2 {
+		t.Errorf("written = %v, want 2 logs", repo.written)
+	}
+}
+
+func TestAuditLogWriterRetriesFailedBatchRowByRow(t *testing.T) {
+	repo := &fakeAuditLogRepo{failBatch: true, badAction: "BAD"}
+	w := newTestAuditLogWriter(repo)
+
+	for _, action := range []string{"LOGIN", "BAD", "GET_SESSIONS"} {
+		if err := w.Create(context.Background(), &AuditLog{Action: action}); err != nil {
+			t.Fatalf("Create(%s) error = %v", action, err)
+		}
+	}
+	if err := w.Close(context.Background()); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	want := []string{"LOGIN", "GET_SESSIONS"}
+	if len(repo.written) != len(want) {
+		t.Fatalf("written = %v, want %v", repo.written, want)
+	}
+	for i, action := range want {
+		if repo.written[i] != action {
+			t.Errorf("written[%d] = %s, want %s", i, repo.written[i], action)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+type dataGuardLagRepository struct {
+	db *sql.DB
+}
+
+// NewDataGuardLagRepository creates a new Data Guard lag repository
+func NewDataGuardLagRepository(db *sql.DB) DataGuardLagRepository {
+	return &dataGuardLagRepository{db: db}
+}
+
+func (r *dataGuardLagRepository) Create(ctx context.Context, metric *DataGuardLagMetric) error {
+	query := `
+		INSERT INTO monitoring.dataguard_lag_metrics (
+			captured_at, oracle_db, transport_lag_seconds, apply_lag_seconds
+		) VALUES ($1, $2, $3, $4)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		metric.CapturedAt,
+		metric.Target,
+		metric.TransportLagSeconds,
+		metric.ApplyLagSeconds,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to create Data Guard lag metric: %w", err)
+	}
+
+	return nil
+}
+
+func (r *dataGuardLagRepository) GetByTimeRange(ctx context.Context, target string, start, end time.Time) ([]*DataGuardLagMetric, error) {
+	query := `
+		SELECT captured_at, oracle_db, transport_lag_seconds, apply_lag_seconds
+		FROM monitoring.dataguard_lag_metrics
+		WHERE oracle_db = $1 AND captured_at BETWEEN $2 AND $3
+		ORDER BY captured_at
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, target, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Data Guard lag metrics: %w", err)
+	}
+	defer rows.Close()
+
+	metrics := []*DataGuardLagMetric{}
+	for rows.Next() {
+		metric := &DataGuardLagMetric{}
+		err := rows.Scan(
+			&metric.CapturedAt,
+			&metric.Target,
+			&metric.TransportLagSeconds,
+			&metric.ApplyLagSeconds,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan Data Guard lag metric: %w", err)
+		}
+		metrics = append(metrics, metric)
+	}
+
+	return metrics, nil
+}
+
+// DeleteBefore removes snapshots older than the retention cutoff
+func (r *dataGuardLagRepository) DeleteBefore(ctx context.Context, before time.Time) error {
+	query := `DELETE FROM monitoring.dataguard_lag_metrics WHERE captured_at < $1`
+
+	if _, err := r.db.ExecContext(ctx, query, before); err != nil {
+		return fmt.Errorf("failed to delete Data Guard lag metrics: %w", err)
+	}
+
+	return nil
+}
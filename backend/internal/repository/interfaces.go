@@ -119,6 +119,7 @@ type AuditLogFilter struct {
 
 type AuditLogRepository interface {
 	Create(ctx context.Context, log *AuditLog) error
+	CreateBatch(ctx context.Context, logs []*AuditLog) error
 	GetByID(ctx context.Context, id uuid.UUID) (*AuditLog, error)
 	List(ctx context.Context, filter *AuditLogFilter) ([]*AuditLog, error)
 	Count(ctx context.Context, filter *AuditLogFilter) (int, error)
@@ -185,6 +186,7 @@ type TablespaceMetricsRepository interface {
 
 type QueryMetric struct {
 	ID             uuid.UUID
+	Target         string
 	SQLID          string
 	SQLText        *string
 	SchemaName     *string
@@ -197,6 +199,7 @@ type QueryMetric struct {
 	RowsProcessed  int
 	FirstLoadTime  *time.Time
 	LastActiveTime *time.Time
+	PlanHashValue  *int64 // plan of the most recently active child cursor
 	CapturedAt     time.Time
 }
 
@@ -204,6 +207,405 @@ type QueryMetricsRepository interface {
 	Create(ctx context.Context, metrics []*QueryMetric) error
 	GetBySQLID(ctx context.Context, sqlID string, start, end time.Time) ([]*QueryMetric, error)
 	GetByTimeRange(ctx context.Context, start, end time.Time) ([]*QueryMetric, error)
+	GetLatest(ctx context.Context, target string) ([]*QueryMetric, error)
+}
+
+// ============================================================================
+// SQL PLAN CHANGE REPOSITORY
+// ============================================================================
+
+// SQLPlanChange records a SQL_ID switching execution plans between two
+// snapshots, with the average elapsed time per execution under each plan
+type SQLPlanChange struct {
+	ID               uuid.UUID
+	Target           string
+	SQLID            string
+	OldPlanHashValue int64
+	NewPlanHashValue int64
+	OldAvgElapsedMS  float64
+	NewAvgElapsedMS  float64
+	Regressed        bool
+	DetectedAt       time.Time
+}
+
+type SQLPlanChangeRepository interface {
+	Create(ctx context.Context, change *SQLPlanChange) error
+	List(ctx context.Context, target string, start, end time.Time, regressedOnly bool) ([]*SQLPlanChange, error)
+	ListBySQLID(ctx context.Context, target, sqlID string) ([]*SQLPlanChange, error)
+	// ListLatest returns the most recent plan change of every SQL_ID
+	ListLatest(ctx context.Context, target string) ([]*SQLPlanChange, error)
+}
+
+// ============================================================================
+// ASH SAMPLE REPOSITORY
+// ============================================================================
+
+// ASHSample is one active session observed by the ASH sampler. Sessions on
+// CPU are recorded with wait class "CPU" and event "ON CPU".
+type ASHSample struct {
+	SampleTime      time.Time
+	Target          string
+	SID             int
+	Serial          int
+	Username        *string
+	SQLID           *string
+	WaitClass       string
+	Event           string
+	Module          *string
+	Program         *string
+	Machine         *string
+	BlockingSession *int
+	InstID          int
+}
+
+// ASHAggregate is the number of samples for one value of a dimension
+type ASHAggregate struct {
+	Key     string
+	Label   *string
+	Samples int
+}
+
+type ASHSampleRepository interface {
+	CreateBatch(ctx context.Context, samples []*ASHSample) error
+	Aggregate(ctx context.Context, target, dimension string, start, end time.Time) ([]*ASHAggregate, error)
+	DeleteBefore(ctx context.Context, before time.Time) (int64, error)
+}
+
+// ============================================================================
+// SYSTEM STATS REPOSITORY
+// ============================================================================
+
+// WaitEventDelta is the waiting done on one event during a collection interval
+type WaitEventDelta struct {
+	CapturedAt      time.Time
+	Target          string
+	IntervalSeconds float64
+	Event           string
+	WaitClass       string
+	Waits           int64
+	TimeWaitedMicro int64
+}
+
+// SystemStatDelta is the change of one time model or v$sysstat statistic
+// during a collection interval
+type SystemStatDelta struct {
+	CapturedAt      time.Time
+	Target          string
+	IntervalSeconds float64
+	Source          string
+	Name            string
+	Value           float64
+}
+
+type SystemStatsRepository interface {
+	CreateEventDeltas(ctx context.Context, deltas []*WaitEventDelta) error
+	CreateStatDeltas(ctx context.Context, deltas []*SystemStatDelta) error
+	TopWaitEvents(ctx context.Context, target string, start, end time.Time, limit int) ([]*WaitEventDelta, error)
+	SumStats(ctx context.Context, target string, names []string, start, end time.Time) (map[string]float64, float64, error)
+	StatHistory(ctx context.Context, target string, names []string, start, end time.Time) ([]*SystemStatDelta, error)
+	DeleteBefore(ctx context.Context, before time.Time) error
+}
+
+// ============================================================================
+// RECOVERY AREA METRICS REPOSITORY
+// ============================================================================
+
+// RecoveryAreaMetric is one snapshot of Fast Recovery Area space
+type RecoveryAreaMetric struct {
+	CapturedAt         time.Time
+	Target             string
+	SpaceLimitMB       float64
+	SpaceUsedMB        float64
+	SpaceReclaimableMB float64
+	FileCount          int
+}
+
+type RecoveryAreaMetricsRepository interface {
+	Create(ctx context.Context, metric *RecoveryAreaMetric) error
+	GetByTimeRange(ctx context.Context, target string, start, end time.Time) ([]*RecoveryAreaMetric, error)
+	DeleteBefore(ctx context.Context, before time.Time) error
+}
+
+// ============================================================================
+// DATA GUARD LAG REPOSITORY
+// ============================================================================
+
+// DataGuardLagMetric is one snapshot of a standby's lag behind its primary
+type DataGuardLagMetric struct {
+	CapturedAt          time.Time
+	Target              string
+	TransportLagSeconds *float64
+	ApplyLagSeconds     *float64
+}
+
+type DataGuardLagRepository interface {
+	Create(ctx context.Context, metric *DataGuardLagMetric) error
+	GetByTimeRange(ctx context.Context, target string, start, end time.Time) ([]*DataGuardLagMetric, error)
+	DeleteBefore(ctx context.Context, before time.Time) error
+}
+
+// ============================================================================
+// PARAMETER SNAPSHOT REPOSITORY
+// ============================================================================
+
+// ParameterSnapshot is one recorded value of an initialization parameter. A
+// snapshot is only stored when the value changes, so the value in effect at a
+// point in time is the latest snapshot captured before it.
+type ParameterSnapshot struct {
+	CapturedAt  time.Time
+	Target      string
+	Name        string
+	Value       *string
+	SPFileValue *string
+	IsDefault   bool
+}
+
+// ParameterChange is a parameter snapshot together with the values it replaced
+type ParameterChange struct {
+	ParameterSnapshot
+	PreviousValue       *string
+	PreviousSPFileValue *string
+}
+
+type ParameterSnapshotRepository interface {
+	CreateBatch(ctx context.Context, snapshots []*ParameterSnapshot) error
+	GetAsOf(ctx context.Context, target string, at time.Time) ([]*ParameterSnapshot, error)
+	ListChanges(ctx context.Context, target, name string, start, end time.Time) ([]*ParameterChange, error)
+	DeleteBefore(ctx context.Context, before time.Time) error
+}
+
+// ============================================================================
+// SEGMENT SNAPSHOT REPOSITORY
+// ============================================================================
+
+// SegmentSnapshot is the size of one segment (table, index, LOB or one of
+// their partitions) at a point in time
+type SegmentSnapshot struct {
+	CapturedAt     time.Time
+	Target         string
+	Owner          string
+	SegmentName    string
+	PartitionName  *string
+	SegmentType    string
+	TablespaceName string
+	SizeMB         float64
+	Extents        int
+}
+
+// SegmentGrowth is how much a segment grew between its first and last
+// snapshot in a window
+type SegmentGrowth struct {
+	Owner          string
+	SegmentName    string
+	PartitionName  *string
+	SegmentType    string
+	TablespaceName string
+	StartTime      time.Time
+	StartSizeMB    float64
+	EndTime        time.Time
+	EndSizeMB      float64
+	GrowthMB       float64
+}
+
+type SegmentSnapshotRepository interface {
+	CreateBatch(ctx context.Context, snapshots []*SegmentSnapshot) error
+	HasSnapshotSince(ctx context.Context, target string, since time.Time) (bool, error)
+	GetHistory(ctx context.Context, target, owner, segmentName string, partitionName *string, start, end time.Time) ([]*SegmentSnapshot, error)
+	TopGrowth(ctx context.Context, target, tablespace, owner string, start, end time.Time, limit int) ([]*SegmentGrowth, error)
+	DeleteBefore(ctx context.Context, before time.Time) error
+}
+
+// ============================================================================
+// ALERT RULE REPOSITORY
+// ============================================================================
+
+type AlertRule struct {
+	ID              uuid.UUID
+	Name            string
+	Description     *string
+	Metric          string
+	Condition       string
+	Threshold       float64
+	DurationSeconds int
+	Severity        string
+	TargetFilter    *string
+	ObjectFilter    *string
+	Enabled         bool
+	CreatedBy       *uuid.UUID
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+type AlertRuleRepository interface {
+	Create(ctx context.Context, rule *AlertRule) error
+	GetByID(ctx context.Context, id uuid.UUID) (*AlertRule, error)
+	List(ctx context.Context) ([]*AlertRule, error)
+	ListEnabled(ctx context.Context) ([]*AlertRule, error)
+	Update(ctx context.Context, rule *AlertRule) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// ============================================================================
+// ALERT REPOSITORY
+// ============================================================================
+
+type Alert struct {
+	ID              uuid.UUID
+	RuleID          *uuid.UUID
+	RuleName        string
+	Metric          string
+	Severity        string
+	Status          string
+	Target          string
+	ObjectKey       string
+	Value           float64
+	Threshold       float64
+	Message         string
+	FiredAt         time.Time
+	AcknowledgedAt  *time.Time
+	AcknowledgedBy  *string
+	ResolvedAt      *time.Time
+	LastEvaluatedAt time.Time
+}
+
+type AlertFilter struct {
+	RuleID   *uuid.UUID
+	Status   *string
+	Severity *string
+	Target   *string
+	Limit    int
+	Offset   int
+}
+
+type AlertRepository interface {
+	Create(ctx context.Context, alert *Alert) error
+	GetByID(ctx context.Context, id uuid.UUID) (*Alert, error)
+	List(ctx context.Context, filter *AlertFilter) ([]*Alert, error)
+	ListActive(ctx context.Context, target string) ([]*Alert, error)
+	UpdateValue(ctx context.Context, id uuid.UUID, value float64, evaluatedAt time.Time) error
+	Acknowledge(ctx context.Context, id uuid.UUID, acknowledgedBy string) error
+	Resolve(ctx context.Context, id uuid.UUID) error
+}
+
+// ============================================================================
+// NOTIFICATION CHANNEL REPOSITORY
+// ============================================================================
+
+// NotificationChannelSettings holds type-specific delivery settings,
+// stored as JSON
+type NotificationChannelSettings struct {
+	URL          string   `json:"url,omitempty"`
+	Secret       string   `json:"secret,omitempty"`
+	SMTPHost     string   `json:"smtpHost,omitempty"`
+	SMTPPort     int      `json:"smtpPort,omitempty"`
+	SMTPUsername string   `json:"smtpUsername,omitempty"`
+	SMTPPassword string   `json:"smtpPassword,omitempty"`
+	From         string   `json:"from,omitempty"`
+	To           []string `json:"to,omitempty"`
+}
+
+type NotificationChannel struct {
+	ID              uuid.UUID
+	Name            string
+	Type            string
+	Settings        NotificationChannelSettings
+	SubjectTemplate *string
+	BodyTemplate    *string
+	MinSeverity     string
+	Enabled         bool
+	CreatedBy       *uuid.UUID
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+type NotificationChannelRepository interface {
+	Create(ctx context.Context, channel *NotificationChannel) error
+	GetByID(ctx context.Context, id uuid.UUID) (*NotificationChannel, error)
+	List(ctx context.Context) ([]*NotificationChannel, error)
+	ListEnabled(ctx context.Context) ([]*NotificationChannel, error)
+	Update(ctx context.Context, channel *NotificationChannel) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// ============================================================================
+// NOTIFICATION REPOSITORY
+// ============================================================================
+
+// Notification records one delivery attempt of an alert event to a channel,
+// or an event that was suppressed before reaching any channel
+type Notification struct {
+	ID           uuid.UUID
+	AlertID      *uuid.UUID
+	ChannelID    *uuid.UUID
+	ChannelName  string
+	Event        string
+	Status       string
+	Attempts     int
+	Error        *string
+	Reason       *string
+	SuppressedBy *uuid.UUID
+	CreatedAt    time.Time
+}
+
+type NotificationRepository interface {
+	Create(ctx context.Context, notification *Notification) error
+	ListByAlert(ctx context.Context, alertID uuid.UUID) ([]*Notification, error)
+	FindRecentFiring(ctx context.Context, ruleID uuid.UUID, target, objectKey string, since time.Time) (*Notification, error)
+}
+
+// ============================================================================
+// SILENCE REPOSITORY
+// ============================================================================
+
+// Silence suppresses notifications of alerts whose labels match every
+// matcher (label -> glob pattern) between StartsAt and EndsAt
+type Silence struct {
+	ID            uuid.UUID
+	Matchers      map[string]string
+	Comment       string
+	CreatedBy     *uuid.UUID
+	CreatedByName string
+	StartsAt      time.Time
+	EndsAt        time.Time
+	CreatedAt     time.Time
+}
+
+type SilenceRepository interface {
+	Create(ctx context.Context, silence *Silence) error
+	GetByID(ctx context.Context, id uuid.UUID) (*Silence, error)
+	List(ctx context.Context, includeExpired bool) ([]*Silence, error)
+	ListActive(ctx context.Context, at time.Time) ([]*Silence, error)
+	Expire(ctx context.Context, id uuid.UUID, at time.Time) error
+}
+
+// ============================================================================
+// MAINTENANCE WINDOW REPOSITORY
+// ============================================================================
+
+// MaintenanceWindow suppresses notifications for matching targets during a
+// one-off or recurring period
+type MaintenanceWindow struct {
+	ID              uuid.UUID
+	Name            string
+	Target          string
+	StartsAt        time.Time
+	DurationMinutes int
+	Recurrence      string
+	Timezone        string
+	Until           *time.Time
+	Enabled         bool
+	CreatedBy       *uuid.UUID
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+type MaintenanceWindowRepository interface {
+	Create(ctx context.Context, window *MaintenanceWindow) error
+	GetByID(ctx context.Context, id uuid.UUID) (*MaintenanceWindow, error)
+	List(ctx context.Context) ([]*MaintenanceWindow, error)
+	ListEnabled(ctx context.Context) ([]*MaintenanceWindow, error)
+	Update(ctx context.Context, window *MaintenanceWindow) error
+	Delete(ctx context.Context, id uuid.UUID) error
 }
 
 // ============================================================================
@@ -219,4 +621,17 @@ type Repositories struct {
 	SessionMetrics   SessionMetricsRepository
 	TablespaceMetrics TablespaceMetricsRepository
 	QueryMetrics     QueryMetricsRepository
+	SQLPlanChanges   SQLPlanChangeRepository
+	AlertRules       AlertRuleRepository
+	Alerts           AlertRepository
+	NotificationChannels NotificationChannelRepository
+	Notifications    NotificationRepository
+	Silences         SilenceRepository
+	MaintenanceWindows MaintenanceWindowRepository
+	ASHSamples       ASHSampleRepository
+	SystemStats      SystemStatsRepository
+	RecoveryAreaMetrics RecoveryAreaMetricsRepository
+	DataGuardLag     DataGuardLagRepository
+	ParameterSnapshots ParameterSnapshotRepository
+	SegmentSnapshots SegmentSnapshotRepository
 }
\ No newline at end of file
@@ -0,0 +1,181 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type maintenanceWindowRepository struct {
+	db *sql.DB
+}
+
+// NewMaintenanceWindowRepository creates a new maintenance window repository
+func NewMaintenanceWindowRepository(db *sql.DB) MaintenanceWindowRepository {
+	return &maintenanceWindowRepository{db: db}
+}
+
+func (r *maintenanceWindowRepository) Create(ctx context.Context, window *MaintenanceWindow) error {
+	query := `
+		INSERT INTO alerting.maintenance_windows (
+			id, name, target, starts_at, duration_minutes, recurrence, timezone,
+			until, enabled, created_by, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+	`
+
+	window.ID = uuid.New()
+	window.CreatedAt = time.Now()
+	window.UpdatedAt = window.CreatedAt
+
+	_, err := r.db.ExecContext(ctx, query,
+		window.ID,
+		window.Name,
+		window.Target,
+		window.StartsAt,
+		window.DurationMinutes,
+		window.Recurrence,
+		window.Timezone,
+		window.Until,
+		window.Enabled,
+		window.CreatedBy,
+		window.CreatedAt,
+		window.UpdatedAt,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to create maintenance window: %w", err)
+	}
+
+	return nil
+}
+
+func (r *maintenanceWindowRepository) GetByID(ctx context.Context, id uuid.UUID) (*MaintenanceWindow, error) {
+	query := maintenanceWindowSelect + ` WHERE id = $1`
+
+	window, err := scanMaintenanceWindow(r.db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("maintenance window not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get maintenance window: %w", err)
+	}
+
+	return window, nil
+}
+
+func (r *maintenanceWindowRepository) List(ctx context.Context) ([]*MaintenanceWindow, error) {
+	return r.list(ctx, maintenanceWindowSelect+` ORDER BY name`)
+}
+
+func (r *maintenanceWindowRepository) ListEnabled(ctx context.Context) ([]*MaintenanceWindow, error) {
+	return r.list(ctx, maintenanceWindowSelect+` WHERE enabled = true ORDER BY name`)
+}
+
+func (r *maintenanceWindowRepository) Update(ctx context.Context, window *MaintenanceWindow) error {
+	query := `
+		UPDATE alerting.maintenance_windows
+		SET name = $1, target = $2, starts_at = $3, duration_minutes = $4, recurrence = $5,
+			timezone = $6, until = $7, enabled = $8, updated_at = $9
+		WHERE id = $10
+	`
+
+	window.UpdatedAt = time.Now()
+
+	result, err := r.db.ExecContext(ctx, query,
+		window.Name,
+		window.Target,
+		window.StartsAt,
+		window.DurationMinutes,
+		window.Recurrence,
+		window.Timezone,
+		window.Until,
+		window.Enabled,
+		window.UpdatedAt,
+		window.ID,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to update maintenance window: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rows == 0 {
+		return fmt.Errorf("maintenance window not found")
+	}
+
+	return nil
+}
+
+func (r *maintenanceWindowRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	query := `DELETE FROM alerting.maintenance_windows WHERE id = $1`
+
+	result, err := r.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete maintenance window: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rows == 0 {
+		return fmt.Errorf("maintenance window not found")
+	}
+
+	return nil
+}
+
+const maintenanceWindowSelect = `
+		SELECT id, name, target, starts_at, duration_minutes, recurrence, timezone,
+			until, enabled, created_by, created_at, updated_at
+		FROM alerting.maintenance_windows
+`
+
+func (r *maintenanceWindowRepository) list(ctx context.Context, query string, args ...interface{}) ([]*MaintenanceWindow, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list maintenance windows: %w", err)
+	}
+	defer rows.Close()
+
+	windows := []*MaintenanceWindow{}
+	for rows.Next() {
+		window, err := scanMaintenanceWindow(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan maintenance window: %w", err)
+		}
+		windows = append(windows, window)
+	}
+
+	return windows, nil
+}
+
+func scanMaintenanceWindow(row rowScanner) (*MaintenanceWindow, error) {
+	window := &MaintenanceWindow{}
+	err := row.Scan(
+		&window.ID,
+		&window.Name,
+		&window.Target,
+		&window.StartsAt,
+		&window.DurationMinutes,
+		&window.Recurrence,
+		&window.Timezone,
+		&window.Until,
+		&window.Enabled,
+		&window.CreatedBy,
+		&window.CreatedAt,
+		&window.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return window, nil
+}
@@ -0,0 +1,196 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type notificationChannelRepository struct {
+	db *sql.DB
+}
+
+// NewNotificationChannelRepository creates a new notification channel repository
+func NewNotificationChannelRepository(db *sql.DB) NotificationChannelRepository {
+	return &notificationChannelRepository{db: db}
+}
+
+func (r *notificationChannelRepository) Create(ctx context.Context, channel *NotificationChannel) error {
+	query := `
+		INSERT INTO alerting.notification_channels (
+			id, name, type, settings, subject_template, body_template,
+			min_severity, enabled, created_by, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+	`
+
+	settings, err := json.Marshal(channel.Settings)
+	if err != nil {
+		return fmt.Errorf("failed to marshal channel settings: %w", err)
+	}
+
+	channel.ID = uuid.New()
+	channel.CreatedAt = time.Now()
+	channel.UpdatedAt = channel.CreatedAt
+
+	_, err = r.db.ExecContext(ctx, query,
+		channel.ID,
+		channel.Name,
+		channel.Type,
+		settings,
+		channel.SubjectTemplate,
+		channel.BodyTemplate,
+		channel.MinSeverity,
+		channel.Enabled,
+		channel.CreatedBy,
+		channel.CreatedAt,
+		channel.UpdatedAt,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to create notification channel: %w", err)
+	}
+
+	return nil
+}
+
+func (r *notificationChannelRepository) GetByID(ctx context.Context, id uuid.UUID) (*NotificationChannel, error) {
+	query := notificationChannelSelect + ` WHERE id = $1`
+
+	channel, err := scanNotificationChannel(r.db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("notification channel not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get notification channel: %w", err)
+	}
+
+	return channel, nil
+}
+
+func (r *notificationChannelRepository) List(ctx context.Context) ([]*NotificationChannel, error) {
+	return r.list(ctx, notificationChannelSelect+` ORDER BY name`)
+}
+
+func (r *notificationChannelRepository) ListEnabled(ctx context.Context) ([]*NotificationChannel, error) {
+	return r.list(ctx, notificationChannelSelect+` WHERE enabled = true ORDER BY name`)
+}
+
+func (r *notificationChannelRepository) Update(ctx context.Context, channel *NotificationChannel) error {
+	query := `
+		UPDATE alerting.notification_channels
+		SET name = $1, type = $2, settings = $3, subject_template = $4, body_template = $5,
+			min_severity = $6, enabled = $7, updated_at = $8
+		WHERE id = $9
+	`
+
+	settings, err := json.Marshal(channel.Settings)
+	if err != nil {
+		return fmt.Errorf("failed to marshal channel settings: %w", err)
+	}
+
+	channel.UpdatedAt = time.Now()
+
+	result, err := r.db.ExecContext(ctx, query,
+		channel.Name,
+		channel.Type,
+		settings,
+		channel.SubjectTemplate,
+		channel.BodyTemplate,
+		channel.MinSeverity,
+		channel.Enabled,
+		channel.UpdatedAt,
+		channel.ID,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to update notification channel: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rows == 0 {
+		return fmt.Errorf("notification channel not found")
+	}
+
+	return nil
+}
+
+func (r *notificationChannelRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	query := `DELETE FROM alerting.notification_channels WHERE id = $1`
+
+	result, err := r.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete notification channel: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rows == 0 {
+		return fmt.Errorf("notification channel not found")
+	}
+
+	return nil
+}
+
+const notificationChannelSelect = `
+		SELECT id, name, type, settings, subject_template, body_template,
+			min_severity, enabled, created_by, created_at, updated_at
+		FROM alerting.notification_channels
+`
+
+func (r *notificationChannelRepository) list(ctx context.Context, query string, args ...interface{}) ([]*NotificationChannel, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list notification channels: %w", err)
+	}
+	defer rows.Close()
+
+	channels := []*NotificationChannel{}
+	for rows.Next() {
+		channel, err := scanNotificationChannel(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan notification channel: %w", err)
+		}
+		channels = append(channels, channel)
+	}
+
+	return channels, nil
+}
+
+func scanNotificationChannel(row rowScanner) (*NotificationChannel, error) {
+	channel := &NotificationChannel{}
+	var settings []byte
+	err := row.Scan(
+		&channel.ID,
+		&channel.Name,
+		&channel.Type,
+		&settings,
+		&channel.SubjectTemplate,
+		&channel.BodyTemplate,
+		&channel.MinSeverity,
+		&channel.Enabled,
+		&channel.CreatedBy,
+		&channel.CreatedAt,
+		&channel.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(settings) > 0 {
+		if err := json.Unmarshal(settings, &channel.Settings); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal channel settings: %w", err)
+		}
+	}
+	return channel, nil
+}
@@ -0,0 +1,136 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Notification delivery outcomes
+const (
+	NotificationStatusSent       = "SENT"
+	NotificationStatusFailed     = "FAILED"
+	NotificationStatusSuppressed = "SUPPRESSED"
+)
+
+type notificationRepository struct {
+	db *sql.DB
+}
+
+// NewNotificationRepository creates a new notification delivery log repository
+func NewNotificationRepository(db *sql.DB) NotificationRepository {
+	return &notificationRepository{db: db}
+}
+
+func (r *notificationRepository) Create(ctx context.Context, notification *Notification) error {
+	query := `
+		INSERT INTO alerting.notifications (
+			id, alert_id, channel_id, channel_name, event, status, attempts, error,
+			reason, suppressed_by, created_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+	`
+
+	notification.ID = uuid.New()
+	if notification.CreatedAt.IsZero() {
+		notification.CreatedAt = time.Now()
+	}
+
+	_, err := r.db.ExecContext(ctx, query,
+		notification.ID,
+		notification.AlertID,
+		notification.ChannelID,
+		notification.ChannelName,
+		notification.Event,
+		notification.Status,
+		notification.Attempts,
+		notification.Error,
+		notification.Reason,
+		notification.SuppressedBy,
+		notification.CreatedAt,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to create notification: %w", err)
+	}
+
+	return nil
+}
+
+func (r *notificationRepository) ListByAlert(ctx context.Context, alertID uuid.UUID) ([]*Notification, error) {
+	query := notificationSelect + `
+		WHERE alert_id = $1
+		ORDER BY created_at
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, alertID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list notifications: %w", err)
+	}
+	defer rows.Close()
+
+	notifications := []*Notification{}
+	for rows.Next() {
+		n, err := scanNotification(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan notification: %w", err)
+		}
+		notifications = append(notifications, n)
+	}
+
+	return notifications, nil
+}
+
+// FindRecentFiring returns the latest sent FIRING notification of any alert
+// for the same rule, target and object since the given time, or nil
+func (r *notificationRepository) FindRecentFiring(ctx context.Context, ruleID uuid.UUID, target, objectKey string, since time.Time) (*Notification, error) {
+	query := `
+		SELECT n.id, n.alert_id, n.channel_id, n.channel_name, n.event, n.status, n.attempts,
+			n.error, n.reason, n.suppressed_by, n.created_at
+		FROM alerting.notifications n
+		JOIN alerting.alerts a ON a.id = n.alert_id
+		WHERE a.rule_id = $1 AND a.target = $2 AND a.object_key = $3
+		  AND n.event = 'FIRING' AND n.status = 'SENT' AND n.created_at >= $4
+		ORDER BY n.created_at DESC
+		LIMIT 1
+	`
+
+	n, err := scanNotification(r.db.QueryRowContext(ctx, query, ruleID, target, objectKey, since))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find recent notification: %w", err)
+	}
+
+	return n, nil
+}
+
+const notificationSelect = `
+		SELECT id, alert_id, channel_id, channel_name, event, status, attempts,
+			error, reason, suppressed_by, created_at
+		FROM alerting.notifications
+`
+
+func scanNotification(row rowScanner) (*Notification, error) {
+	n := &Notification{}
+	err := row.Scan(
+		&n.ID,
+		&n.AlertID,
+		&n.ChannelID,
+		&n.ChannelName,
+		&n.Event,
+		&n.Status,
+		&n.Attempts,
+		&n.Error,
+		&n.Reason,
+		&n.SuppressedBy,
+		&n.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return n, nil
+}
@@ -0,0 +1,160 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+)
+
+type parameterSnapshotRepository struct {
+	db *sql.DB
+}
+
+// NewParameterSnapshotRepository creates a new parameter snapshot repository
+func NewParameterSnapshotRepository(db *sql.DB) ParameterSnapshotRepository {
+	return &parameterSnapshotRepository{db: db}
+}
+
+func (r *parameterSnapshotRepository) CreateBatch(ctx context.Context, snapshots []*ParameterSnapshot) error {
+	if len(snapshots) == 0 {
+		return nil
+	}
+
+	const columnsPerRow = 6
+	placeholders := make([]string, 0, len(snapshots))
+	args := make([]interface{}, 0, len(snapshots)*columnsPerRow)
+
+	for i, snapshot := range snapshots {
+		base := i * columnsPerRow
+		placeholders = append(placeholders, fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d)",
+			base+1, base+2, base+3, base+4, base+5, base+6))
+		args = append(args,
+			snapshot.CapturedAt,
+			snapshot.Target,
+			snapshot.Name,
+			snapshot.Value,
+			snapshot.SPFileValue,
+			snapshot.IsDefault,
+		)
+	}
+
+	query := `
+		INSERT INTO monitoring.parameter_snapshots (
+			captured_at, oracle_db, name, value, spfile_value, is_default
+		) VALUES ` + strings.Join(placeholders, ", ")
+
+	if _, err := r.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to create parameter snapshots: %w", err)
+	}
+
+	return nil
+}
+
+// GetAsOf returns the value of every parameter in effect at a point in time
+func (r *parameterSnapshotRepository) GetAsOf(ctx context.Context, target string, at time.Time) ([]*ParameterSnapshot, error) {
+	query := `
+		SELECT DISTINCT ON (name) captured_at, oracle_db, name, value, spfile_value, is_default
+		FROM monitoring.parameter_snapshots
+		WHERE oracle_db = $1 AND captured_at <= $2
+		ORDER BY name, captured_at DESC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, target, at)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get parameter snapshots: %w", err)
+	}
+	defer rows.Close()
+
+	snapshots := []*ParameterSnapshot{}
+	for rows.Next() {
+		snapshot := &ParameterSnapshot{}
+		err := rows.Scan(
+			&snapshot.CapturedAt,
+			&snapshot.Target,
+			&snapshot.Name,
+			&snapshot.Value,
+			&snapshot.SPFileValue,
+			&snapshot.IsDefault,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan parameter snapshot: %w", err)
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+
+	return snapshots, nil
+}
+
+// ListChanges returns the snapshots taken in a window that replaced an
+// earlier value, optionally for one parameter; the first snapshot of a
+// parameter is its baseline, not a change
+func (r *parameterSnapshotRepository) ListChanges(ctx context.Context, target, name string, start, end time.Time) ([]*ParameterChange, error) {
+	query := `
+		SELECT captured_at, oracle_db, name, value, spfile_value, is_default,
+			previous_value, previous_spfile_value
+		FROM (
+			SELECT
+				captured_at, oracle_db, name, value, spfile_value, is_default,
+				LAG(value) OVER w as previous_value,
+				LAG(spfile_value) OVER w as previous_spfile_value,
+				ROW_NUMBER() OVER w as version
+			FROM monitoring.parameter_snapshots
+			WHERE oracle_db = $1 AND ($2 = '' OR name = $2)
+			WINDOW w AS (PARTITION BY name ORDER BY captured_at)
+		) versions
+		WHERE version > 1 AND captured_at BETWEEN $3 AND $4
+		ORDER BY captured_at DESC, name
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, target, name, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list parameter changes: %w", err)
+	}
+	defer rows.Close()
+
+	changes := []*ParameterChange{}
+	for rows.Next() {
+		change := &ParameterChange{}
+		err := rows.Scan(
+			&change.CapturedAt,
+			&change.Target,
+			&change.Name,
+			&change.Value,
+			&change.SPFileValue,
+			&change.IsDefault,
+			&change.PreviousValue,
+			&change.PreviousSPFileValue,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan parameter change: %w", err)
+		}
+		changes = append(changes, change)
+	}
+
+	return changes, nil
+}
+
+// DeleteBefore removes snapshots superseded before the retention cutoff,
+// keeping the one still in effect at the cutoff so values can be resolved
+func (r *parameterSnapshotRepository) DeleteBefore(ctx context.Context, before time.Time) error {
+	query := `
+		DELETE FROM monitoring.parameter_snapshots s
+		WHERE s.captured_at < $1
+		  AND EXISTS (
+			SELECT 1
+			FROM monitoring.parameter_snapshots n
+			WHERE n.oracle_db = s.oracle_db
+			  AND n.name = s.name
+			  AND n.captured_at > s.captured_at
+			  AND n.captured_at <= $1
+		  )
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, before); err != nil {
+		return fmt.Errorf("failed to delete parameter snapshots: %w", err)
+	}
+
+	return nil
+}
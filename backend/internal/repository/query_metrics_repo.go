@@ -3,8 +3,10 @@ package repository
 import (
 	"context"
 	"database/sql"
-	// "fmt"
+	"fmt"
 	"time"
+
+	"github.com/google/uuid"
 )
 
 type queryMetricsRepository struct {
@@ -17,14 +19,129 @@ func NewQueryMetricsRepository(db *sql.DB) QueryMetricsRepository {
 }
 
 func (r *queryMetricsRepository) Create(ctx context.Context, metrics []*QueryMetric) error {
-	// Simplified implementation for now
+	if len(metrics) == 0 {
+		return nil
+	}
+
+	query := `
+		INSERT INTO monitoring.sql_metrics (
+			id, oracle_db, sql_id, sql_text, parsing_schema, executions,
+			elapsed_time_ms, cpu_time_ms, disk_reads, buffer_gets, rows_processed,
+			first_load_time, last_active_time, plan_hash_value, captured_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+	`
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// All rows of one snapshot share the same capture time
+	capturedAt := time.Now()
+
+	for _, metric := range metrics {
+		metric.ID = uuid.New()
+		metric.CapturedAt = capturedAt
+
+		_, err := tx.ExecContext(ctx, query,
+			metric.ID,
+			metric.Target,
+			metric.SQLID,
+			metric.SQLText,
+			metric.ParsingSchema,
+			metric.Executions,
+			metric.ElapsedTimeMS,
+			metric.CPUTimeMS,
+			metric.DiskReads,
+			metric.BufferGets,
+			metric.RowsProcessed,
+			metric.FirstLoadTime,
+			metric.LastActiveTime,
+			metric.PlanHashValue,
+			metric.CapturedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to insert query metric: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
 	return nil
 }
 
 func (r *queryMetricsRepository) GetBySQLID(ctx context.Context, sqlID string, start, end time.Time) ([]*QueryMetric, error) {
-	return []*QueryMetric{}, nil
+	query := queryMetricsSelect + `
+		WHERE sql_id = $1 AND captured_at BETWEEN $2 AND $3
+		ORDER BY captured_at ASC
+	`
+
+	return r.list(ctx, query, sqlID, start, end)
 }
 
 func (r *queryMetricsRepository) GetByTimeRange(ctx context.Context, start, end time.Time) ([]*QueryMetric, error) {
-	return []*QueryMetric{}, nil
-}
\ No newline at end of file
+	query := queryMetricsSelect + `
+		WHERE captured_at BETWEEN $1 AND $2
+		ORDER BY captured_at DESC, elapsed_time_ms DESC
+	`
+
+	return r.list(ctx, query, start, end)
+}
+
+// GetLatest returns every row of the most recent snapshot for a target
+func (r *queryMetricsRepository) GetLatest(ctx context.Context, target string) ([]*QueryMetric, error) {
+	query := queryMetricsSelect + `
+		WHERE oracle_db = $1
+		  AND captured_at = (
+			SELECT MAX(captured_at) FROM monitoring.sql_metrics WHERE oracle_db = $1
+		  )
+	`
+
+	return r.list(ctx, query, target)
+}
+
+const queryMetricsSelect = `
+		SELECT id, oracle_db, sql_id, sql_text, parsing_schema, executions,
+			elapsed_time_ms, cpu_time_ms, disk_reads, buffer_gets, rows_processed,
+			first_load_time, last_active_time, plan_hash_value, captured_at
+		FROM monitoring.sql_metrics
+`
+
+func (r *queryMetricsRepository) list(ctx context.Context, query string, args ...interface{}) ([]*QueryMetric, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query metrics: %w", err)
+	}
+	defer rows.Close()
+
+	metrics := []*QueryMetric{}
+	for rows.Next() {
+		metric := &QueryMetric{}
+		err := rows.Scan(
+			&metric.ID,
+			&metric.Target,
+			&metric.SQLID,
+			&metric.SQLText,
+			&metric.ParsingSchema,
+			&metric.Executions,
+			&metric.ElapsedTimeMS,
+			&metric.CPUTimeMS,
+			&metric.DiskReads,
+			&metric.BufferGets,
+			&metric.RowsProcessed,
+			&metric.FirstLoadTime,
+			&metric.LastActiveTime,
+			&metric.PlanHashValue,
+			&metric.CapturedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan query metric: %w", err)
+		}
+		metrics = append(metrics, metric)
+	}
+
+	return metrics, nil
+}
@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+type recoveryAreaMetricsRepository struct {
+	db *sql.DB
+}
+
+// NewRecoveryAreaMetricsRepository creates a new recovery area metrics repository
+func NewRecoveryAreaMetricsRepository(db *sql.DB) RecoveryAreaMetricsRepository {
+	return &recoveryAreaMetricsRepository{db: db}
+}
+
+func (r *recoveryAreaMetricsRepository) Create(ctx context.Context, metric *RecoveryAreaMetric) error {
+	query := `
+		INSERT INTO monitoring.recovery_area_metrics (
+			captured_at, oracle_db, space_limit_mb, space_used_mb, space_reclaimable_mb, file_count
+		) VALUES ($1, $2, $3, $4, $5, $6)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		metric.CapturedAt,
+		metric.Target,
+		metric.SpaceLimitMB,
+		metric.SpaceUsedMB,
+		metric.SpaceReclaimableMB,
+		metric.FileCount,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to create recovery area metric: %w", err)
+	}
+
+	return nil
+}
+
+func (r *recoveryAreaMetricsRepository) GetByTimeRange(ctx context.Context, target string, start, end time.Time) ([]*RecoveryAreaMetric, error) {
+	query := `
+		SELECT captured_at, oracle_db, space_limit_mb, space_used_mb, space_reclaimable_mb, file_count
+		FROM monitoring.recovery_area_metrics
+		WHERE oracle_db = $1 AND captured_at BETWEEN $2 AND $3
+		ORDER BY captured_at
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, target, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get recovery area metrics: %w", err)
+	}
+	defer rows.Close()
+
+	metrics := []*RecoveryAreaMetric{}
+	for rows.Next() {
+		metric := &RecoveryAreaMetric{}
+		err := rows.Scan(
+			&metric.CapturedAt,
+			&metric.Target,
+			&metric.SpaceLimitMB,
+			&metric.SpaceUsedMB,
+			&metric.SpaceReclaimableMB,
+			&metric.FileCount,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan recovery area metric: %w", err)
+		}
+		metrics = append(metrics, metric)
+	}
+
+	return metrics, nil
+}
+
+// DeleteBefore removes snapshots older than the retention cutoff
+func (r *recoveryAreaMetricsRepository) DeleteBefore(ctx context.Context, before time.Time) error {
+	query := `DELETE FROM monitoring.recovery_area_metrics WHERE captured_at < $1`
+
+	if _, err := r.db.ExecContext(ctx, query, before); err != nil {
+		return fmt.Errorf("failed to delete recovery area metrics: %w", err)
+	}
+
+	return nil
+}
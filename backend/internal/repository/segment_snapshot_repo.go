@@ -0,0 +1,180 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+)
+
+type segmentSnapshotRepository struct {
+	db *sql.DB
+}
+
+// NewSegmentSnapshotRepository creates a new segment snapshot repository
+func NewSegmentSnapshotRepository(db *sql.DB) SegmentSnapshotRepository {
+	return &segmentSnapshotRepository{db: db}
+}
+
+func (r *segmentSnapshotRepository) CreateBatch(ctx context.Context, snapshots []*SegmentSnapshot) error {
+	if len(snapshots) == 0 {
+		return nil
+	}
+
+	const columnsPerRow = 9
+	placeholders := make([]string, 0, len(snapshots))
+	args := make([]interface{}, 0, len(snapshots)*columnsPerRow)
+
+	for i, snapshot := range snapshots {
+		base := i * columnsPerRow
+		placeholders = append(placeholders, fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d)",
+			base+1, base+2, base+3, base+4, base+5, base+6, base+7, base+8, base+9))
+		args = append(args,
+			snapshot.CapturedAt,
+			snapshot.Target,
+			snapshot.Owner,
+			snapshot.SegmentName,
+			snapshot.PartitionName,
+			snapshot.SegmentType,
+			snapshot.TablespaceName,
+			snapshot.SizeMB,
+			snapshot.Extents,
+		)
+	}
+
+	query := `
+		INSERT INTO monitoring.segment_snapshots (
+			captured_at, oracle_db, owner, segment_name, partition_name,
+			segment_type, tablespace_name, size_mb, extents
+		) VALUES ` + strings.Join(placeholders, ", ")
+
+	if _, err := r.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to create segment snapshots: %w", err)
+	}
+
+	return nil
+}
+
+// HasSnapshotSince reports whether segments were snapshotted at or after since
+func (r *segmentSnapshotRepository) HasSnapshotSince(ctx context.Context, target string, since time.Time) (bool, error) {
+	query := `
+		SELECT EXISTS (
+			SELECT 1
+			FROM monitoring.segment_snapshots
+			WHERE oracle_db = $1 AND captured_at >= $2
+		)
+	`
+
+	var exists bool
+	if err := r.db.QueryRowContext(ctx, query, target, since).Scan(&exists); err != nil {
+		return false, fmt.Errorf("failed to check segment snapshots: %w", err)
+	}
+
+	return exists, nil
+}
+
+// GetHistory returns the snapshots of one segment (or segment partition) in a window
+func (r *segmentSnapshotRepository) GetHistory(ctx context.Context, target, owner, segmentName string, partitionName *string, start, end time.Time) ([]*SegmentSnapshot, error) {
+	query := `
+		SELECT captured_at, oracle_db, owner, segment_name, partition_name,
+			segment_type, tablespace_name, size_mb, extents
+		FROM monitoring.segment_snapshots
+		WHERE oracle_db = $1 AND owner = $2 AND segment_name = $3
+		  AND partition_name IS NOT DISTINCT FROM $4
+		  AND captured_at BETWEEN $5 AND $6
+		ORDER BY captured_at
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, target, owner, segmentName, partitionName, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get segment history: %w", err)
+	}
+	defer rows.Close()
+
+	snapshots := []*SegmentSnapshot{}
+	for rows.Next() {
+		snapshot := &SegmentSnapshot{}
+		err := rows.Scan(
+			&snapshot.CapturedAt,
+			&snapshot.Target,
+			&snapshot.Owner,
+			&snapshot.SegmentName,
+			&snapshot.PartitionName,
+			&snapshot.SegmentType,
+			&snapshot.TablespaceName,
+			&snapshot.SizeMB,
+			&snapshot.Extents,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan segment snapshot: %w", err)
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+
+	return snapshots, nil
+}
+
+// TopGrowth returns the segments that grew the most between their first and
+// last snapshot in a window, optionally in one tablespace or of one owner
+func (r *segmentSnapshotRepository) TopGrowth(ctx context.Context, target, tablespace, owner string, start, end time.Time, limit int) ([]*SegmentGrowth, error) {
+	query := `
+		SELECT owner, segment_name, partition_name, segment_type, tablespace_name,
+			start_time, start_size_mb, captured_at, size_mb, size_mb - start_size_mb as growth_mb
+		FROM (
+			SELECT
+				owner, segment_name, partition_name, segment_type, tablespace_name,
+				captured_at, size_mb,
+				FIRST_VALUE(captured_at) OVER w as start_time,
+				FIRST_VALUE(size_mb) OVER w as start_size_mb,
+				ROW_NUMBER() OVER (PARTITION BY owner, segment_name, partition_name ORDER BY captured_at DESC) as latest
+			FROM monitoring.segment_snapshots
+			WHERE oracle_db = $1 AND captured_at BETWEEN $2 AND $3
+			  AND ($4 = '' OR tablespace_name = $4)
+			  AND ($5 = '' OR owner = $5)
+			WINDOW w AS (PARTITION BY owner, segment_name, partition_name ORDER BY captured_at)
+		) windowed
+		WHERE latest = 1 AND size_mb > start_size_mb
+		ORDER BY growth_mb DESC
+		LIMIT $6
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, target, start, end, tablespace, owner, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get segment growth: %w", err)
+	}
+	defer rows.Close()
+
+	growth := []*SegmentGrowth{}
+	for rows.Next() {
+		g := &SegmentGrowth{}
+		err := rows.Scan(
+			&g.Owner,
+			&g.SegmentName,
+			&g.PartitionName,
+			&g.SegmentType,
+			&g.TablespaceName,
+			&g.StartTime,
+			&g.StartSizeMB,
+			&g.EndTime,
+			&g.EndSizeMB,
+			&g.GrowthMB,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan segment growth: %w", err)
+		}
+		growth = append(growth, g)
+	}
+
+	return growth, nil
+}
+
+func (r *segmentSnapshotRepository) DeleteBefore(ctx context.Context, before time.Time) error {
+	query := `DELETE FROM monitoring.segment_snapshots WHERE captured_at < $1`
+
+	if _, err := r.db.ExecContext(ctx, query, before); err != nil {
+		return fmt.Errorf("failed to delete segment snapshots: %w", err)
+	}
+
+	return nil
+}
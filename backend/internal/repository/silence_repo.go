@@ -0,0 +1,146 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type silenceRepository struct {
+	db *sql.DB
+}
+
+// NewSilenceRepository creates a new silence repository
+func NewSilenceRepository(db *sql.DB) SilenceRepository {
+	return &silenceRepository{db: db}
+}
+
+func (r *silenceRepository) Create(ctx context.Context, silence *Silence) error {
+	query := `
+		INSERT INTO alerting.silences (
+			id, matchers, comment, created_by, created_by_name, starts_at, ends_at, created_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+
+	matchers, err := json.Marshal(silence.Matchers)
+	if err != nil {
+		return fmt.Errorf("failed to marshal silence matchers: %w", err)
+	}
+
+	silence.ID = uuid.New()
+	silence.CreatedAt = time.Now()
+
+	_, err = r.db.ExecContext(ctx, query,
+		silence.ID,
+		matchers,
+		silence.Comment,
+		silence.CreatedBy,
+		silence.CreatedByName,
+		silence.StartsAt,
+		silence.EndsAt,
+		silence.CreatedAt,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to create silence: %w", err)
+	}
+
+	return nil
+}
+
+func (r *silenceRepository) GetByID(ctx context.Context, id uuid.UUID) (*Silence, error) {
+	query := silenceSelect + ` WHERE id = $1`
+
+	silence, err := scanSilence(r.db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("silence not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get silence: %w", err)
+	}
+
+	return silence, nil
+}
+
+func (r *silenceRepository) List(ctx context.Context, includeExpired bool) ([]*Silence, error) {
+	if includeExpired {
+		return r.list(ctx, silenceSelect+` ORDER BY starts_at DESC`)
+	}
+	return r.list(ctx, silenceSelect+` WHERE ends_at > $1 ORDER BY starts_at DESC`, time.Now())
+}
+
+func (r *silenceRepository) ListActive(ctx context.Context, at time.Time) ([]*Silence, error) {
+	return r.list(ctx, silenceSelect+` WHERE starts_at <= $1 AND ends_at > $1`, at)
+}
+
+// Expire ends a silence early by moving its end time to at
+func (r *silenceRepository) Expire(ctx context.Context, id uuid.UUID, at time.Time) error {
+	query := `UPDATE alerting.silences SET ends_at = $1 WHERE id = $2 AND ends_at > $1`
+
+	result, err := r.db.ExecContext(ctx, query, at, id)
+	if err != nil {
+		return fmt.Errorf("failed to expire silence: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rows == 0 {
+		return fmt.Errorf("silence not found or already expired")
+	}
+
+	return nil
+}
+
+const silenceSelect = `
+		SELECT id, matchers, comment, created_by, created_by_name, starts_at, ends_at, created_at
+		FROM alerting.silences
+`
+
+func (r *silenceRepository) list(ctx context.Context, query string, args ...interface{}) ([]*Silence, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list silences: %w", err)
+	}
+	defer rows.Close()
+
+	silences := []*Silence{}
+	for rows.Next() {
+		silence, err := scanSilence(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan silence: %w", err)
+		}
+		silences = append(silences, silence)
+	}
+
+	return silences, nil
+}
+
+func scanSilence(row rowScanner) (*Silence, error) {
+	silence := &Silence{}
+	var matchers []byte
+	err := row.Scan(
+		&silence.ID,
+		&matchers,
+		&silence.Comment,
+		&silence.CreatedBy,
+		&silence.CreatedByName,
+		&silence.StartsAt,
+		&silence.EndsAt,
+		&silence.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(matchers, &silence.Matchers); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal silence matchers: %w", err)
+	}
+	return silence, nil
+}
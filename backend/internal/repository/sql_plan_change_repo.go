@@ -0,0 +1,119 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type sqlPlanChangeRepository struct {
+	db *sql.DB
+}
+
+// NewSQLPlanChangeRepository creates a new SQL plan change repository
+func NewSQLPlanChangeRepository(db *sql.DB) SQLPlanChangeRepository {
+	return &sqlPlanChangeRepository{db: db}
+}
+
+func (r *sqlPlanChangeRepository) Create(ctx context.Context, change *SQLPlanChange) error {
+	query := `
+		INSERT INTO monitoring.sql_plan_changes (
+			id, oracle_db, sql_id, old_plan_hash_value, new_plan_hash_value,
+			old_avg_elapsed_ms, new_avg_elapsed_ms, regressed, detected_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`
+
+	change.ID = uuid.New()
+	if change.DetectedAt.IsZero() {
+		change.DetectedAt = time.Now()
+	}
+
+	_, err := r.db.ExecContext(ctx, query,
+		change.ID,
+		change.Target,
+		change.SQLID,
+		change.OldPlanHashValue,
+		change.NewPlanHashValue,
+		change.OldAvgElapsedMS,
+		change.NewAvgElapsedMS,
+		change.Regressed,
+		change.DetectedAt,
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to create SQL plan change: %w", err)
+	}
+
+	return nil
+}
+
+func (r *sqlPlanChangeRepository) List(ctx context.Context, target string, start, end time.Time, regressedOnly bool) ([]*SQLPlanChange, error) {
+	query := sqlPlanChangeSelect + `
+		WHERE oracle_db = $1 AND detected_at BETWEEN $2 AND $3
+		  AND (regressed OR NOT $4)
+		ORDER BY detected_at DESC
+	`
+
+	return r.list(ctx, query, target, start, end, regressedOnly)
+}
+
+func (r *sqlPlanChangeRepository) ListBySQLID(ctx context.Context, target, sqlID string) ([]*SQLPlanChange, error) {
+	query := sqlPlanChangeSelect + `
+		WHERE oracle_db = $1 AND sql_id = $2
+		ORDER BY detected_at DESC
+	`
+
+	return r.list(ctx, query, target, sqlID)
+}
+
+func (r *sqlPlanChangeRepository) ListLatest(ctx context.Context, target string) ([]*SQLPlanChange, error) {
+	query := `
+		SELECT DISTINCT ON (sql_id)
+			id, oracle_db, sql_id, old_plan_hash_value, new_plan_hash_value,
+			old_avg_elapsed_ms, new_avg_elapsed_ms, regressed, detected_at
+		FROM monitoring.sql_plan_changes
+		WHERE oracle_db = $1
+		ORDER BY sql_id, detected_at DESC
+	`
+
+	return r.list(ctx, query, target)
+}
+
+const sqlPlanChangeSelect = `
+		SELECT id, oracle_db, sql_id, old_plan_hash_value, new_plan_hash_value,
+			old_avg_elapsed_ms, new_avg_elapsed_ms, regressed, detected_at
+		FROM monitoring.sql_plan_changes
+`
+
+func (r *sqlPlanChangeRepository) list(ctx context.Context, query string, args ...interface{}) ([]*SQLPlanChange, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list SQL plan changes: %w", err)
+	}
+	defer rows.Close()
+
+	changes := []*SQLPlanChange{}
+	for rows.Next() {
+		change := &SQLPlanChange{}
+		err := rows.Scan(
+			&change.ID,
+			&change.Target,
+			&change.SQLID,
+			&change.OldPlanHashValue,
+			&change.NewPlanHashValue,
+			&change.OldAvgElapsedMS,
+			&change.NewAvgElapsedMS,
+			&change.Regressed,
+			&change.DetectedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan SQL plan change: %w", err)
+		}
+		changes = append(changes, change)
+	}
+
+	return changes, nil
+}
@@ -0,0 +1,192 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// System statistic sources
+const (
+	StatSourceTimeModel = "TIME_MODEL"
+	StatSourceSysstat   = "SYSSTAT"
+)
+
+type systemStatsRepository struct {
+	db *sql.DB
+}
+
+// NewSystemStatsRepository creates a new system statistics repository
+func NewSystemStatsRepository(db *sql.DB) SystemStatsRepository {
+	return &systemStatsRepository{db: db}
+}
+
+func (r *systemStatsRepository) CreateEventDeltas(ctx context.Context, deltas []*WaitEventDelta) error {
+	if len(deltas) == 0 {
+		return nil
+	}
+
+	const columnsPerRow = 7
+	placeholders := make([]string, 0, len(deltas))
+	args := make([]interface{}, 0, len(deltas)*columnsPerRow)
+
+	for i, d := range deltas {
+		base := i * columnsPerRow
+		placeholders = append(placeholders, fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d)",
+			base+1, base+2, base+3, base+4, base+5, base+6, base+7))
+		args = append(args, d.CapturedAt, d.Target, d.IntervalSeconds, d.Event, d.WaitClass, d.Waits, d.TimeWaitedMicro)
+	}
+
+	query := `
+		INSERT INTO monitoring.wait_event_deltas (
+			captured_at, oracle_db, interval_seconds, event, wait_class, waits, time_waited_micro
+		) VALUES ` + strings.Join(placeholders, ", ")
+
+	if _, err := r.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to insert wait event deltas: %w", err)
+	}
+
+	return nil
+}
+
+func (r *systemStatsRepository) CreateStatDeltas(ctx context.Context, deltas []*SystemStatDelta) error {
+	if len(deltas) == 0 {
+		return nil
+	}
+
+	const columnsPerRow = 6
+	placeholders := make([]string, 0, len(deltas))
+	args := make([]interface{}, 0, len(deltas)*columnsPerRow)
+
+	for i, d := range deltas {
+		base := i * columnsPerRow
+		placeholders = append(placeholders, fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d)",
+			base+1, base+2, base+3, base+4, base+5, base+6))
+		args = append(args, d.CapturedAt, d.Target, d.IntervalSeconds, d.Source, d.Name, d.Value)
+	}
+
+	query := `
+		INSERT INTO monitoring.system_stat_deltas (
+			captured_at, oracle_db, interval_seconds, source, name, value
+		) VALUES ` + strings.Join(placeholders, ", ")
+
+	if _, err := r.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to insert system stat deltas: %w", err)
+	}
+
+	return nil
+}
+
+// TopWaitEvents sums wait event deltas over a window, most time waited first
+func (r *systemStatsRepository) TopWaitEvents(ctx context.Context, target string, start, end time.Time, limit int) ([]*WaitEventDelta, error) {
+	query := `
+		SELECT event, MAX(wait_class), SUM(waits), SUM(time_waited_micro)
+		FROM monitoring.wait_event_deltas
+		WHERE oracle_db = $1 AND captured_at > $2 AND captured_at <= $3
+		GROUP BY event
+		HAVING SUM(time_waited_micro) > 0
+		ORDER BY SUM(time_waited_micro) DESC
+		LIMIT $4
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, target, start, end, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query top wait events: %w", err)
+	}
+	defer rows.Close()
+
+	events := []*WaitEventDelta{}
+	for rows.Next() {
+		e := &WaitEventDelta{Target: target}
+		if err := rows.Scan(&e.Event, &e.WaitClass, &e.Waits, &e.TimeWaitedMicro); err != nil {
+			return nil, fmt.Errorf("failed to scan wait event: %w", err)
+		}
+		events = append(events, e)
+	}
+
+	return events, nil
+}
+
+// SumStats sums statistic deltas over a window and returns the totals by
+// name along with the number of seconds the collected intervals cover
+func (r *systemStatsRepository) SumStats(ctx context.Context, target string, names []string, start, end time.Time) (map[string]float64, float64, error) {
+	query := `
+		SELECT name, SUM(value)
+		FROM monitoring.system_stat_deltas
+		WHERE oracle_db = $1 AND captured_at > $2 AND captured_at <= $3 AND name = ANY($4)
+		GROUP BY name
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, target, start, end, pq.Array(names))
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to sum system stats: %w", err)
+	}
+	defer rows.Close()
+
+	sums := map[string]float64{}
+	for rows.Next() {
+		var name string
+		var value float64
+		if err := rows.Scan(&name, &value); err != nil {
+			return nil, 0, fmt.Errorf("failed to scan system stat: %w", err)
+		}
+		sums[name] = value
+	}
+
+	coverageQuery := `
+		SELECT COALESCE(SUM(interval_seconds), 0)
+		FROM (
+			SELECT DISTINCT captured_at, interval_seconds
+			FROM monitoring.system_stat_deltas
+			WHERE oracle_db = $1 AND captured_at > $2 AND captured_at <= $3
+		) intervals
+	`
+
+	var covered float64
+	if err := r.db.QueryRowContext(ctx, coverageQuery, target, start, end).Scan(&covered); err != nil {
+		return nil, 0, fmt.Errorf("failed to get stats coverage: %w", err)
+	}
+
+	return sums, covered, nil
+}
+
+// StatHistory returns the per-interval deltas of the given statistics
+func (r *systemStatsRepository) StatHistory(ctx context.Context, target string, names []string, start, end time.Time) ([]*SystemStatDelta, error) {
+	query := `
+		SELECT captured_at, oracle_db, interval_seconds, source, name, value
+		FROM monitoring.system_stat_deltas
+		WHERE oracle_db = $1 AND captured_at > $2 AND captured_at <= $3 AND name = ANY($4)
+		ORDER BY captured_at, name
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, target, start, end, pq.Array(names))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query system stat history: %w", err)
+	}
+	defer rows.Close()
+
+	deltas := []*SystemStatDelta{}
+	for rows.Next() {
+		d := &SystemStatDelta{}
+		if err := rows.Scan(&d.CapturedAt, &d.Target, &d.IntervalSeconds, &d.Source, &d.Name, &d.Value); err != nil {
+			return nil, fmt.Errorf("failed to scan system stat: %w", err)
+		}
+		deltas = append(deltas, d)
+	}
+
+	return deltas, nil
+}
+
+// DeleteBefore removes deltas older than the retention cutoff
+func (r *systemStatsRepository) DeleteBefore(ctx context.Context, before time.Time) error {
+	for _, table := range []string{"monitoring.wait_event_deltas", "monitoring.system_stat_deltas"} {
+		if _, err := r.db.ExecContext(ctx, `DELETE FROM `+table+` WHERE captured_at < $1`, before); err != nil {
+			return fmt.Errorf("failed to delete from %s: %w", table, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,810 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aashiq-04/oracle-dba/internal/repository"
+	"github.com/aashiq-04/oracle-dba/pkg/logger"
+)
+
+// Alert metrics supported by the rule engine
+const (
+	MetricTablespaceUsage       = "TABLESPACE_USAGE_PCT"
+	MetricTablespaceMaxUsage    = "TABLESPACE_MAX_USAGE_PCT"
+	MetricBlockedSeconds        = "BLOCKED_SECONDS"
+	MetricActiveSessionCount    = "ACTIVE_SESSION_COUNT"
+	MetricInvalidObjectCount    = "INVALID_OBJECT_COUNT"
+	MetricSQLElapsedDelta       = "SQL_ELAPSED_DELTA"
+	MetricSQLPlanRegression     = "SQL_PLAN_REGRESSION_PCT"
+	MetricASMDiskGroupUsage     = "ASM_DISKGROUP_USAGE_PCT"
+	MetricFRANonReclaimable     = "FRA_NON_RECLAIMABLE_PCT"
+	MetricFullBackupAge         = "BACKUP_FULL_AGE_HOURS"
+	MetricArchivelogBackupAge   = "BACKUP_ARCHIVELOG_AGE_HOURS"
+	MetricDataGuardApplyLag     = "DATAGUARD_APPLY_LAG_SECONDS"
+	MetricDataGuardTransportLag = "DATAGUARD_TRANSPORT_LAG_SECONDS"
+)
+
+// Alert rule conditions
+var alertConditions = map[string]string{
+	"GT":  ">",
+	"GTE": ">=",
+	"LT":  "<",
+	"LTE": "<=",
+	"EQ":  "=",
+}
+
+// Alert severities
+var alertSeverities = map[string]bool{
+	"INFO":     true,
+	"WARNING":  true,
+	"CRITICAL": true,
+}
+
+// MetricSample is one observed value of a metric for a single object
+// (a tablespace, a blocked session, a schema, a SQL_ID...)
+type MetricSample struct {
+	ObjectKey string
+	Value     float64
+}
+
+// MetricCollector gathers the current samples of one metric
+type MetricCollector func(ctx context.Context) ([]MetricSample, error)
+
+// AlertNotifier is told when alerts fire or resolve
+type AlertNotifier interface {
+	NotifyAlert(event string, alert *repository.Alert)
+}
+
+// sqlSnapshot is the SQL statistics snapshot taken during one evaluation
+// cycle, shared by every SQL metric, and the snapshot before it
+type sqlSnapshot struct {
+	previous []*repository.QueryMetric
+	current  []*repository.QueryMetric
+}
+
+// AlertService evaluates alert rules against Oracle metrics and manages
+// the alert lifecycle (FIRING -> ACKNOWLEDGED -> RESOLVED)
+type AlertService struct {
+	oracleService    *OracleService
+	ruleRepo         repository.AlertRuleRepository
+	alertRepo        repository.AlertRepository
+	queryMetricsRepo repository.QueryMetricsRepository
+	planChangeRepo   repository.SQLPlanChangeRepository
+	auditRepo        repository.AuditLogRepository
+	notifier         AlertNotifier
+	logger           logger.Logger
+	target           string
+	interval         time.Duration
+	sqlTopN          int
+
+	collectors  map[string]MetricCollector
+	pending     map[string]time.Time // rule/object keys whose condition holds but not yet for long enough
+	sqlSnapshot *sqlSnapshot         // reset at the start of every evaluation cycle
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewAlertService creates a new alert rule engine for one Oracle target
+func NewAlertService(
+	oracleService *OracleService,
+	ruleRepo repository.AlertRuleRepository,
+	alertRepo repository.AlertRepository,
+	queryMetricsRepo repository.QueryMetricsRepository,
+	planChangeRepo repository.SQLPlanChangeRepository,
+	auditRepo repository.AuditLogRepository,
+	notifier AlertNotifier,
+	log logger.Logger,
+	target string,
+	interval time.Duration,
+	sqlTopN int,
+) *AlertService {
+	s := &AlertService{
+		oracleService:    oracleService,
+		ruleRepo:         ruleRepo,
+		alertRepo:        alertRepo,
+		queryMetricsRepo: queryMetricsRepo,
+		planChangeRepo:   planChangeRepo,
+		auditRepo:        auditRepo,
+		notifier:         notifier,
+		logger:           log,
+		target:           target,
+		interval:         interval,
+		sqlTopN:          sqlTopN,
+		collectors:       map[string]MetricCollector{},
+		pending:          map[string]time.Time{},
+	}
+
+	s.RegisterCollector(MetricTablespaceUsage, s.collectTablespaceUsage)
+	s.RegisterCollector(MetricTablespaceMaxUsage, s.collectTablespaceMaxUsage)
+	s.RegisterCollector(MetricBlockedSeconds, s.collectBlockedSeconds)
+	s.RegisterCollector(MetricActiveSessionCount, s.collectActiveSessionCount)
+	s.RegisterCollector(MetricInvalidObjectCount, s.collectInvalidObjectCount)
+	s.RegisterCollector(MetricSQLElapsedDelta, s.collectSQLElapsedDelta)
+	s.RegisterCollector(MetricSQLPlanRegression, s.collectSQLPlanRegression)
+	s.RegisterCollector(MetricASMDiskGroupUsage, s.collectASMDiskGroupUsage)
+	s.RegisterCollector(MetricFRANonReclaimable, s.collectFRANonReclaimable)
+	s.RegisterCollector(MetricFullBackupAge, s.collectFullBackupAge)
+	s.RegisterCollector(MetricArchivelogBackupAge, s.collectArchivelogBackupAge)
+	s.RegisterCollector(MetricDataGuardApplyLag, s.dataGuardLagCollector("apply lag"))
+	s.RegisterCollector(MetricDataGuardTransportLag, s.dataGuardLagCollector("transport lag"))
+
+	return s
+}
+
+// RegisterCollector makes a metric available to alert rules
+func (s *AlertService) RegisterCollector(metric string, collector MetricCollector) {
+	s.collectors[metric] = collector
+}
+
+// Start begins periodic rule evaluation in the background
+func (s *AlertService) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+	s.done = make(chan struct{})
+
+	go func() {
+		defer close(s.done)
+
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := s.evaluate(ctx); err != nil && ctx.Err() == nil {
+					s.logger.Error("Alert evaluation failed", logger.Error(err))
+				}
+			}
+		}
+	}()
+}
+
+// Stop halts rule evaluation and waits for the current cycle to finish
+func (s *AlertService) Stop() {
+	if s.cancel == nil {
+		return
+	}
+	s.cancel()
+	<-s.done
+}
+
+// ============================================================================
+// RULE EVALUATION
+// ============================================================================
+
+func (s *AlertService) evaluate(ctx context.Context) error {
+	s.sqlSnapshot = nil
+
+	rules, err := s.ruleRepo.ListEnabled(ctx)
+	if err != nil {
+		return err
+	}
+
+	active, err := s.alertRepo.ListActive(ctx, s.target)
+	if err != nil {
+		return err
+	}
+
+	// Alerts of deleted rules are keyed by their own ID so they always resolve
+	activeByKey := make(map[string]*repository.Alert, len(active))
+	for _, alert := range active {
+		if alert.RuleID != nil {
+			activeByKey[alertKey(*alert.RuleID, alert.ObjectKey)] = alert
+		} else {
+			activeByKey[alert.ID.String()] = alert
+		}
+	}
+
+	now := time.Now()
+	samples := map[string][]MetricSample{}
+	failedMetrics := map[string]bool{}
+	evaluatedRules := map[uuid.UUID]*repository.AlertRule{}
+	holding := map[string]bool{}
+
+	for _, rule := range rules {
+		if !matchPattern(rule.TargetFilter, s.target) {
+			continue
+		}
+
+		metricSamples, collected := samples[rule.Metric]
+		if !collected && !failedMetrics[rule.Metric] {
+			metricSamples, err = s.collect(ctx, rule.Metric)
+			if err != nil {
+				s.logger.Warn("Failed to collect alert metric",
+					logger.String("metric", rule.Metric),
+					logger.Error(err),
+				)
+				failedMetrics[rule.Metric] = true
+			} else {
+				samples[rule.Metric] = metricSamples
+			}
+		}
+		evaluatedRules[rule.ID] = rule
+		if failedMetrics[rule.Metric] {
+			continue
+		}
+
+		for _, sample := range metricSamples {
+			if !matchPattern(rule.ObjectFilter, sample.ObjectKey) {
+				continue
+			}
+			if !compareThreshold(rule.Condition, sample.Value, rule.Threshold) {
+				continue
+			}
+
+			key := alertKey(rule.ID, sample.ObjectKey)
+			holding[key] = true
+
+			if alert, ok := activeByKey[key]; ok {
+				if err := s.alertRepo.UpdateValue(ctx, alert.ID, sample.Value, now); err != nil {
+					return err
+				}
+				continue
+			}
+
+			since, ok := s.pending[key]
+			if !ok {
+				since = now
+				s.pending[key] = since
+			}
+			if now.Sub(since) < time.Duration(rule.DurationSeconds)*time.Second {
+				continue
+			}
+
+			if err := s.fire(ctx, rule, sample); err != nil {
+				return err
+			}
+			delete(s.pending, key)
+		}
+	}
+
+	// Resolve active alerts whose condition no longer holds
+	for key, alert := range activeByKey {
+		if holding[key] {
+			continue
+		}
+		if alert.RuleID != nil {
+			if rule, ok := evaluatedRules[*alert.RuleID]; ok && failedMetrics[rule.Metric] {
+				continue
+			}
+		}
+		if err := s.alertRepo.Resolve(ctx, alert.ID); err != nil {
+			return err
+		}
+		alert.Status = repository.AlertStatusResolved
+		alert.ResolvedAt = &now
+		s.notifier.NotifyAlert(NotificationEventResolved, alert)
+	}
+
+	// Conditions that stopped holding restart their "for" duration
+	for key := range s.pending {
+		if !holding[key] {
+			delete(s.pending, key)
+		}
+	}
+
+	return nil
+}
+
+func (s *AlertService) collect(ctx context.Context, metric string) ([]MetricSample, error) {
+	collector, ok := s.collectors[metric]
+	if !ok {
+		return nil, fmt.Errorf("unknown metric: %s", metric)
+	}
+	return collector(ctx)
+}
+
+func (s *AlertService) fire(ctx context.Context, rule *repository.AlertRule, sample MetricSample) error {
+	ruleID := rule.ID
+	alert := &repository.Alert{
+		RuleID:    &ruleID,
+		RuleName:  rule.Name,
+		Metric:    rule.Metric,
+		Severity:  rule.Severity,
+		Status:    repository.AlertStatusFiring,
+		Target:    s.target,
+		ObjectKey: sample.ObjectKey,
+		Value:     sample.Value,
+		Threshold: rule.Threshold,
+		Message: fmt.Sprintf("%s: %s %s on %s is %.2f (threshold %s %.2f)",
+			rule.Name, rule.Metric, sample.ObjectKey, s.target,
+			sample.Value, alertConditions[rule.Condition], rule.Threshold),
+	}
+
+	if err := s.alertRepo.Create(ctx, alert); err != nil {
+		return err
+	}
+
+	s.notifier.NotifyAlert(NotificationEventFiring, alert)
+	return nil
+}
+
+func alertKey(ruleID uuid.UUID, objectKey string) string {
+	return ruleID.String() + "|" + objectKey
+}
+
+// matchPattern matches a case-insensitive glob pattern; nil or empty matches everything
+func matchPattern(pattern *string, value string) bool {
+	if pattern == nil || *pattern == "" {
+		return true
+	}
+	matched, err := path.Match(strings.ToUpper(*pattern), strings.ToUpper(value))
+	return err == nil && matched
+}
+
+func compareThreshold(condition string, value, threshold float64) bool {
+	switch condition {
+	case "GT":
+		return value > threshold
+	case "GTE":
+		return value >= threshold
+	case "LT":
+		return value < threshold
+	case "LTE":
+		return value <= threshold
+	case "EQ":
+		return value == threshold
+	}
+	return false
+}
+
+// ============================================================================
+// METRIC COLLECTORS
+// ============================================================================
+
+func (s *AlertService) collectTablespaceUsage(ctx context.Context) ([]MetricSample, error) {
+	tablespaces, err := s.oracleService.fetchTablespaces(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	samples := make([]MetricSample, len(tablespaces))
+	for i, ts := range tablespaces {
+		samples[i] = MetricSample{ObjectKey: ts.Key(), Value: ts.UsagePercentage}
+	}
+	return samples, nil
+}
+
+// collectTablespaceMaxUsage measures usage against the autoextend limit, so a
+// nearly full tablespace that can still grow does not fire
+func (s *AlertService) collectTablespaceMaxUsage(ctx context.Context) ([]MetricSample, error) {
+	tablespaces, err := s.oracleService.fetchTablespaces(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	samples := make([]MetricSample, len(tablespaces))
+	for i, ts := range tablespaces {
+		samples[i] = MetricSample{ObjectKey: ts.Key(), Value: ts.MaxUsagePercentage}
+	}
+	return samples, nil
+}
+
+func (s *AlertService) collectASMDiskGroupUsage(ctx context.Context) ([]MetricSample, error) {
+	groups, err := s.oracleService.fetchASMDiskGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	samples := make([]MetricSample, len(groups))
+	for i, g := range groups {
+		samples[i] = MetricSample{ObjectKey: g.Name, Value: g.UsagePercentage}
+	}
+	return samples, nil
+}
+
+// collectFRANonReclaimable ignores reclaimable space, which Oracle frees on
+// demand; the area fills up only when non-reclaimable files reach the limit
+func (s *AlertService) collectFRANonReclaimable(ctx context.Context) ([]MetricSample, error) {
+	area, err := s.oracleService.fetchRecoveryArea(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if area == nil {
+		return nil, nil // no recovery area configured
+	}
+
+	return []MetricSample{{ObjectKey: area.Name, Value: area.NonReclaimablePercentage}}, nil
+}
+
+// collectFullBackupAge reports the age of the oldest datafile's last full
+// backup, so a rule like "GT 168" means no full backup of everything in 7 days
+func (s *AlertService) collectFullBackupAge(ctx context.Context) ([]MetricSample, error) {
+	coverage, err := s.oracleService.fetchBackupCoverage(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return []MetricSample{{ObjectKey: s.target, Value: coverage.FullBackupAgeHours}}, nil
+}
+
+func (s *AlertService) collectArchivelogBackupAge(ctx context.Context) ([]MetricSample, error) {
+	coverage, err := s.oracleService.fetchBackupCoverage(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if coverage.ArchivelogBackupAgeHours == nil {
+		return nil, nil // NOARCHIVELOG, nothing to back up
+	}
+	return []MetricSample{{ObjectKey: s.target, Value: *coverage.ArchivelogBackupAgeHours}}, nil
+}
+
+// dataGuardLagCollector reports one v$dataguard_stats lag in seconds; only a
+// standby has samples. Pair it with a rule duration to alert on sustained lag.
+func (s *AlertService) dataGuardLagCollector(name string) MetricCollector {
+	return func(ctx context.Context) ([]MetricSample, error) {
+		stats, err := s.oracleService.fetchDataGuardStats(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if stats[name] == nil {
+			return nil, nil
+		}
+		return []MetricSample{{ObjectKey: s.target, Value: *stats[name]}}, nil
+	}
+}
+
+func (s *AlertService) collectBlockedSeconds(ctx context.Context) ([]MetricSample, error) {
+	blockingSessions, err := s.oracleService.fetchBlockingSessions(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	samples := make([]MetricSample, len(blockingSessions))
+	for i, bs := range blockingSessions {
+		samples[i] = MetricSample{
+			ObjectKey: fmt.Sprintf("%d,%d", bs.BlockedSID, bs.BlockedSerial),
+			Value:     float64(bs.BlockedDurationSeconds),
+		}
+	}
+	return samples, nil
+}
+
+func (s *AlertService) collectActiveSessionCount(ctx context.Context) ([]MetricSample, error) {
+	count, err := s.oracleService.countActiveSessions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return []MetricSample{{ObjectKey: s.target, Value: float64(count)}}, nil
+}
+
+func (s *AlertService) collectInvalidObjectCount(ctx context.Context) ([]MetricSample, error) {
+	counts, err := s.oracleService.fetchInvalidObjectCounts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	samples := make([]MetricSample, 0, len(counts))
+	for schema, count := range counts {
+		samples = append(samples, MetricSample{ObjectKey: schema, Value: float64(count)})
+	}
+	return samples, nil
+}
+
+// collectSQLElapsedDelta reports, per SQL_ID, the elapsed seconds accumulated
+// per minute since the previous snapshot
+func (s *AlertService) collectSQLElapsedDelta(ctx context.Context) ([]MetricSample, error) {
+	snapshot, err := s.takeSQLSnapshot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	previous, current := snapshot.previous, snapshot.current
+
+	if len(previous) == 0 || len(current) == 0 {
+		return []MetricSample{}, nil
+	}
+
+	minutes := current[0].CapturedAt.Sub(previous[0].CapturedAt).Minutes()
+	if minutes <= 0 {
+		return []MetricSample{}, nil
+	}
+
+	prevBySQLID := make(map[string]*repository.QueryMetric, len(previous))
+	for _, m := range previous {
+		prevBySQLID[m.SQLID] = m
+	}
+
+	samples := []MetricSample{}
+	for _, m := range current {
+		prev, ok := prevBySQLID[m.SQLID]
+		if !ok {
+			continue
+		}
+		deltaMS := m.ElapsedTimeMS - prev.ElapsedTimeMS
+		if deltaMS < 0 {
+			// Cursor aged out and was reloaded; cumulative counters restarted
+			continue
+		}
+		samples = append(samples, MetricSample{
+			ObjectKey: m.SQLID,
+			Value:     deltaMS / 1000 / minutes,
+		})
+	}
+	return samples, nil
+}
+
+// collectSQLPlanRegression reports, per SQL_ID still running on a plan that
+// regressed, how much slower (in percent) an execution is than on the old plan
+func (s *AlertService) collectSQLPlanRegression(ctx context.Context) ([]MetricSample, error) {
+	snapshot, err := s.takeSQLSnapshot(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := s.planChangeRepo.ListLatest(ctx, s.target)
+	if err != nil {
+		return nil, err
+	}
+
+	currentPlans := make(map[string]int64, len(snapshot.current))
+	for _, m := range snapshot.current {
+		if m.PlanHashValue != nil {
+			currentPlans[m.SQLID] = *m.PlanHashValue
+		}
+	}
+
+	samples := []MetricSample{}
+	for _, change := range changes {
+		if !change.Regressed || change.OldAvgElapsedMS <= 0 {
+			continue
+		}
+		if plan, ok := currentPlans[change.SQLID]; !ok || plan != change.NewPlanHashValue {
+			continue
+		}
+		samples = append(samples, MetricSample{
+			ObjectKey: change.SQLID,
+			Value:     (change.NewAvgElapsedMS - change.OldAvgElapsedMS) / change.OldAvgElapsedMS * 100,
+		})
+	}
+	return samples, nil
+}
+
+// takeSQLSnapshot snapshots SQL statistics into history once per evaluation
+// cycle and records any plan changes since the previous snapshot
+func (s *AlertService) takeSQLSnapshot(ctx context.Context) (*sqlSnapshot, error) {
+	if s.sqlSnapshot != nil {
+		return s.sqlSnapshot, nil
+	}
+
+	previous, err := s.queryMetricsRepo.GetLatest(ctx, s.target)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := s.oracleService.fetchSQLSnapshot(ctx, s.sqlTopN)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range current {
+		m.Target = s.target
+	}
+	if err := s.queryMetricsRepo.Create(ctx, current); err != nil {
+		return nil, err
+	}
+
+	if err := s.detectPlanChanges(ctx, previous, current); err != nil {
+		s.logger.Warn("Failed to detect SQL plan changes", logger.Error(err))
+	}
+
+	s.sqlSnapshot = &sqlSnapshot{previous: previous, current: current}
+	return s.sqlSnapshot, nil
+}
+
+// detectPlanChanges records SQL_IDs whose current plan differs from the
+// previous snapshot, comparing the average elapsed time per execution of both
+// plans. The old plan falls back to the previous snapshot's averages once its
+// cursors have aged out of the shared pool.
+func (s *AlertService) detectPlanChanges(ctx context.Context, previous, current []*repository.QueryMetric) error {
+	prevBySQLID := make(map[string]*repository.QueryMetric, len(previous))
+	for _, m := range previous {
+		prevBySQLID[m.SQLID] = m
+	}
+
+	for _, m := range current {
+		prev, ok := prevBySQLID[m.SQLID]
+		if !ok || prev.PlanHashValue == nil || m.PlanHashValue == nil {
+			continue
+		}
+		oldPlan, newPlan := *prev.PlanHashValue, *m.PlanHashValue
+		// Statements without a plan (PL/SQL calls, DDL) report 0
+		if oldPlan == newPlan || oldPlan == 0 || newPlan == 0 {
+			continue
+		}
+
+		stats, err := s.oracleService.fetchSQLPlanStats(ctx, m.SQLID)
+		if err != nil {
+			return err
+		}
+
+		newStat, ok := stats[newPlan]
+		if !ok || newStat.Executions == 0 {
+			continue
+		}
+		newAvg := newStat.ElapsedMS / float64(newStat.Executions)
+
+		var oldAvg float64
+		if oldStat, ok := stats[oldPlan]; ok && oldStat.Executions > 0 {
+			oldAvg = oldStat.ElapsedMS / float64(oldStat.Executions)
+		} else if prev.Executions > 0 {
+			oldAvg = prev.ElapsedTimeMS / float64(prev.Executions)
+		} else {
+			continue
+		}
+
+		change := &repository.SQLPlanChange{
+			Target:           s.target,
+			SQLID:            m.SQLID,
+			OldPlanHashValue: oldPlan,
+			NewPlanHashValue: newPlan,
+			OldAvgElapsedMS:  oldAvg,
+			NewAvgElapsedMS:  newAvg,
+			Regressed:        newAvg > oldAvg,
+			DetectedAt:       m.CapturedAt,
+		}
+		if err := s.planChangeRepo.Create(ctx, change); err != nil {
+			return err
+		}
+
+		if change.Regressed {
+			s.logger.Warn("SQL plan change with worse average elapsed time",
+				logger.String("sql_id", m.SQLID),
+				logger.String("old_plan", strconv.FormatInt(oldPlan, 10)),
+				logger.String("new_plan", strconv.FormatInt(newPlan, 10)),
+			)
+		}
+	}
+
+	return nil
+}
+
+// ListSQLPlanChanges returns plan changes detected in a time range
+func (s *AlertService) ListSQLPlanChanges(ctx context.Context, start, end time.Time, regressedOnly bool) ([]*repository.SQLPlanChange, error) {
+	return s.planChangeRepo.List(ctx, s.target, start, end, regressedOnly)
+}
+
+// ============================================================================
+// RULE MANAGEMENT
+// ============================================================================
+
+// ListRules returns all alert rules
+func (s *AlertService) ListRules(ctx context.Context) ([]*repository.AlertRule, error) {
+	return s.ruleRepo.List(ctx)
+}
+
+// GetRule returns an alert rule by ID
+func (s *AlertService) GetRule(ctx context.Context, id uuid.UUID) (*repository.AlertRule, error) {
+	return s.ruleRepo.GetByID(ctx, id)
+}
+
+// CreateRule validates and stores a new alert rule
+func (s *AlertService) CreateRule(ctx context.Context, userID uuid.UUID, rule *repository.AlertRule) error {
+	if err := s.validateRule(rule); err != nil {
+		return err
+	}
+
+	rule.CreatedBy = &userID
+	if err := s.ruleRepo.Create(ctx, rule); err != nil {
+		return fmt.Errorf("failed to create alert rule: %w", err)
+	}
+
+	s.auditAlertAction(ctx, userID, "CREATE_ALERT_RULE", "ALERT_RULE", rule.ID)
+	return nil
+}
+
+// UpdateRule validates and updates an existing alert rule
+func (s *AlertService) UpdateRule(ctx context.Context, userID uuid.UUID, rule *repository.AlertRule) error {
+	if err := s.validateRule(rule); err != nil {
+		return err
+	}
+
+	if err := s.ruleRepo.Update(ctx, rule); err != nil {
+		return fmt.Errorf("failed to update alert rule: %w", err)
+	}
+
+	s.auditAlertAction(ctx, userID, "UPDATE_ALERT_RULE", "ALERT_RULE", rule.ID)
+	return nil
+}
+
+// DeleteRule deletes an alert rule; its alerts are kept for history
+func (s *AlertService) DeleteRule(ctx context.Context, userID, id uuid.UUID) error {
+	if err := s.ruleRepo.Delete(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete alert rule: %w", err)
+	}
+
+	s.auditAlertAction(ctx, userID, "DELETE_ALERT_RULE", "ALERT_RULE", id)
+	return nil
+}
+
+func (s *AlertService) validateRule(rule *repository.AlertRule) error {
+	if strings.TrimSpace(rule.Name) == "" {
+		return fmt.Errorf("rule name is required")
+	}
+	if _, ok := s.collectors[rule.Metric]; !ok {
+		return fmt.Errorf("unknown metric: %s", rule.Metric)
+	}
+	if _, ok := alertConditions[rule.Condition]; !ok {
+		return fmt.Errorf("unknown condition: %s", rule.Condition)
+	}
+	if !alertSeverities[rule.Severity] {
+		return fmt.Errorf("unknown severity: %s", rule.Severity)
+	}
+	if rule.DurationSeconds < 0 {
+		return fmt.Errorf("duration must not be negative")
+	}
+	for _, pattern := range []*string{rule.TargetFilter, rule.ObjectFilter} {
+		if pattern == nil {
+			continue
+		}
+		if _, err := path.Match(*pattern, ""); err != nil {
+			return fmt.Errorf("invalid filter pattern %q: %w", *pattern, err)
+		}
+	}
+	return nil
+}
+
+// ============================================================================
+// ALERT LIFECYCLE
+// ============================================================================
+
+// ListAlerts returns alerts matching the filter
+func (s *AlertService) ListAlerts(ctx context.Context, filter *repository.AlertFilter) ([]*repository.Alert, error) {
+	return s.alertRepo.List(ctx, filter)
+}
+
+// GetAlert returns an alert by ID
+func (s *AlertService) GetAlert(ctx context.Context, id uuid.UUID) (*repository.Alert, error) {
+	return s.alertRepo.GetByID(ctx, id)
+}
+
+// AcknowledgeAlert marks a firing alert as acknowledged by a user
+func (s *AlertService) AcknowledgeAlert(ctx context.Context, userID uuid.UUID, username string, id uuid.UUID) (*repository.Alert, error) {
+	if err := s.alertRepo.Acknowledge(ctx, id, username); err != nil {
+		return nil, err
+	}
+
+	s.auditAlertAction(ctx, userID, "ACKNOWLEDGE_ALERT", "ALERT", id)
+	return s.alertRepo.GetByID(ctx, id)
+}
+
+// ResolveAlert manually resolves a firing or acknowledged alert
+func (s *AlertService) ResolveAlert(ctx context.Context, userID uuid.UUID, id uuid.UUID) (*repository.Alert, error) {
+	if err := s.alertRepo.Resolve(ctx, id); err != nil {
+		return nil, err
+	}
+
+	s.auditAlertAction(ctx, userID, "RESOLVE_ALERT", "ALERT", id)
+
+	alert, err := s.alertRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	s.notifier.NotifyAlert(NotificationEventResolved, alert)
+	return alert, nil
+}
+
+// ============================================================================
+// AUDIT HELPERS
+// ============================================================================
+
+func (s *AlertService) auditAlertAction(ctx context.Context, userID uuid.UUID, action, resourceType string, id uuid.UUID) {
+	resourceID := id.String()
+	log := &repository.AuditLog{
+		UserID:       &userID,
+		Username:     userID.String(),
+		Action:       action,
+		ResourceType: resourceType,
+		ResourceID:   &resourceID,
+		Status:       "SUCCESS",
+	}
+	_ = s.auditRepo.Create(ctx, log)
+}
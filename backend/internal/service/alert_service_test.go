@@ -0,0 +1,292 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aashiq-04/oracle-dba/internal/repository"
+	"github.com/aashiq-04/oracle-dba/pkg/logger"
+)
+
+func TestMatchPattern(t *testing.T) {
+	pattern := func(p string) *string { return &p }
+
+	tests := []struct {
+		name    string
+		pattern *string
+		value   string
+		want    bool
+	}{
+		{"nil matches everything", nil, "USERS", true},
+		{"empty matches everything", pattern(""), "USERS", true},
+		{"exact", pattern("USERS"), "USERS", true},
+		{"case insensitive", pattern("users"), "UsErS", true},
+		{"star suffix", pattern("PROD*"), "PROD_DB1", true},
+		{"star matches empty", pattern("PROD*"), "PROD", true},
+		{"star prefix", pattern("*_DATA"), "APP_DATA", true},
+		{"question mark", pattern("TEMP?"), "TEMP2", true},
+		{"question mark needs one char", pattern("TEMP?"), "TEMP", false},
+		{"no match", pattern("PROD*"), "DEV_DB1", false},
+		{"anchored", pattern("USERS"), "USERS2", false},
+		{"character class", pattern("DB[12]"), "db2", true},
+		{"malformed pattern", pattern("DB["), "DB[", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := matchPattern(tt.pattern, tt.value); got != tt.want {
+				t.Errorf("matchPattern(%v, %q) = %v, want %v", tt.pattern, tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompareThreshold(t *testing.T) {
+	tests := []struct {
+		condition string
+		value     float64
+		threshold float64
+		want      bool
+	}{
+		{"GT", 91, 90, true},
+		{"GT", 90, 90, false},
+		{"GT", 89, 90, false},
+		{"GTE", 90, 90, true},
+		{"GTE", 89.99, 90, false},
+		{"LT", 9, 10, true},
+		{"LT", 10, 10, false},
+		{"LTE", 10, 10, true},
+		{"LTE", 10.01, 10, false},
+		{"EQ", 0, 0, true},
+		{"EQ", 1, 0, false},
+		{"GT", -1, -2, true},
+		{"UNKNOWN", 100, 0, false},
+	}
+
+	for _, tt := range tests {
+		if got := compareThreshold(tt.condition, tt.value, tt.threshold); got != tt.want {
+			t.Errorf("compareThreshold(%s, %v, %v) = %v, want %v",
+				tt.condition, tt.value, tt.threshold, got, tt.want)
+		}
+	}
+}
+
+// fakeAlertRuleRepo serves a fixed list of rules
+type fakeAlertRuleRepo struct {
+	rules []*repository.AlertRule
+}
+
+func (r *fakeAlertRuleRepo) Create(ctx context.Context, rule *repository.AlertRule) error {
+	return nil
+}
+
+func (r *fakeAlertRuleRepo) GetByID(ctx context.Context, id uuid.UUID) (*repository.AlertRule, error) {
+	return nil, nil
+}
+
+func (r *fakeAlertRuleRepo) List(ctx context.Context) ([]*repository.AlertRule, error) {
+	return r.rules, nil
+}
+
+func (r *fakeAlertRuleRepo) ListEnabled(ctx context.Context) ([]*repository.AlertRule, error) {
+	return r.rules, nil
+}
+
+func (r *fakeAlertRuleRepo) Update(ctx context.Context, rule *repository.AlertRule) error {
+	return nil
+}
+
+func (r *fakeAlertRuleRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	return nil
+}
+
+// fakeAlertRepo keeps alerts in memory
+type fakeAlertRepo struct {
+	alerts []*repository.Alert
+}
+
+func (r *fakeAlertRepo) Create(ctx context.Context, alert *repository.Alert) error {
+	alert.ID = uuid.New()
+	alert.FiredAt = time.Now()
+	r.alerts = append(r.alerts, alert)
+	return nil
+}
+
+func (r *fakeAlertRepo) GetByID(ctx context.Context, id uuid.UUID) (*repository.Alert, error) {
+	for _, alert := range r.alerts {
+		if alert.ID == id {
+			return alert, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *fakeAlertRepo) List(ctx context.Context, filter *repository.AlertFilter) ([]*repository.Alert, error) {
+	return r.alerts, nil
+}
+
+func (r *fakeAlertRepo) ListActive(ctx context.Context, target string) ([]*repository.Alert, error) {
+	var active []*repository.Alert
+	for _, alert := range r.alerts {
+		if alert.Target == target && alert.Status != repository.AlertStatusResolved {
+			copied := *alert
+			active = append(active, &copied)
+		}
+	}
+	return active, nil
+}
+
+func (r *fakeAlertRepo) UpdateValue(ctx context.Context, id uuid.UUID, value float64, evaluatedAt time.Time) error {
+	for _, alert := range r.alerts {
+		if alert.ID == id {
+			alert.Value = value
+			alert.LastEvaluatedAt = evaluatedAt
+		}
+	}
+	return nil
+}
+
+func (r *fakeAlertRepo) Acknowledge(ctx context.Context, id uuid.UUID, acknowledgedBy string) error {
+	return nil
+}
+
+func (r *fakeAlertRepo) Resolve(ctx context.Context, id uuid.UUID) error {
+	for _, alert := range r.alerts {
+		if alert.ID == id {
+			alert.Status = repository.AlertStatusResolved
+		}
+	}
+	return nil
+}
+
+// recordingNotifier records the events it is told about
+type recordingNotifier struct {
+	events []string
+}
+
+func (n *recordingNotifier) NotifyAlert(event string, alert *repository.Alert) {
+	n.events = append(n.events, event+" "+alert.ObjectKey)
+}
+
+const testMetric = "TEST_METRIC"
+
+func newTestAlertService(rules []*repository.AlertRule, samples *[]MetricSample) (*AlertService, *fakeAlertRepo, *recordingNotifier) {
+	alertRepo := &fakeAlertRepo{}
+	notifier := &recordingNotifier{}
+	s := NewAlertService(nil, &fakeAlertRuleRepo{rules: rules}, alertRepo, nil, nil, nil,
+		notifier, logger.NewLogger(), "PRODDB", time.Minute, 10)
+	s.RegisterCollector(testMetric, func(ctx context.Context) ([]MetricSample, error) {
+		return *samples, nil
+	})
+	return s, alertRepo, notifier
+}
+
+func testRule(durationSeconds int) *repository.AlertRule {
+	return &repository.AlertRule{
+		ID:              uuid.New(),
+		Name:            "High usage",
+		Metric:          testMetric,
+		Condition:       "GTE",
+		Threshold:       90,
+		DurationSeconds: durationSeconds,
+		Severity:        "WARNING",
+		Enabled:         true,
+	}
+}
+
+func TestEvaluateFiresAndResolves(t *testing.T) {
+	ctx := context.Background()
+	samples := []MetricSample{{ObjectKey: "USERS", Value: 95}, {ObjectKey: "TOOLS", Value: 10}}
+	s, alertRepo, notifier := newTestAlertService([]*repository.AlertRule{testRule(0)}, &samples)
+
+	if err := s.evaluate(ctx); err != nil {
+		t.Fatalf("evaluate() error = %v", err)
+	}
+	if len(alertRepo.alerts) != 1 || alertRepo.alerts[0].ObjectKey != "USERS" {
+		t.Fatalf("alerts = %+v, want one FIRING alert for USERS", alertRepo.alerts)
+	}
+
+	// A condition that still holds updates the alert instead of firing again
+	samples[0].Value = 97
+	if err := s.evaluate(ctx); err != nil {
+		t.Fatalf("evaluate() error = %v", err)
+	}
+	if len(alertRepo.alerts) != 1 || alertRepo.alerts[0].Value != 97 {
+		t.Fatalf("alerts = %+v, want the USERS alert updated to 97", alertRepo.alerts)
+	}
+
+	samples[0].Value = 50
+	if err := s.evaluate(ctx); err != nil {
+		t.Fatalf("evaluate() error = %v", err)
+	}
+	if alertRepo.alerts[0].Status != repository.AlertStatusResolved {
+		t.Errorf("status = %s, want RESOLVED", alertRepo.alerts[0].Status)
+	}
+
+	want := []string{NotificationEventFiring + " USERS", NotificationEventResolved + " USERS"}
+	if len(notifier.events) != len(want) {
+		t.Fatalf("events = %v, want %v", notifier.events, want)
+	}
+	for i := range want {
+		if notifier.events[i] != want[i] {
+			t.Errorf("events[%d] = %s, want %s", i, notifier.events[i], want[i])
+		}
+	}
+}
+
+func TestEvaluateWaitsForDuration(t *testing.T) {
+	ctx := context.Background()
+	rule := testRule(300)
+	samples := []MetricSample{{ObjectKey: "USERS", Value: 90}}
+	s, alertRepo, _ := newTestAlertService([]*repository.AlertRule{rule}, &samples)
+
+	if err := s.evaluate(ctx); err != nil {
+		t.Fatalf("evaluate() error = %v", err)
+	}
+	if len(alertRepo.alerts) != 0 {
+		t.Fatalf("alert fired before its duration elapsed")
+	}
+
+	// A condition that stops holding restarts its duration
+	key := alertKey(rule.ID, "USERS")
+	samples[0].Value = 80
+	if err := s.evaluate(ctx); err != nil {
+		t.Fatalf("evaluate() error = %v", err)
+	}
+	if _, ok := s.pending[key]; ok {
+		t.Fatalf("pending condition kept after it stopped holding")
+	}
+
+	samples[0].Value = 90
+	if err := s.evaluate(ctx); err != nil {
+		t.Fatalf("evaluate() error = %v", err)
+	}
+	s.pending[key] = time.Now().Add(-301 * time.Second)
+	if err := s.evaluate(ctx); err != nil {
+		t.Fatalf("evaluate() error = %v", err)
+	}
+	if len(alertRepo.alerts) != 1 {
+		t.Errorf("alerts = %d, want 1 after the duration elapsed", len(alertRepo.alerts))
+	}
+}
+
+func TestEvaluateResolvesAlertsOfDeletedRules(t *testing.T) {
+	ctx := context.Background()
+	samples := []MetricSample{}
+	s, alertRepo, _ := newTestAlertService(nil, &samples)
+
+	orphan := &repository.Alert{Target: "PRODDB", ObjectKey: "USERS", Status: repository.AlertStatusFiring}
+	if err := alertRepo.Create(ctx, orphan); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := s.evaluate(ctx); err != nil {
+		t.Fatalf("evaluate() error = %v", err)
+	}
+	if orphan.Status != repository.AlertStatusResolved {
+		t.Errorf("status = %s, want RESOLVED", orphan.Status)
+	}
+}
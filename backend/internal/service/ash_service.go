@@ -0,0 +1,409 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/aashiq-04/oracle-dba/internal/repository"
+	"github.com/aashiq-04/oracle-dba/pkg/logger"
+)
+
+// ASHBreakdown is the DB time attributed to one value of a dimension
+// (a wait class, an event, a SQL_ID or a session) over a time window
+type ASHBreakdown struct {
+	Key               string
+	Label             *string
+	Samples           int
+	DBTimeSeconds     float64
+	AvgActiveSessions float64
+	Percentage        float64
+}
+
+// ashTick is every active session seen by one sampling pass; empty ticks are
+// kept so the buffer knows which period it covers
+type ashTick struct {
+	time    time.Time
+	samples []*repository.ASHSample
+}
+
+// ashRing is a fixed-size ring buffer of the most recent sampling ticks
+type ashRing struct {
+	mu    sync.RWMutex
+	ticks []ashTick
+	next  int
+	full  bool
+}
+
+func newASHRing(capacity int) *ashRing {
+	return &ashRing{ticks: make([]ashTick, capacity)}
+}
+
+func (r *ashRing) add(tick ashTick) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.ticks[r.next] = tick
+	r.next = (r.next + 1) % len(r.ticks)
+	if r.next == 0 {
+		r.full = true
+	}
+}
+
+// window returns the samples taken in [start, end) and whether the buffer
+// covers the whole window
+func (r *ashRing) window(start, end time.Time) ([]*repository.ASHSample, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	oldest := 0
+	count := r.next
+	if r.full {
+		oldest = r.next
+		count = len(r.ticks)
+	}
+	if count == 0 || r.ticks[oldest].time.After(start) {
+		return nil, false
+	}
+
+	samples := []*repository.ASHSample{}
+	for i := 0; i < count; i++ {
+		tick := r.ticks[(oldest+i)%len(r.ticks)]
+		if tick.time.Before(start) || !tick.time.Before(end) {
+			continue
+		}
+		samples = append(samples, tick.samples...)
+	}
+	return samples, true
+}
+
+// ASHService samples active sessions at a high frequency, keeping recent
+// samples in memory and persisting all of them for historical analysis.
+// It provides Active Session History style breakdowns without requiring the
+// Diagnostics Pack, so it also works on Standard Edition.
+type ASHService struct {
+	oracleService *OracleService
+	sampleRepo    repository.ASHSampleRepository
+	logger        logger.Logger
+	target        string
+	interval      time.Duration
+	flushInterval time.Duration
+	retention     time.Duration
+
+	ring       *ashRing
+	maxPending int
+
+	mu      sync.Mutex
+	pending []*repository.ASHSample
+	dropped int
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewASHService creates a new ASH sampler for one Oracle target
+func NewASHService(
+	oracleService *OracleService,
+	sampleRepo repository.ASHSampleRepository,
+	log logger.Logger,
+	target string,
+	interval time.Duration,
+	bufferDuration time.Duration,
+	flushInterval time.Duration,
+	retention time.Duration,
+) *ASHService {
+	capacity := int(bufferDuration / interval)
+	if capacity < 1 {
+		capacity = 1
+	}
+
+	return &ASHService{
+		oracleService: oracleService,
+		sampleRepo:    sampleRepo,
+		logger:        log,
+		target:        target,
+		interval:      interval,
+		flushInterval: flushInterval,
+		retention:     retention,
+		ring:          newASHRing(capacity),
+		// Bound memory while PostgreSQL is unavailable (~100 active sessions per tick)
+		maxPending: capacity * 100,
+	}
+}
+
+// Start begins sampling and background persistence
+func (s *ASHService) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+
+	s.wg.Add(2)
+	go s.sampleLoop(ctx)
+	go s.persistLoop(ctx)
+}
+
+// Stop halts sampling and writes any samples not yet persisted
+func (s *ASHService) Stop() {
+	if s.cancel == nil {
+		return
+	}
+	s.cancel()
+	s.wg.Wait()
+}
+
+func (s *ASHService) sampleLoop(ctx context.Context) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.sample(ctx)
+		}
+	}
+}
+
+func (s *ASHService) sample(ctx context.Context) {
+	// A pass must not overrun the next tick
+	sampleCtx, cancel := context.WithTimeout(ctx, s.interval)
+	defer cancel()
+
+	samples, err := s.oracleService.fetchASHSample(sampleCtx)
+	if err != nil {
+		if ctx.Err() == nil {
+			s.logger.Warn("ASH sample failed", logger.Error(err))
+		}
+		return
+	}
+
+	tickTime := time.Now()
+	for _, sample := range samples {
+		sample.Target = s.target
+		tickTime = sample.SampleTime
+	}
+	s.ring.add(ashTick{time: tickTime, samples: samples})
+
+	s.mu.Lock()
+	s.pending = append(s.pending, samples...)
+	if overflow := len(s.pending) - s.maxPending; overflow > 0 {
+		s.pending = s.pending[overflow:]
+		s.dropped += overflow
+	}
+	s.mu.Unlock()
+}
+
+func (s *ASHService) persistLoop(ctx context.Context) {
+	defer s.wg.Done()
+
+	flushTicker := time.NewTicker(s.flushInterval)
+	defer flushTicker.Stop()
+
+	pruneTicker := time.NewTicker(time.Hour)
+	defer pruneTicker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			s.flush()
+			return
+		case <-flushTicker.C:
+			s.flush()
+		case <-pruneTicker.C:
+			s.prune()
+		}
+	}
+}
+
+func (s *ASHService) flush() {
+	s.mu.Lock()
+	batch := s.pending
+	dropped := s.dropped
+	s.pending = nil
+	s.dropped = 0
+	s.mu.Unlock()
+
+	if dropped > 0 {
+		s.logger.Warn("ASH samples dropped while persistence was failing",
+			logger.String("count", strconv.Itoa(dropped)),
+		)
+	}
+	if len(batch) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if err := s.sampleRepo.CreateBatch(ctx, batch); err != nil {
+		s.logger.Error("Failed to persist ASH samples", logger.Error(err))
+
+		// Keep the batch for the next flush, ahead of newer samples
+		s.mu.Lock()
+		s.pending = append(batch, s.pending...)
+		if overflow := len(s.pending) - s.maxPending; overflow > 0 {
+			s.pending = s.pending[overflow:]
+			s.dropped += overflow
+		}
+		s.mu.Unlock()
+	}
+}
+
+func (s *ASHService) prune() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	if _, err := s.sampleRepo.DeleteBefore(ctx, time.Now().Add(-s.retention)); err != nil {
+		s.logger.Error("Failed to prune ASH samples", logger.Error(err))
+	}
+}
+
+// ============================================================================
+// BREAKDOWNS
+// ============================================================================
+
+// DBTimeByWaitClass breaks down DB time over the last N minutes by wait class
+// (CPU counts as its own class)
+func (s *ASHService) DBTimeByWaitClass(ctx context.Context, minutes int) ([]*ASHBreakdown, error) {
+	if minutes <= 0 {
+		return nil, fmt.Errorf("minutes must be positive")
+	}
+	end := time.Now()
+	start := end.Add(-time.Duration(minutes) * time.Minute)
+	return s.Breakdown(ctx, repository.ASHDimensionWaitClass, start, end, 0)
+}
+
+// TopEvents returns the wait events (and CPU) with the most DB time in a window
+func (s *ASHService) TopEvents(ctx context.Context, start, end time.Time, limit int) ([]*ASHBreakdown, error) {
+	return s.Breakdown(ctx, repository.ASHDimensionEvent, start, end, limit)
+}
+
+// TopSQL returns the SQL_IDs with the most DB time in a window
+func (s *ASHService) TopSQL(ctx context.Context, start, end time.Time, limit int) ([]*ASHBreakdown, error) {
+	return s.Breakdown(ctx, repository.ASHDimensionSQLID, start, end, limit)
+}
+
+// TopSessions returns the sessions (sid,serial#) with the most DB time in a window
+func (s *ASHService) TopSessions(ctx context.Context, start, end time.Time, limit int) ([]*ASHBreakdown, error) {
+	return s.Breakdown(ctx, repository.ASHDimensionSession, start, end, limit)
+}
+
+// Breakdown attributes DB time in [start, end) to the values of a dimension.
+// Windows covered by the in-memory buffer are answered without PostgreSQL.
+// A limit of 0 returns every value.
+func (s *ASHService) Breakdown(ctx context.Context, dimension string, start, end time.Time, limit int) ([]*ASHBreakdown, error) {
+	if !end.After(start) {
+		return nil, fmt.Errorf("end must be after start")
+	}
+
+	aggregates, err := s.aggregate(ctx, dimension, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	total := 0
+	for _, agg := range aggregates {
+		total += agg.Samples
+	}
+
+	windowSeconds := end.Sub(start).Seconds()
+	sampleSeconds := s.interval.Seconds()
+
+	if limit > 0 && len(aggregates) > limit {
+		aggregates = aggregates[:limit]
+	}
+
+	breakdown := make([]*ASHBreakdown, len(aggregates))
+	for i, agg := range aggregates {
+		dbTime := float64(agg.Samples) * sampleSeconds
+		breakdown[i] = &ASHBreakdown{
+			Key:               agg.Key,
+			Label:             agg.Label,
+			Samples:           agg.Samples,
+			DBTimeSeconds:     dbTime,
+			AvgActiveSessions: dbTime / windowSeconds,
+			Percentage:        float64(agg.Samples) / float64(total) * 100,
+		}
+	}
+
+	return breakdown, nil
+}
+
+func (s *ASHService) aggregate(ctx context.Context, dimension string, start, end time.Time) ([]*repository.ASHAggregate, error) {
+	samples, covered := s.ring.window(start, end)
+	if !covered {
+		return s.sampleRepo.Aggregate(ctx, s.target, dimension, start, end)
+	}
+
+	keyOf, labelOf, err := ashDimensionFuncs(dimension)
+	if err != nil {
+		return nil, err
+	}
+
+	byKey := map[string]*repository.ASHAggregate{}
+	for _, sample := range samples {
+		key, ok := keyOf(sample)
+		if !ok {
+			continue
+		}
+		agg, exists := byKey[key]
+		if !exists {
+			agg = &repository.ASHAggregate{Key: key}
+			byKey[key] = agg
+		}
+		agg.Samples++
+		if label := labelOf(sample); label != nil {
+			agg.Label = label
+		}
+	}
+
+	aggregates := make([]*repository.ASHAggregate, 0, len(byKey))
+	for _, agg := range byKey {
+		aggregates = append(aggregates, agg)
+	}
+	sort.Slice(aggregates, func(i, j int) bool {
+		if aggregates[i].Samples != aggregates[j].Samples {
+			return aggregates[i].Samples > aggregates[j].Samples
+		}
+		return aggregates[i].Key < aggregates[j].Key
+	})
+
+	return aggregates, nil
+}
+
+// ashDimensionFuncs mirrors the repository's SQL grouping for in-memory samples
+func ashDimensionFuncs(dimension string) (func(*repository.ASHSample) (string, bool), func(*repository.ASHSample) *string, error) {
+	noLabel := func(*repository.ASHSample) *string { return nil }
+	username := func(sample *repository.ASHSample) *string { return sample.Username }
+
+	switch dimension {
+	case repository.ASHDimensionWaitClass:
+		return func(sample *repository.ASHSample) (string, bool) {
+			return sample.WaitClass, true
+		}, noLabel, nil
+	case repository.ASHDimensionEvent:
+		return func(sample *repository.ASHSample) (string, bool) {
+				return sample.Event, true
+			}, func(sample *repository.ASHSample) *string {
+				waitClass := sample.WaitClass
+				return &waitClass
+			}, nil
+	case repository.ASHDimensionSQLID:
+		return func(sample *repository.ASHSample) (string, bool) {
+			if sample.SQLID == nil {
+				return "", false
+			}
+			return *sample.SQLID, true
+		}, username, nil
+	case repository.ASHDimensionSession:
+		return func(sample *repository.ASHSample) (string, bool) {
+			return fmt.Sprintf("%d,%d,@%d", sample.SID, sample.Serial, sample.InstID), true
+		}, username, nil
+	}
+	return nil, nil, fmt.Errorf("unknown ASH dimension: %s", dimension)
+}
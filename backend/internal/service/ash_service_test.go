@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aashiq-04/oracle-dba/internal/repository"
+	"github.com/aashiq-04/oracle-dba/pkg/logger"
+)
+
+var ashBase = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+// ashTickAt is a tick at second offset with one sample per SID
+func ashTickAt(offset int, sids ...int) ashTick {
+	at := ashBase.Add(time.Duration(offset) * time.Second)
+	samples := make([]*repository.ASHSample, len(sids))
+	for i, sid := range sids {
+		samples[i] = &repository.ASHSample{SampleTime: at, SID: sid, WaitClass: "CPU", Event: "ON CPU"}
+	}
+	return ashTick{time: at, samples: samples}
+}
+
+func sampleSIDs(samples []*repository.ASHSample) []int {
+	sids := make([]int, len(samples))
+	for i, sample := range samples {
+		sids[i] = sample.SID
+	}
+	return sids
+}
+
+func TestASHRingWindow(t *testing.T) {
+	at := func(offset int) time.Time { return ashBase.Add(time.Duration(offset) * time.Second) }
+
+	tests := []struct {
+		name        string
+		capacity    int
+		ticks       []ashTick
+		start, end  time.Time
+		wantCovered bool
+		wantSIDs    []int
+	}{
+		{"empty ring", 4, nil, at(0), at(10), false, nil},
+		{"partially filled", 4, []ashTick{ashTickAt(0, 1), ashTickAt(1, 2)}, at(0), at(10), true, []int{1, 2}},
+		{"start before oldest tick", 4, []ashTick{ashTickAt(5, 1)}, at(0), at(10), false, nil},
+		{"end is exclusive", 4, []ashTick{ashTickAt(0, 1), ashTickAt(1, 2), ashTickAt(2, 3)}, at(0), at(2), true, []int{1, 2}},
+		{"start is inclusive", 4, []ashTick{ashTickAt(0, 1), ashTickAt(1, 2), ashTickAt(2, 3)}, at(1), at(10), true, []int{2, 3}},
+		{"exactly full", 3, []ashTick{ashTickAt(0, 1), ashTickAt(1, 2), ashTickAt(2, 3)}, at(0), at(10), true, []int{1, 2, 3}},
+		{
+			"wrapped keeps newest in order", 3,
+			[]ashTick{ashTickAt(0, 1), ashTickAt(1, 2), ashTickAt(2, 3), ashTickAt(3, 4), ashTickAt(4, 5)},
+			at(2), at(10), true, []int{3, 4, 5},
+		},
+		{
+			"wrapped window older than buffer", 3,
+			[]ashTick{ashTickAt(0, 1), ashTickAt(1, 2), ashTickAt(2, 3), ashTickAt(3, 4)},
+			at(0), at(10), false, nil,
+		},
+		{
+			"wrapped twice", 2,
+			[]ashTick{ashTickAt(0, 1), ashTickAt(1, 2), ashTickAt(2, 3), ashTickAt(3, 4), ashTickAt(4, 5)},
+			at(3), at(10), true, []int{4, 5},
+		},
+		{"capacity one", 1, []ashTick{ashTickAt(0, 1), ashTickAt(1, 2)}, at(1), at(2), true, []int{2}},
+		{"empty ticks cover their period", 3, []ashTick{ashTickAt(0), ashTickAt(1), ashTickAt(2, 7)}, at(0), at(10), true, []int{7}},
+		{"multiple sessions per tick", 3, []ashTick{ashTickAt(0, 1, 2), ashTickAt(1, 3)}, at(0), at(10), true, []int{1, 2, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ring := newASHRing(tt.capacity)
+			for _, tick := range tt.ticks {
+				ring.add(tick)
+			}
+
+			samples, covered := ring.window(tt.start, tt.end)
+			if covered != tt.wantCovered {
+				t.Fatalf("covered = %v, want %v", covered, tt.wantCovered)
+			}
+			if !covered {
+				return
+			}
+
+			got := sampleSIDs(samples)
+			if len(got) != len(tt.wantSIDs) {
+				t.Fatalf("SIDs = %v, want %v", got, tt.wantSIDs)
+			}
+			for i := range got {
+				if got[i] != tt.wantSIDs[i] {
+					t.Errorf("SIDs = %v, want %v", got, tt.wantSIDs)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestASHBreakdownFromRing(t *testing.T) {
+	s := NewASHService(nil, nil, logger.NewLogger(), "PRODDB", time.Second, time.Minute, time.Minute, time.Hour)
+
+	sqlA, sqlB := "a1b2c3d4e5f6g", "z9y8x7w6v5u4t"
+	for i := 0; i < 10; i++ {
+		at := ashBase.Add(time.Duration(i) * time.Second)
+		samples := []*repository.ASHSample{
+			{SampleTime: at, SID: 10, Serial: 1, SQLID: &sqlA, WaitClass: "CPU", Event: "ON CPU"},
+		}
+		if i < 5 {
+			samples = append(samples, &repository.ASHSample{SampleTime: at, SID: 20, Serial: 3, SQLID: &sqlB, WaitClass: "User I/O", Event: "db file sequential read"})
+		}
+		if i < 2 {
+			samples = append(samples, &repository.ASHSample{SampleTime: at, SID: 30, Serial: 9, WaitClass: "Concurrency", Event: "latch free"})
+		}
+		s.ring.add(ashTick{time: at, samples: samples})
+	}
+
+	start, end := ashBase, ashBase.Add(10*time.Second)
+	breakdown, err := s.Breakdown(context.Background(), repository.ASHDimensionWaitClass, start, end, 0)
+	if err != nil {
+		t.Fatalf("Breakdown() error = %v", err)
+	}
+
+	want := []struct {
+		key     string
+		samples int
+	}{{"CPU", 10}, {"User I/O", 5}, {"Concurrency", 2}}
+	if len(breakdown) != len(want) {
+		t.Fatalf("breakdown has %d rows, want %d", len(breakdown), len(want))
+	}
+	for i, w := range want {
+		b := breakdown[i]
+		if b.Key != w.key || b.Samples != w.samples {
+			t.Errorf("row %d = %s/%d, want %s/%d", i, b.Key, b.Samples, w.key, w.samples)
+		}
+	}
+	if got := breakdown[0].AvgActiveSessions; got != 1 {
+		t.Errorf("CPU average active sessions = %v, want 1", got)
+	}
+	if got := breakdown[0].Percentage; got < 58.8 || got > 58.9 {
+		t.Errorf("CPU percentage = %v, want ~58.82", got)
+	}
+
+	// Samples without a SQL_ID are left out of the SQL breakdown
+	topSQL, err := s.TopSQL(context.Background(), start, end, 1)
+	if err != nil {
+		t.Fatalf("TopSQL() error = %v", err)
+	}
+	if len(topSQL) != 1 || topSQL[0].Key != sqlA || topSQL[0].Percentage < 66.6 || topSQL[0].Percentage > 66.7 {
+		t.Errorf("TopSQL = %+v, want %s at ~66.67%%", topSQL[0], sqlA)
+	}
+
+	if _, err := s.Breakdown(context.Background(), repository.ASHDimensionWaitClass, end, start, 0); err == nil {
+		t.Error("Breakdown() with end before start succeeded")
+	}
+}
@@ -0,0 +1,395 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aashiq-04/oracle-dba/internal/repository"
+	"github.com/aashiq-04/oracle-dba/pkg/logger"
+)
+
+// historyJob captures one kind of capacity snapshot and prunes old ones
+type historyJob struct {
+	name     string
+	snapshot func(ctx context.Context, capturedAt time.Time) error
+	prune    func(ctx context.Context, before time.Time) error
+}
+
+// Segment snapshots cover the largest segments and are taken at most once per
+// interval, as cdb_segments is slow to query on large databases
+const (
+	SegmentSnapshotLimit    = 500
+	SegmentSnapshotInterval = time.Hour
+)
+
+// HistoryService periodically snapshots slowly changing figures (capacity,
+// standby lag, parameters, segment sizes) into PostgreSQL so they can be trended
+type HistoryService struct {
+	oracleService    *OracleService
+	recoveryAreaRepo repository.RecoveryAreaMetricsRepository
+	dataGuardLagRepo repository.DataGuardLagRepository
+	parameterRepo    repository.ParameterSnapshotRepository
+	segmentRepo      repository.SegmentSnapshotRepository
+	logger           logger.Logger
+	target           string
+	interval         time.Duration
+	retention        time.Duration
+
+	jobs []historyJob
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewHistoryService creates a new capacity history collector
+func NewHistoryService(
+	oracleService *OracleService,
+	recoveryAreaRepo repository.RecoveryAreaMetricsRepository,
+	dataGuardLagRepo repository.DataGuardLagRepository,
+	parameterRepo repository.ParameterSnapshotRepository,
+	segmentRepo repository.SegmentSnapshotRepository,
+	log logger.Logger,
+	target string,
+	interval time.Duration,
+	retention time.Duration,
+) *HistoryService {
+	s := &HistoryService{
+		oracleService:    oracleService,
+		recoveryAreaRepo: recoveryAreaRepo,
+		dataGuardLagRepo: dataGuardLagRepo,
+		parameterRepo:    parameterRepo,
+		segmentRepo:      segmentRepo,
+		logger:           log,
+		target:           target,
+		interval:         interval,
+		retention:        retention,
+	}
+
+	s.registerJob("recovery area", s.snapshotRecoveryArea, recoveryAreaRepo.DeleteBefore)
+	s.registerJob("Data Guard lag", s.snapshotDataGuardLag, dataGuardLagRepo.DeleteBefore)
+	s.registerJob("parameter", s.snapshotParameters, parameterRepo.DeleteBefore)
+	s.registerJob("segment", s.snapshotSegments, segmentRepo.DeleteBefore)
+
+	return s
+}
+
+func (s *HistoryService) registerJob(name string, snapshot func(context.Context, time.Time) error, prune func(context.Context, time.Time) error) {
+	s.jobs = append(s.jobs, historyJob{name: name, snapshot: snapshot, prune: prune})
+}
+
+// Start begins periodic snapshots in the background
+func (s *HistoryService) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+	s.done = make(chan struct{})
+
+	go func() {
+		defer close(s.done)
+
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		pruneTicker := time.NewTicker(time.Hour)
+		defer pruneTicker.Stop()
+
+		s.snapshot(ctx)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				s.snapshot(ctx)
+			case <-pruneTicker.C:
+				s.prune(ctx)
+			}
+		}
+	}()
+}
+
+// Stop halts collection and waits for the current cycle to finish
+func (s *HistoryService) Stop() {
+	if s.cancel == nil {
+		return
+	}
+	s.cancel()
+	<-s.done
+}
+
+// snapshot runs every job; one failing does not stop the others
+func (s *HistoryService) snapshot(ctx context.Context) {
+	capturedAt := time.Now()
+	for _, job := range s.jobs {
+		if err := job.snapshot(ctx, capturedAt); err != nil && ctx.Err() == nil {
+			s.logger.Error(fmt.Sprintf("Failed to snapshot %s history", job.name), logger.Error(err))
+		}
+	}
+}
+
+func (s *HistoryService) prune(ctx context.Context) {
+	before := time.Now().Add(-s.retention)
+	for _, job := range s.jobs {
+		if err := job.prune(ctx, before); err != nil && ctx.Err() == nil {
+			s.logger.Error(fmt.Sprintf("Failed to prune %s history", job.name), logger.Error(err))
+		}
+	}
+}
+
+// ============================================================================
+// RECOVERY AREA
+// ============================================================================
+
+func (s *HistoryService) snapshotRecoveryArea(ctx context.Context, capturedAt time.Time) error {
+	area, err := s.oracleService.fetchRecoveryArea(ctx)
+	if err != nil {
+		return err
+	}
+	if area == nil {
+		return nil // no recovery area configured
+	}
+
+	return s.recoveryAreaRepo.Create(ctx, &repository.RecoveryAreaMetric{
+		CapturedAt:         capturedAt,
+		Target:             s.target,
+		SpaceLimitMB:       area.SpaceLimitMB,
+		SpaceUsedMB:        area.SpaceUsedMB,
+		SpaceReclaimableMB: area.SpaceReclaimableMB,
+		FileCount:          area.FileCount,
+	})
+}
+
+// RecoveryAreaHistory returns the recovery area snapshots taken in a window
+func (s *HistoryService) RecoveryAreaHistory(ctx context.Context, start, end time.Time) ([]*repository.RecoveryAreaMetric, error) {
+	if !end.After(start) {
+		return nil, fmt.Errorf("end must be after start")
+	}
+	return s.recoveryAreaRepo.GetByTimeRange(ctx, s.target, start, end)
+}
+
+// ============================================================================
+// DATA GUARD LAG
+// ============================================================================
+
+func (s *HistoryService) snapshotDataGuardLag(ctx context.Context, capturedAt time.Time) error {
+	stats, err := s.oracleService.fetchDataGuardStats(ctx)
+	if err != nil {
+		return err
+	}
+
+	metric := &repository.DataGuardLagMetric{
+		CapturedAt:          capturedAt,
+		Target:              s.target,
+		TransportLagSeconds: stats["transport lag"],
+		ApplyLagSeconds:     stats["apply lag"],
+	}
+	if metric.TransportLagSeconds == nil && metric.ApplyLagSeconds == nil {
+		return nil // not a standby
+	}
+
+	return s.dataGuardLagRepo.Create(ctx, metric)
+}
+
+// DataGuardLagHistory returns the standby lag snapshots taken in a window
+func (s *HistoryService) DataGuardLagHistory(ctx context.Context, start, end time.Time) ([]*repository.DataGuardLagMetric, error) {
+	if !end.After(start) {
+		return nil, fmt.Errorf("end must be after start")
+	}
+	return s.dataGuardLagRepo.GetByTimeRange(ctx, s.target, start, end)
+}
+
+// ============================================================================
+// INITIALIZATION PARAMETERS
+// ============================================================================
+
+// snapshotParameters records the parameters whose value changed since the
+// last snapshot (all of them on the first run)
+func (s *HistoryService) snapshotParameters(ctx context.Context, capturedAt time.Time) error {
+	params, err := s.oracleService.fetchParameters(ctx)
+	if err != nil {
+		return err
+	}
+
+	stored, err := s.parameterRepo.GetAsOf(ctx, s.target, capturedAt)
+	if err != nil {
+		return err
+	}
+	previous := make(map[string]*repository.ParameterSnapshot, len(stored))
+	for _, snapshot := range stored {
+		previous[snapshot.Name] = snapshot
+	}
+
+	changed := []*repository.ParameterSnapshot{}
+	for _, p := range params {
+		if prev, ok := previous[p.Name]; ok &&
+			prev.IsDefault == p.IsDefault &&
+			parameterValuesEqual(prev.Value, p.Value) &&
+			parameterValuesEqual(prev.SPFileValue, p.SPFileValue) {
+			continue
+		}
+		changed = append(changed, &repository.ParameterSnapshot{
+			CapturedAt:  capturedAt,
+			Target:      s.target,
+			Name:        p.Name,
+			Value:       p.Value,
+			SPFileValue: p.SPFileValue,
+			IsDefault:   p.IsDefault,
+		})
+	}
+
+	return s.parameterRepo.CreateBatch(ctx, changed)
+}
+
+// ParameterHistory returns the parameter changes recorded for a target in a
+// window, optionally for one parameter. An empty target means this one.
+func (s *HistoryService) ParameterHistory(ctx context.Context, target, name string, start, end time.Time) ([]*repository.ParameterChange, error) {
+	if !end.After(start) {
+		return nil, fmt.Errorf("end must be after start")
+	}
+	if target == "" {
+		target = s.target
+	}
+	return s.parameterRepo.ListChanges(ctx, target, strings.ToLower(name), start, end)
+}
+
+// ParameterSet names the recorded parameters of a target at a point in
+// time; an empty target means this one, a nil time the latest snapshot
+type ParameterSet struct {
+	Target string
+	At     *time.Time
+}
+
+// ParameterDifference is a parameter set differently in two parameter sets;
+// a side is nil when the parameter was not recorded there
+type ParameterDifference struct {
+	Name  string
+	Base  *repository.ParameterSnapshot
+	Other *repository.ParameterSnapshot
+}
+
+// CompareParameters diffs the parameters of two targets, or of one target at
+// two points in time, returning only the parameters that differ
+func (s *HistoryService) CompareParameters(ctx context.Context, base, other ParameterSet) ([]*ParameterDifference, error) {
+	baseParams, err := s.parameterSet(ctx, base)
+	if err != nil {
+		return nil, err
+	}
+	otherParams, err := s.parameterSet(ctx, other)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(baseParams)+len(otherParams))
+	for name := range baseParams {
+		names = append(names, name)
+	}
+	for name := range otherParams {
+		if _, ok := baseParams[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+
+	differences := []*ParameterDifference{}
+	for _, name := range names {
+		b, o := baseParams[name], otherParams[name]
+		if b != nil && o != nil &&
+			parameterValuesEqual(b.Value, o.Value) &&
+			parameterValuesEqual(b.SPFileValue, o.SPFileValue) {
+			continue
+		}
+		differences = append(differences, &ParameterDifference{Name: name, Base: b, Other: o})
+	}
+
+	return differences, nil
+}
+
+func (s *HistoryService) parameterSet(ctx context.Context, set ParameterSet) (map[string]*repository.ParameterSnapshot, error) {
+	target := set.Target
+	if target == "" {
+		target = s.target
+	}
+	at := time.Now()
+	if set.At != nil {
+		at = *set.At
+	}
+
+	snapshots, err := s.parameterRepo.GetAsOf(ctx, target, at)
+	if err != nil {
+		return nil, err
+	}
+	if len(snapshots) == 0 {
+		return nil, fmt.Errorf("no parameters recorded for %s as of %s", target, at.Format(time.RFC3339))
+	}
+
+	params := make(map[string]*repository.ParameterSnapshot, len(snapshots))
+	for _, snapshot := range snapshots {
+		params[snapshot.Name] = snapshot
+	}
+	return params, nil
+}
+
+// ============================================================================
+// SEGMENTS
+// ============================================================================
+
+func (s *HistoryService) snapshotSegments(ctx context.Context, capturedAt time.Time) error {
+	// Snapshots are stamped when the cycle starts, so a tick landing just
+	// short of the interval would otherwise skip to the next one
+	window := SegmentSnapshotInterval - s.interval/2
+	recent, err := s.segmentRepo.HasSnapshotSince(ctx, s.target, capturedAt.Add(-window))
+	if err != nil || recent {
+		return err
+	}
+
+	// Snapshots are keyed without a container, so keep to the connected one
+	pdb, err := s.oracleService.connectedPDB(ctx)
+	if err != nil {
+		return err
+	}
+	segments, err := s.oracleService.fetchSegments(ctx, "", "", SegmentSnapshotLimit, pdb)
+	if err != nil {
+		return err
+	}
+
+	snapshots := make([]*repository.SegmentSnapshot, len(segments))
+	for i, seg := range segments {
+		snapshots[i] = &repository.SegmentSnapshot{
+			CapturedAt:     capturedAt,
+			Target:         s.target,
+			Owner:          seg.Owner,
+			SegmentName:    seg.SegmentName,
+			PartitionName:  seg.PartitionName,
+			SegmentType:    seg.SegmentType,
+			TablespaceName: seg.TablespaceName,
+			SizeMB:         seg.SizeMB,
+			Extents:        seg.Extents,
+		}
+	}
+
+	return s.segmentRepo.CreateBatch(ctx, snapshots)
+}
+
+// SegmentHistory returns the size snapshots of one segment, or of one
+// partition of it, taken in a window
+func (s *HistoryService) SegmentHistory(ctx context.Context, owner, segmentName string, partitionName *string, start, end time.Time) ([]*repository.SegmentSnapshot, error) {
+	if !end.After(start) {
+		return nil, fmt.Errorf("end must be after start")
+	}
+	if partitionName != nil {
+		name := strings.ToUpper(*partitionName)
+		partitionName = &name
+	}
+	return s.segmentRepo.GetHistory(ctx, s.target, strings.ToUpper(owner), strings.ToUpper(segmentName), partitionName, start, end)
+}
+
+// TopGrowingSegments returns the segments that grew the most in a window,
+// optionally only those in one tablespace or of one owner. Only the largest
+// segments are snapshotted, so a segment is measured from when it became one.
+func (s *HistoryService) TopGrowingSegments(ctx context.Context, tablespace, owner string, start, end time.Time, limit int) ([]*repository.SegmentGrowth, error) {
+	if !end.After(start) {
+		return nil, fmt.Errorf("end must be after start")
+	}
+	return s.segmentRepo.TopGrowth(ctx, s.target, strings.ToUpper(tablespace), strings.ToUpper(owner), start, end, limit)
+}
@@ -0,0 +1,651 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aashiq-04/oracle-dba/internal/repository"
+	"github.com/aashiq-04/oracle-dba/pkg/logger"
+	"github.com/aashiq-04/oracle-dba/pkg/notify"
+)
+
+// Notification events
+const (
+	NotificationEventFiring   = "FIRING"
+	NotificationEventResolved = "RESOLVED"
+	NotificationEventTest     = "TEST"
+)
+
+// Notification channel types
+const (
+	ChannelTypeWebhook = "WEBHOOK"
+	ChannelTypeEmail   = "EMAIL"
+	ChannelTypeSlack   = "SLACK"
+	ChannelTypeTeams   = "TEAMS"
+)
+
+// Severity ranks used for a channel's minimum severity
+var severityRank = map[string]int{
+	"INFO":     0,
+	"WARNING":  1,
+	"CRITICAL": 2,
+}
+
+const (
+	defaultSubjectTemplate = `[{{.Alert.Severity}}] {{.Event}}: {{.Alert.RuleName}} on {{.Alert.Target}}`
+
+	defaultBodyTemplate = `{{.Alert.Message}}
+Status: {{.Event}}
+Object: {{.Alert.ObjectKey}}
+Value: {{printf "%.2f" .Alert.Value}} (threshold {{printf "%.2f" .Alert.Threshold}})
+Fired at: {{.Alert.FiredAt.Format "2006-01-02 15:04:05 MST"}}{{if .Alert.ResolvedAt}}
+Resolved at: {{.Alert.ResolvedAt.Format "2006-01-02 15:04:05 MST"}}{{end}}`
+
+	// Generic webhooks receive the whole event as JSON by default
+	defaultWebhookBodyTemplate = `{{json .}}`
+)
+
+var templateFuncs = template.FuncMap{
+	"json": func(v interface{}) (string, error) {
+		b, err := json.Marshal(v)
+		return string(b), err
+	},
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+}
+
+// NotificationData is the value channel templates are executed against
+type NotificationData struct {
+	Event   string            `json:"event"`
+	Channel string            `json:"channel"`
+	Alert   NotificationAlert `json:"alert"`
+	SentAt  time.Time         `json:"sentAt"`
+}
+
+// NotificationAlert is the alert as exposed to templates and webhook payloads
+type NotificationAlert struct {
+	ID         string     `json:"id"`
+	RuleName   string     `json:"ruleName"`
+	Metric     string     `json:"metric"`
+	Severity   string     `json:"severity"`
+	Status     string     `json:"status"`
+	Target     string     `json:"target"`
+	ObjectKey  string     `json:"objectKey"`
+	Value      float64    `json:"value"`
+	Threshold  float64    `json:"threshold"`
+	Message    string     `json:"message"`
+	FiredAt    time.Time  `json:"firedAt"`
+	ResolvedAt *time.Time `json:"resolvedAt,omitempty"`
+}
+
+// ChannelFactory builds a deliverable channel from its stored configuration
+type ChannelFactory func(channel *repository.NotificationChannel) (notify.Channel, error)
+
+// NotificationResult is the outcome of delivering one message to one channel
+type NotificationResult struct {
+	Success  bool
+	Attempts int
+	Error    *string
+}
+
+// NotificationSuppressor decides whether an alert's notifications are
+// currently suppressed (maintenance windows, silences...)
+type NotificationSuppressor interface {
+	CheckSuppression(ctx context.Context, alert *repository.Alert, at time.Time) (*Suppression, error)
+}
+
+type notificationJob struct {
+	event string
+	alert *repository.Alert
+}
+
+// NotificationService renders alert events through per-channel templates
+// and delivers them with retry and exponential backoff
+type NotificationService struct {
+	channelRepo      repository.NotificationChannelRepository
+	notificationRepo repository.NotificationRepository
+	auditRepo        repository.AuditLogRepository
+	suppressor       NotificationSuppressor
+	logger           logger.Logger
+	target           string
+	dedupWindow      time.Duration
+	maxAttempts      int
+	initialBackoff   time.Duration
+	maxBackoff       time.Duration
+
+	factories map[string]ChannelFactory
+
+	queue    chan notificationJob
+	cancel   context.CancelFunc
+	done     chan struct{}
+	inFlight sync.WaitGroup
+}
+
+// NewNotificationService creates a new notification dispatcher
+func NewNotificationService(
+	channelRepo repository.NotificationChannelRepository,
+	notificationRepo repository.NotificationRepository,
+	auditRepo repository.AuditLogRepository,
+	suppressor NotificationSuppressor,
+	log logger.Logger,
+	target string,
+	dedupWindow time.Duration,
+	queueSize int,
+	maxAttempts int,
+	initialBackoff time.Duration,
+	maxBackoff time.Duration,
+) *NotificationService {
+	s := &NotificationService{
+		channelRepo:      channelRepo,
+		notificationRepo: notificationRepo,
+		auditRepo:        auditRepo,
+		suppressor:       suppressor,
+		logger:           log,
+		target:           target,
+		dedupWindow:      dedupWindow,
+		maxAttempts:      maxAttempts,
+		initialBackoff:   initialBackoff,
+		maxBackoff:       maxBackoff,
+		factories:        map[string]ChannelFactory{},
+		queue:            make(chan notificationJob, queueSize),
+	}
+
+	s.RegisterChannelType(ChannelTypeWebhook, newWebhookChannel)
+	s.RegisterChannelType(ChannelTypeEmail, newEmailChannel)
+	s.RegisterChannelType(ChannelTypeSlack, newChatChannel)
+	s.RegisterChannelType(ChannelTypeTeams, newChatChannel)
+
+	return s
+}
+
+// RegisterChannelType makes a channel type available for configuration
+func (s *NotificationService) RegisterChannelType(channelType string, factory ChannelFactory) {
+	s.factories[channelType] = factory
+}
+
+// Start begins delivering queued alert notifications in the background
+func (s *NotificationService) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+	s.done = make(chan struct{})
+
+	go func() {
+		defer close(s.done)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case job := <-s.queue:
+				s.dispatch(ctx, job)
+			}
+		}
+	}()
+}
+
+// Stop halts delivery, abandoning pending retries, and waits for in-flight
+// deliveries to be recorded
+func (s *NotificationService) Stop() {
+	if s.cancel == nil {
+		return
+	}
+	s.cancel()
+	<-s.done
+	s.inFlight.Wait()
+}
+
+// NotifyAlert queues an alert event for delivery without blocking rule evaluation
+func (s *NotificationService) NotifyAlert(event string, alert *repository.Alert) {
+	select {
+	case s.queue <- notificationJob{event: event, alert: alert}:
+	default:
+		s.logger.Warn("Notification queue full, dropping alert notification",
+			logger.String("alert_id", alert.ID.String()),
+			logger.String("event", event),
+		)
+	}
+}
+
+// dispatch fans an alert event out to every enabled channel whose minimum
+// severity it meets; each channel retries independently. Suppressed events
+// are recorded against the alert instead of being sent.
+func (s *NotificationService) dispatch(ctx context.Context, job notificationJob) {
+	suppression, err := s.checkSuppression(ctx, job)
+	if err != nil {
+		// Fail open: a missed page is worse than an unwanted one
+		s.logger.Error("Failed to check notification suppression", logger.Error(err))
+	}
+	if suppression != nil {
+		s.recordSuppressed(ctx, job, suppression)
+		return
+	}
+
+	channels, err := s.channelRepo.ListEnabled(ctx)
+	if err != nil {
+		s.logger.Error("Failed to load notification channels", logger.Error(err))
+		return
+	}
+
+	for _, channel := range channels {
+		if severityRank[job.alert.Severity] < severityRank[channel.MinSeverity] {
+			continue
+		}
+
+		s.inFlight.Add(1)
+		go func(channel *repository.NotificationChannel) {
+			defer s.inFlight.Done()
+
+			result := s.deliver(ctx, channel, job.event, job.alert)
+			if !result.Success {
+				s.logger.Warn("Alert notification failed",
+					logger.String("channel", channel.Name),
+					logger.String("alert_id", job.alert.ID.String()),
+					logger.String("error", *result.Error),
+				)
+			}
+		}(channel)
+	}
+}
+
+func (s *NotificationService) checkSuppression(ctx context.Context, job notificationJob) (*Suppression, error) {
+	suppression, err := s.suppressor.CheckSuppression(ctx, job.alert, time.Now())
+	if err != nil || suppression != nil {
+		return suppression, err
+	}
+
+	switch job.event {
+	case NotificationEventFiring:
+		// Repeated firings of the same rule and object are notified once per window
+		if s.dedupWindow <= 0 || job.alert.RuleID == nil {
+			return nil, nil
+		}
+		previous, err := s.notificationRepo.FindRecentFiring(ctx, *job.alert.RuleID,
+			job.alert.Target, job.alert.ObjectKey, time.Now().Add(-s.dedupWindow))
+		if err != nil || previous == nil {
+			return nil, err
+		}
+		return &Suppression{
+			Reason: fmt.Sprintf("duplicate of alert %s notified at %s (dedup window %s)",
+				previous.AlertID, previous.CreatedAt.Format(time.RFC3339), s.dedupWindow),
+			SuppressedBy: previous.AlertID,
+		}, nil
+
+	case NotificationEventResolved:
+		// Only announce resolution of alerts whose firing was announced
+		history, err := s.notificationRepo.ListByAlert(ctx, job.alert.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range history {
+			if n.Event == NotificationEventFiring && n.Status != repository.NotificationStatusSuppressed {
+				return nil, nil
+			}
+		}
+		return &Suppression{Reason: "firing notification was suppressed"}, nil
+	}
+
+	return nil, nil
+}
+
+func (s *NotificationService) recordSuppressed(ctx context.Context, job notificationJob, suppression *Suppression) {
+	alertID := job.alert.ID
+	reason := suppression.Reason
+
+	if err := s.notificationRepo.Create(ctx, &repository.Notification{
+		AlertID:      &alertID,
+		Event:        job.event,
+		Status:       repository.NotificationStatusSuppressed,
+		Reason:       &reason,
+		SuppressedBy: suppression.SuppressedBy,
+	}); err != nil {
+		s.logger.Error("Failed to record suppressed notification", logger.Error(err))
+	}
+}
+
+// AlertHistory returns the notification history of an alert, including
+// suppressed events and why they were suppressed
+func (s *NotificationService) AlertHistory(ctx context.Context, alertID uuid.UUID) ([]*repository.Notification, error) {
+	return s.notificationRepo.ListByAlert(ctx, alertID)
+}
+
+// deliver renders and sends one event to one channel, then records the outcome
+func (s *NotificationService) deliver(ctx context.Context, channel *repository.NotificationChannel, event string, alert *repository.Alert) *NotificationResult {
+	result := &NotificationResult{}
+
+	err := func() error {
+		sender, err := s.buildChannel(channel)
+		if err != nil {
+			return err
+		}
+
+		msg, err := renderMessage(channel, event, alert)
+		if err != nil {
+			return err
+		}
+
+		result.Attempts, err = s.sendWithRetry(ctx, sender, msg)
+		return err
+	}()
+
+	result.Success = err == nil
+	status := repository.NotificationStatusSent
+	if err != nil {
+		errMsg := err.Error()
+		result.Error = &errMsg
+		status = repository.NotificationStatusFailed
+	}
+
+	var alertID *uuid.UUID
+	if event != NotificationEventTest {
+		alertID = &alert.ID
+	}
+	channelID := channel.ID
+
+	// Record even when ctx was cancelled mid-delivery
+	recordCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := s.notificationRepo.Create(recordCtx, &repository.Notification{
+		AlertID:     alertID,
+		ChannelID:   &channelID,
+		ChannelName: channel.Name,
+		Event:       event,
+		Status:      status,
+		Attempts:    result.Attempts,
+		Error:       result.Error,
+	}); err != nil {
+		s.logger.Error("Failed to record notification", logger.Error(err))
+	}
+
+	return result
+}
+
+// sendWithRetry retries transient failures with exponential backoff and
+// returns the number of attempts made
+func (s *NotificationService) sendWithRetry(ctx context.Context, sender notify.Channel, msg notify.Message) (int, error) {
+	backoff := s.initialBackoff
+
+	for attempt := 1; ; attempt++ {
+		err := sender.Send(ctx, msg)
+		if err == nil {
+			return attempt, nil
+		}
+		if notify.IsPermanent(err) || attempt >= s.maxAttempts {
+			return attempt, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return attempt, fmt.Errorf("%w (retry abandoned: %v)", err, ctx.Err())
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+		if backoff > s.maxBackoff {
+			backoff = s.maxBackoff
+		}
+	}
+}
+
+func (s *NotificationService) buildChannel(channel *repository.NotificationChannel) (notify.Channel, error) {
+	factory, ok := s.factories[channel.Type]
+	if !ok {
+		return nil, fmt.Errorf("unknown channel type: %s", channel.Type)
+	}
+	return factory(channel)
+}
+
+func renderMessage(channel *repository.NotificationChannel, event string, alert *repository.Alert) (notify.Message, error) {
+	data := NotificationData{
+		Event:   event,
+		Channel: channel.Name,
+		Alert: NotificationAlert{
+			ID:         alert.ID.String(),
+			RuleName:   alert.RuleName,
+			Metric:     alert.Metric,
+			Severity:   alert.Severity,
+			Status:     alert.Status,
+			Target:     alert.Target,
+			ObjectKey:  alert.ObjectKey,
+			Value:      alert.Value,
+			Threshold:  alert.Threshold,
+			Message:    alert.Message,
+			FiredAt:    alert.FiredAt,
+			ResolvedAt: alert.ResolvedAt,
+		},
+		SentAt: time.Now(),
+	}
+
+	subjectTmpl, bodyTmpl := channelTemplates(channel)
+
+	subject, err := renderTemplate("subject", subjectTmpl, data)
+	if err != nil {
+		return notify.Message{}, err
+	}
+	body, err := renderTemplate("body", bodyTmpl, data)
+	if err != nil {
+		return notify.Message{}, err
+	}
+
+	return notify.Message{Subject: subject, Body: body}, nil
+}
+
+func channelTemplates(channel *repository.NotificationChannel) (string, string) {
+	subject := defaultSubjectTemplate
+	if channel.SubjectTemplate != nil && *channel.SubjectTemplate != "" {
+		subject = *channel.SubjectTemplate
+	}
+
+	body := defaultBodyTemplate
+	if channel.Type == ChannelTypeWebhook {
+		body = defaultWebhookBodyTemplate
+	}
+	if channel.BodyTemplate != nil && *channel.BodyTemplate != "" {
+		body = *channel.BodyTemplate
+	}
+
+	return subject, body
+}
+
+func renderTemplate(name, text string, data NotificationData) (string, error) {
+	tmpl, err := template.New(name).Funcs(templateFuncs).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", &notify.PermanentError{Err: fmt.Errorf("invalid %s template: %w", name, err)}
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", &notify.PermanentError{Err: fmt.Errorf("failed to render %s template: %w", name, err)}
+	}
+	return buf.String(), nil
+}
+
+// ============================================================================
+// CHANNEL FACTORIES
+// ============================================================================
+
+func newWebhookChannel(channel *repository.NotificationChannel) (notify.Channel, error) {
+	if channel.Settings.URL == "" {
+		return nil, fmt.Errorf("webhook channel requires a URL")
+	}
+	return notify.NewWebhookChannel(channel.Settings.URL, channel.Settings.Secret), nil
+}
+
+func newChatChannel(channel *repository.NotificationChannel) (notify.Channel, error) {
+	if channel.Settings.URL == "" {
+		return nil, fmt.Errorf("%s channel requires a webhook URL", strings.ToLower(channel.Type))
+	}
+	return notify.NewChatChannel(channel.Settings.URL), nil
+}
+
+func newEmailChannel(channel *repository.NotificationChannel) (notify.Channel, error) {
+	settings := channel.Settings
+	if settings.SMTPHost == "" || settings.SMTPPort == 0 {
+		return nil, fmt.Errorf("email channel requires an SMTP host and port")
+	}
+	if settings.From == "" || len(settings.To) == 0 {
+		return nil, fmt.Errorf("email channel requires a sender and at least one recipient")
+	}
+	return notify.NewEmailChannel(
+		settings.SMTPHost,
+		settings.SMTPPort,
+		settings.SMTPUsername,
+		settings.SMTPPassword,
+		settings.From,
+		settings.To,
+	), nil
+}
+
+// ============================================================================
+// CHANNEL MANAGEMENT
+// ============================================================================
+
+// ListChannels returns all notification channels
+func (s *NotificationService) ListChannels(ctx context.Context) ([]*repository.NotificationChannel, error) {
+	return s.channelRepo.List(ctx)
+}
+
+// GetChannel returns a notification channel by ID
+func (s *NotificationService) GetChannel(ctx context.Context, id uuid.UUID) (*repository.NotificationChannel, error) {
+	return s.channelRepo.GetByID(ctx, id)
+}
+
+// CreateChannel validates and stores a new notification channel
+func (s *NotificationService) CreateChannel(ctx context.Context, userID uuid.UUID, channel *repository.NotificationChannel) error {
+	if err := s.validateChannel(channel); err != nil {
+		return err
+	}
+
+	channel.CreatedBy = &userID
+	if err := s.channelRepo.Create(ctx, channel); err != nil {
+		return fmt.Errorf("failed to create notification channel: %w", err)
+	}
+
+	s.auditChannelAction(ctx, userID, "CREATE_NOTIFICATION_CHANNEL", channel.ID)
+	return nil
+}
+
+// UpdateChannel validates and updates a notification channel. Empty secrets
+// and a masked URL keep the stored values so clients never need to read them
+// back.
+func (s *NotificationService) UpdateChannel(ctx context.Context, userID uuid.UUID, channel *repository.NotificationChannel) error {
+	existing, err := s.channelRepo.GetByID(ctx, channel.ID)
+	if err != nil {
+		return err
+	}
+	if channel.Settings.Secret == "" {
+		channel.Settings.Secret = existing.Settings.Secret
+	}
+	if channel.Settings.SMTPPassword == "" {
+		channel.Settings.SMTPPassword = existing.Settings.SMTPPassword
+	}
+	if channel.Settings.URL == MaskChannelURL(existing) {
+		channel.Settings.URL = existing.Settings.URL
+	}
+
+	if err := s.validateChannel(channel); err != nil {
+		return err
+	}
+
+	if err := s.channelRepo.Update(ctx, channel); err != nil {
+		return fmt.Errorf("failed to update notification channel: %w", err)
+	}
+
+	s.auditChannelAction(ctx, userID, "UPDATE_NOTIFICATION_CHANNEL", channel.ID)
+	return nil
+}
+
+// DeleteChannel deletes a notification channel; its delivery history is kept
+func (s *NotificationService) DeleteChannel(ctx context.Context, userID, id uuid.UUID) error {
+	if err := s.channelRepo.Delete(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete notification channel: %w", err)
+	}
+
+	s.auditChannelAction(ctx, userID, "DELETE_NOTIFICATION_CHANNEL", id)
+	return nil
+}
+
+// SendTestNotification synchronously delivers a sample alert through a
+// channel, retrying like a real notification, and reports the outcome
+func (s *NotificationService) SendTestNotification(ctx context.Context, userID, channelID uuid.UUID) (*NotificationResult, error) {
+	channel, err := s.channelRepo.GetByID(ctx, channelID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	alert := &repository.Alert{
+		ID:        uuid.New(),
+		RuleName:  "Test notification",
+		Metric:    "TEST",
+		Severity:  "INFO",
+		Status:    repository.AlertStatusFiring,
+		Target:    s.target,
+		ObjectKey: channel.Name,
+		Message:   fmt.Sprintf("Test notification for channel %s", channel.Name),
+		FiredAt:   now,
+	}
+
+	result := s.deliver(ctx, channel, NotificationEventTest, alert)
+
+	s.auditChannelAction(ctx, userID, "SEND_TEST_NOTIFICATION", channel.ID)
+	return result, nil
+}
+
+// MaskChannelURL returns a channel's URL safe to show to readers. Slack and
+// Teams incoming webhook URLs embed their token in the path, so only the
+// scheme and host are kept.
+func MaskChannelURL(channel *repository.NotificationChannel) string {
+	if channel.Type != ChannelTypeSlack && channel.Type != ChannelTypeTeams || channel.Settings.URL == "" {
+		return channel.Settings.URL
+	}
+
+	u, err := url.Parse(channel.Settings.URL)
+	if err != nil || u.Host == "" {
+		return maskedValue
+	}
+	return u.Scheme + "://" + u.Host + "/" + maskedValue
+}
+
+const maskedValue = "****"
+
+func (s *NotificationService) validateChannel(channel *repository.NotificationChannel) error {
+	if strings.TrimSpace(channel.Name) == "" {
+		return fmt.Errorf("channel name is required")
+	}
+	if _, ok := severityRank[channel.MinSeverity]; !ok {
+		return fmt.Errorf("unknown severity: %s", channel.MinSeverity)
+	}
+	if _, err := s.buildChannel(channel); err != nil {
+		return err
+	}
+
+	// Render against a sample alert so template errors surface at save time
+	sample := &repository.Alert{FiredAt: time.Now()}
+	if _, err := renderMessage(channel, NotificationEventTest, sample); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *NotificationService) auditChannelAction(ctx context.Context, userID uuid.UUID, action string, id uuid.UUID) {
+	resourceID := id.String()
+	log := &repository.AuditLog{
+		UserID:       &userID,
+		Username:     userID.String(),
+		Action:       action,
+		ResourceType: "NOTIFICATION_CHANNEL",
+		ResourceID:   &resourceID,
+		Status:       "SUCCESS",
+	}
+	_ = s.auditRepo.Create(ctx, log)
+}
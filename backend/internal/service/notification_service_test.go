@@ -0,0 +1,359 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aashiq-04/oracle-dba/internal/repository"
+	"github.com/aashiq-04/oracle-dba/pkg/logger"
+	"github.com/aashiq-04/oracle-dba/pkg/notify"
+)
+
+// fakeNotificationRepo keeps notifications in memory. Notifications carry
+// no rule or object, so sent firings are indexed by them separately.
+type fakeNotificationRepo struct {
+	mu            sync.Mutex
+	notifications []*repository.Notification
+	firings       []sentFiring
+	firingLookups int
+}
+
+type sentFiring struct {
+	ruleID       uuid.UUID
+	target       string
+	objectKey    string
+	notification *repository.Notification
+}
+
+func (r *fakeNotificationRepo) Create(ctx context.Context, n *repository.Notification) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	n.ID = uuid.New()
+	if n.CreatedAt.IsZero() {
+		n.CreatedAt = time.Now()
+	}
+	r.notifications = append(r.notifications, n)
+	return nil
+}
+
+func (r *fakeNotificationRepo) ListByAlert(ctx context.Context, alertID uuid.UUID) ([]*repository.Notification, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var history []*repository.Notification
+	for _, n := range r.notifications {
+		if n.AlertID != nil && *n.AlertID == alertID {
+			history = append(history, n)
+		}
+	}
+	return history, nil
+}
+
+func (r *fakeNotificationRepo) FindRecentFiring(ctx context.Context, ruleID uuid.UUID, target, objectKey string, since time.Time) (*repository.Notification, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.firingLookups++
+	for _, f := range r.firings {
+		if f.ruleID == ruleID && f.target == target && f.objectKey == objectKey &&
+			!f.notification.CreatedAt.Before(since) {
+			return f.notification, nil
+		}
+	}
+	return nil, nil
+}
+
+// recordSentFiring stores a delivered FIRING notification of alert
+func (r *fakeNotificationRepo) recordSentFiring(alert *repository.Alert, at time.Time) {
+	alertID := alert.ID
+	n := &repository.Notification{
+		ID:        uuid.New(),
+		AlertID:   &alertID,
+		Event:     NotificationEventFiring,
+		Status:    repository.NotificationStatusSent,
+		CreatedAt: at,
+	}
+	r.notifications = append(r.notifications, n)
+	r.firings = append(r.firings, sentFiring{
+		ruleID:       *alert.RuleID,
+		target:       alert.Target,
+		objectKey:    alert.ObjectKey,
+		notification: n,
+	})
+}
+
+// noSuppression never suppresses notifications
+type noSuppression struct{}
+
+func (noSuppression) CheckSuppression(ctx context.Context, alert *repository.Alert, at time.Time) (*Suppression, error) {
+	return nil, nil
+}
+
+func newTestNotificationService(notificationRepo repository.NotificationRepository, dedupWindow time.Duration) *NotificationService {
+	return NewNotificationService(nil, notificationRepo, nil, noSuppression{}, logger.NewLogger(),
+		"PRODDB", dedupWindow, 10, 4, time.Millisecond, 4*time.Millisecond)
+}
+
+func TestSendWithRetry(t *testing.T) {
+	tests := []struct {
+		name         string
+		statuses     []int
+		wantAttempts int
+		wantErr      bool
+	}{
+		{"success", []int{200}, 1, false},
+		{"retries 5xx until success", []int{503, 502, 200}, 3, false},
+		{"retries rate limiting", []int{429, 200}, 2, false},
+		{"stops at max attempts", []int{500, 500, 500, 500, 500}, 4, true},
+		{"stops on permanent error", []int{400, 200}, 1, true},
+		{"stops on permanent error after a transient one", []int{503, 401, 200}, 2, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				n := int(requests.Add(1)) - 1
+				if n >= len(tt.statuses) {
+					n = len(tt.statuses) - 1
+				}
+				w.WriteHeader(tt.statuses[n])
+			}))
+			defer server.Close()
+
+			s := newTestNotificationService(&fakeNotificationRepo{}, 0)
+			attempts, err := s.sendWithRetry(context.Background(),
+				notify.NewWebhookChannel(server.URL, ""), notify.Message{Body: "{}"})
+
+			if attempts != tt.wantAttempts {
+				t.Errorf("attempts = %d, want %d", attempts, tt.wantAttempts)
+			}
+			if int(requests.Load()) != tt.wantAttempts {
+				t.Errorf("requests = %d, want %d", requests.Load(), tt.wantAttempts)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSendWithRetryAbandonedOnCancel(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	s := newTestNotificationService(&fakeNotificationRepo{}, 0)
+	s.initialBackoff = time.Hour
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	attempts, err := s.sendWithRetry(ctx, notify.NewWebhookChannel(server.URL, ""), notify.Message{Body: "{}"})
+	if attempts != 1 || err == nil {
+		t.Errorf("attempts = %d, err = %v, want 1 attempt and an error", attempts, err)
+	}
+}
+
+func firingAlert(ruleID uuid.UUID, objectKey string) *repository.Alert {
+	return &repository.Alert{
+		ID:        uuid.New(),
+		RuleID:    &ruleID,
+		Severity:  "CRITICAL",
+		Status:    repository.AlertStatusFiring,
+		Target:    "PRODDB",
+		ObjectKey: objectKey,
+	}
+}
+
+func TestCheckSuppressionDedupesRepeatedFirings(t *testing.T) {
+	ctx := context.Background()
+	ruleID := uuid.New()
+	first := firingAlert(ruleID, "USERS")
+
+	tests := []struct {
+		name           string
+		alert          *repository.Alert
+		previousAt     time.Time
+		wantSuppressed bool
+	}{
+		{"same rule and object within window", firingAlert(ruleID, "USERS"), time.Now().Add(-10 * time.Minute), true},
+		{"same rule and object after window", firingAlert(ruleID, "USERS"), time.Now().Add(-2 * time.Hour), false},
+		{"other object", firingAlert(ruleID, "TOOLS"), time.Now().Add(-10 * time.Minute), false},
+		{"other rule", firingAlert(uuid.New(), "USERS"), time.Now().Add(-10 * time.Minute), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeNotificationRepo{}
+			repo.recordSentFiring(first, tt.previousAt)
+			s := newTestNotificationService(repo, time.Hour)
+
+			suppression, err := s.checkSuppression(ctx, notificationJob{event: NotificationEventFiring, alert: tt.alert})
+			if err != nil {
+				t.Fatalf("checkSuppression() error = %v", err)
+			}
+			if (suppression != nil) != tt.wantSuppressed {
+				t.Fatalf("suppression = %+v, want suppressed %v", suppression, tt.wantSuppressed)
+			}
+			if suppression != nil && (suppression.SuppressedBy == nil || *suppression.SuppressedBy != first.ID) {
+				t.Errorf("SuppressedBy = %v, want %s", suppression.SuppressedBy, first.ID)
+			}
+		})
+	}
+}
+
+func TestCheckSuppressionWithoutDedupWindow(t *testing.T) {
+	repo := &fakeNotificationRepo{}
+	alert := firingAlert(uuid.New(), "USERS")
+	repo.recordSentFiring(alert, time.Now())
+	s := newTestNotificationService(repo, 0)
+
+	suppression, err := s.checkSuppression(context.Background(),
+		notificationJob{event: NotificationEventFiring, alert: alert})
+	if err != nil || suppression != nil {
+		t.Errorf("checkSuppression() = %+v, %v, want no suppression", suppression, err)
+	}
+	if repo.firingLookups != 0 {
+		t.Errorf("FindRecentFiring called %d times with dedup disabled", repo.firingLookups)
+	}
+}
+
+func TestCheckSuppressionResolvedFollowsFiring(t *testing.T) {
+	ctx := context.Background()
+	repo := &fakeNotificationRepo{}
+	s := newTestNotificationService(repo, time.Hour)
+
+	notified := firingAlert(uuid.New(), "USERS")
+	repo.recordSentFiring(notified, time.Now())
+	suppression, err := s.checkSuppression(ctx, notificationJob{event: NotificationEventResolved, alert: notified})
+	if err != nil || suppression != nil {
+		t.Errorf("resolution of a notified alert: suppression = %+v, err = %v", suppression, err)
+	}
+
+	deduped := firingAlert(uuid.New(), "TOOLS")
+	alertID := deduped.ID
+	repo.notifications = append(repo.notifications, &repository.Notification{
+		AlertID: &alertID,
+		Event:   NotificationEventFiring,
+		Status:  repository.NotificationStatusSuppressed,
+	})
+	suppression, err = s.checkSuppression(ctx, notificationJob{event: NotificationEventResolved, alert: deduped})
+	if err != nil || suppression == nil {
+		t.Errorf("resolution of a suppressed alert: suppression = %+v, err = %v, want suppressed", suppression, err)
+	}
+}
+
+func TestMaskChannelURL(t *testing.T) {
+	tests := []struct {
+		channelType string
+		url         string
+		want        string
+	}{
+		{ChannelTypeSlack, "https://hooks.slack.com/services/T000/B000/XXXXXXXX", "https://hooks.slack.com/****"},
+		{ChannelTypeTeams, "https://contoso.webhook.office.com/webhookb2/a@b/IncomingWebhook/c/d", "https://contoso.webhook.office.com/****"},
+		{ChannelTypeSlack, "not a url", "****"},
+		{ChannelTypeSlack, "", ""},
+		{ChannelTypeWebhook, "https://example.com/hooks/alerts", "https://example.com/hooks/alerts"},
+	}
+
+	for _, tt := range tests {
+		channel := &repository.NotificationChannel{
+			Type:     tt.channelType,
+			Settings: repository.NotificationChannelSettings{URL: tt.url},
+		}
+		if got := MaskChannelURL(channel); got != tt.want {
+			t.Errorf("MaskChannelURL(%s %q) = %q, want %q", tt.channelType, tt.url, got, tt.want)
+		}
+	}
+}
+
+// fakeChannelRepo stores notification channels in memory
+type fakeChannelRepo struct {
+	channels map[uuid.UUID]*repository.NotificationChannel
+}
+
+func (r *fakeChannelRepo) Create(ctx context.Context, channel *repository.NotificationChannel) error {
+	channel.ID = uuid.New()
+	r.channels[channel.ID] = channel
+	return nil
+}
+
+func (r *fakeChannelRepo) GetByID(ctx context.Context, id uuid.UUID) (*repository.NotificationChannel, error) {
+	channel, ok := r.channels[id]
+	if !ok {
+		return nil, fmt.Errorf("notification channel not found")
+	}
+	copied := *channel
+	return &copied, nil
+}
+
+func (r *fakeChannelRepo) List(ctx context.Context) ([]*repository.NotificationChannel, error) {
+	return nil, nil
+}
+
+func (r *fakeChannelRepo) ListEnabled(ctx context.Context) ([]*repository.NotificationChannel, error) {
+	return nil, nil
+}
+
+func (r *fakeChannelRepo) Update(ctx context.Context, channel *repository.NotificationChannel) error {
+	r.channels[channel.ID] = channel
+	return nil
+}
+
+func (r *fakeChannelRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	delete(r.channels, id)
+	return nil
+}
+
+// discardAuditRepo drops audit logs
+type discardAuditRepo struct {
+	repository.AuditLogRepository
+}
+
+func (discardAuditRepo) Create(ctx context.Context, log *repository.AuditLog) error {
+	return nil
+}
+
+func TestUpdateChannelKeepsMaskedURL(t *testing.T) {
+	ctx := context.Background()
+	stored := "https://hooks.slack.com/services/T000/B000/XXXXXXXX"
+	channelRepo := &fakeChannelRepo{channels: map[uuid.UUID]*repository.NotificationChannel{}}
+	s := NewNotificationService(channelRepo, &fakeNotificationRepo{}, discardAuditRepo{}, noSuppression{},
+		logger.NewLogger(), "PRODDB", 0, 10, 1, time.Millisecond, time.Millisecond)
+
+	channel := &repository.NotificationChannel{
+		Name:        "DBA Slack",
+		Type:        ChannelTypeSlack,
+		Settings:    repository.NotificationChannelSettings{URL: stored},
+		MinSeverity: "INFO",
+	}
+	if err := s.CreateChannel(ctx, uuid.New(), channel); err != nil {
+		t.Fatalf("CreateChannel() error = %v", err)
+	}
+
+	update := *channel
+	update.Settings.URL = MaskChannelURL(channel)
+	if err := s.UpdateChannel(ctx, uuid.New(), &update); err != nil {
+		t.Fatalf("UpdateChannel() error = %v", err)
+	}
+	if got := channelRepo.channels[channel.ID].Settings.URL; got != stored {
+		t.Errorf("URL after update with masked value = %q, want %q", got, stored)
+	}
+
+	replaced := "https://hooks.slack.com/services/T111/B111/YYYYYYYY"
+	update.Settings.URL = replaced
+	if err := s.UpdateChannel(ctx, uuid.New(), &update); err != nil {
+		t.Fatalf("UpdateChannel() error = %v", err)
+	}
+	if got := channelRepo.channels[channel.ID].Settings.URL; got != replaced {
+		t.Errorf("URL after update with new value = %q, want %q", got, replaced)
+	}
+}
@@ -3,7 +3,12 @@ package service
 import (
 	"context"
 	"database/sql"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"math"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -59,11 +64,57 @@ type OracleSession struct {
 	WaitClass       *string
 	Event           *string
 	SecondsInWait   *int
+	InstID          int // RAC instance the session runs on
+	ConID           int // container (PDB) the session is connected to
+}
+
+// clusterQuery picks the gv$ form of a monitoring query when the database is
+// monitored as a RAC cluster
+func (s *OracleService) clusterQuery(local, cluster string) string {
+	if s.oracleDB.Cluster {
+		return cluster
+	}
+	return local
+}
+
+// clusterArgs appends the instance bound by the gv$ form of a per-instance
+// query; the v$ form only sees the connected instance and takes no instance
+func (s *OracleService) clusterArgs(instID interface{}, args ...interface{}) []interface{} {
+	if s.oracleDB.Cluster {
+		return append(args, instID)
+	}
+	return args
+}
+
+// instanceBind binds an optional instance number; the gv$ queries taking it
+// default to the connected instance
+func instanceBind(instID *int) sql.NullInt64 {
+	if instID == nil {
+		return sql.NullInt64{}
+	}
+	return sql.NullInt64{Int64: int64(*instID), Valid: true}
+}
+
+// pdbBind binds an optional PDB name for queries scoped with
+// "(:n IS NULL OR con_id = CON_NAME_TO_ID(:n))"; empty matches every container
+func pdbBind(pdb string) sql.NullString {
+	return sql.NullString{String: strings.ToUpper(pdb), Valid: pdb != ""}
+}
+
+// connectedPDB names the container the monitoring connection runs in, or is
+// empty on a non-CDB. Actions and background snapshots only see objects of
+// that container, so the cdb_ views they read are scoped to it.
+func (s *OracleService) connectedPDB(ctx context.Context) (string, error) {
+	var pdb sql.NullString
+	if err := s.oracleDB.DB.QueryRowContext(ctx, oracle.QueryConnectedPDB).Scan(&pdb); err != nil {
+		return "", fmt.Errorf("failed to query connected container: %w", err)
+	}
+	return pdb.String, nil
 }
 
 // GetActiveSessions retrieves all active Oracle sessions
 func (s *OracleService) GetActiveSessions(ctx context.Context, userID uuid.UUID) ([]*OracleSession, error) {
-	rows, err := s.oracleDB.DB.QueryContext(ctx, oracle.QueryActiveSessions)
+	rows, err := s.oracleDB.DB.QueryContext(ctx, s.clusterQuery(oracle.QueryActiveSessions, oracle.QueryClusterActiveSessions))
 	if err != nil {
 		s.auditQueryFailure(ctx, userID, "GET_ACTIVE_SESSIONS", err)
 		return nil, fmt.Errorf("failed to query active sessions: %w", err)
@@ -90,6 +141,8 @@ func (s *OracleService) GetActiveSessions(ctx context.Context, userID uuid.UUID)
 			&session.WaitClass,
 			&session.Event,
 			&session.SecondsInWait,
+			&session.InstID,
+			&session.ConID,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan session: %w", err)
@@ -103,7 +156,7 @@ func (s *OracleService) GetActiveSessions(ctx context.Context, userID uuid.UUID)
 
 // GetAllSessions retrieves all Oracle sessions
 func (s *OracleService) GetAllSessions(ctx context.Context, userID uuid.UUID) ([]*OracleSession, error) {
-	rows, err := s.oracleDB.DB.QueryContext(ctx, oracle.QueryAllSessions)
+	rows, err := s.oracleDB.DB.QueryContext(ctx, s.clusterQuery(oracle.QueryAllSessions, oracle.QueryClusterAllSessions))
 	if err != nil {
 		s.auditQueryFailure(ctx, userID, "GET_ALL_SESSIONS", err)
 		return nil, fmt.Errorf("failed to query all sessions: %w", err)
@@ -130,6 +183,8 @@ func (s *OracleService) GetAllSessions(ctx context.Context, userID uuid.UUID) ([
 			&session.WaitClass,
 			&session.Event,
 			&session.SecondsInWait,
+			&session.InstID,
+			&session.ConID,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan session: %w", err)
@@ -143,7 +198,7 @@ func (s *OracleService) GetAllSessions(ctx context.Context, userID uuid.UUID) ([
 
 // GetSessionsBySchema retrieves sessions for a specific schema
 func (s *OracleService) GetSessionsBySchema(ctx context.Context, userID uuid.UUID, schemaName string) ([]*OracleSession, error) {
-	rows, err := s.oracleDB.DB.QueryContext(ctx, oracle.QuerySessionsBySchema, schemaName)
+	rows, err := s.oracleDB.DB.QueryContext(ctx, s.clusterQuery(oracle.QuerySessionsBySchema, oracle.QueryClusterSessionsBySchema), schemaName)
 	if err != nil {
 		s.auditQueryFailure(ctx, userID, "GET_SESSIONS_BY_SCHEMA", err)
 		return nil, fmt.Errorf("failed to query sessions by schema: %w", err)
@@ -170,6 +225,8 @@ func (s *OracleService) GetSessionsBySchema(ctx context.Context, userID uuid.UUI
 			&session.WaitClass,
 			&session.Event,
 			&session.SecondsInWait,
+			&session.InstID,
+			&session.ConID,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan session: %w", err)
@@ -181,296 +238,4548 @@ func (s *OracleService) GetSessionsBySchema(ctx context.Context, userID uuid.UUI
 	return sessions, nil
 }
 
-// ============================================================================
-// BLOCKING SESSIONS
-// ============================================================================
-
-// BlockingSession represents a blocking session relationship
-type BlockingSession struct {
-	BlockingSID           int
-	BlockingSerial        int
-	BlockingUser          *string
-	BlockingSchema        *string
-	BlockingStatus        string
-	BlockingSQLID         *string
-	BlockingSQLText       *string
-	BlockedSID            int
-	BlockedSerial         int
-	BlockedUser           *string
-	BlockedSchema         *string
-	BlockedWaitClass      *string
-	BlockedEvent          *string
-	BlockedDurationSeconds int
-	BlockedSQLText        *string
+// countActiveSessions counts active user sessions without auditing (for background use)
+func (s *OracleService) countActiveSessions(ctx context.Context) (int, error) {
+	var count int
+	if err := s.oracleDB.DB.QueryRowContext(ctx, s.clusterQuery(oracle.QueryActiveSessionCount, oracle.QueryClusterActiveSessionCount)).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count active sessions: %w", err)
+	}
+	return count, nil
 }
 
-// GetBlockingSessions retrieves all blocking session relationships
-func (s *OracleService) GetBlockingSessions(ctx context.Context, userID uuid.UUID) ([]*BlockingSession, error) {
-	rows, err := s.oracleDB.DB.QueryContext(ctx, oracle.QueryBlockingSessions)
+// fetchASHSample captures the currently active sessions for the ASH sampler
+func (s *OracleService) fetchASHSample(ctx context.Context) ([]*repository.ASHSample, error) {
+	rows, err := s.oracleDB.DB.QueryContext(ctx, s.clusterQuery(oracle.QueryASHSample, oracle.QueryClusterASHSample))
 	if err != nil {
-		s.auditQueryFailure(ctx, userID, "GET_BLOCKING_SESSIONS", err)
-		return nil, fmt.Errorf("failed to query blocking sessions: %w", err)
+		return nil, fmt.Errorf("failed to sample active sessions: %w", err)
 	}
 	defer rows.Close()
 
-	blockingSessions := []*BlockingSession{}
+	sampleTime := time.Now()
+	samples := []*repository.ASHSample{}
 	for rows.Next() {
-		bs := &BlockingSession{}
+		sample := &repository.ASHSample{SampleTime: sampleTime}
+		var waitClass, event sql.NullString
 		err := rows.Scan(
-			&bs.BlockingSID,
-			&bs.BlockingSerial,
-			&bs.BlockingUser,
-			&bs.BlockingSchema,
-			&bs.BlockingStatus,
-			&bs.BlockingSQLID,
-			&bs.BlockingSQLText,
-			&bs.BlockedSID,
-			&bs.BlockedSerial,
-			&bs.BlockedUser,
-			&bs.BlockedSchema,
-			&bs.BlockedWaitClass,
-			&bs.BlockedEvent,
-			&bs.BlockedDurationSeconds,
-			&bs.BlockedSQLText,
+			&sample.SID,
+			&sample.Serial,
+			&sample.Username,
+			&sample.SQLID,
+			&waitClass,
+			&event,
+			&sample.Module,
+			&sample.Program,
+			&sample.Machine,
+			&sample.BlockingSession,
+			&sample.InstID,
 		)
 		if err != nil {
-			return nil, fmt.Errorf("failed to scan blocking session: %w", err)
+			return nil, fmt.Errorf("failed to scan session sample: %w", err)
 		}
-		blockingSessions = append(blockingSessions, bs)
+		sample.WaitClass = waitClass.String
+		sample.Event = event.String
+		samples = append(samples, sample)
 	}
 
-	s.auditQuerySuccess(ctx, userID, "GET_BLOCKING_SESSIONS", len(blockingSessions))
-	return blockingSessions, nil
+	return samples, nil
 }
 
-// ============================================================================
-// TABLESPACE MONITORING
-// ============================================================================
+// SessionListOptions selects one page of sessions. Cursor continues from the
+// NextCursor of a previous page and cannot be combined with Offset.
+type SessionListOptions struct {
+	Filter     oracle.SessionFilter
+	SortBy     string
+	Descending bool
+	Limit      int
+	Offset     int
+	Cursor     string
+}
 
-// Tablespace represents Oracle tablespace information
-type Tablespace struct {
-	Name            string
-	TotalSizeMB     float64
-	UsedSizeMB      float64
-	FreeSizeMB      float64
-	UsagePercentage float64
-	Status          string
-	Contents        string
-	DatafileCount   int
+// SessionPage is one page of a session listing
+type SessionPage struct {
+	Sessions   []*OracleSession
+	TotalCount int
+	NextCursor *string
+}
+
+// Session listing page size limits
+const (
+	DefaultSessionPageSize = 100
+	MaxSessionPageSize     = 1000
+)
+
+// sessionCursor is the opaque keyset position handed to clients
+type sessionCursor struct {
+	SortBy     string      `json:"s"`
+	Descending bool        `json:"d"`
+	Value      interface{} `json:"v"`
+	SID        int         `json:"i"`
+	InstID     int         `json:"r,omitempty"`
+	Serial     int         `json:"n"`
 }
 
-// GetTablespaces retrieves all tablespace information
-func (s *OracleService) GetTablespaces(ctx context.Context, userID uuid.UUID) ([]*Tablespace, error) {
-	rows, err := s.oracleDB.DB.QueryContext(ctx, oracle.QueryTablespaces)
+// ListSessions retrieves one page of sessions, filtered and sorted in Oracle
+func (s *OracleService) ListSessions(ctx context.Context, userID uuid.UUID, opts SessionListOptions) (*SessionPage, error) {
+	query := oracle.SessionQuery{
+		Filter:     opts.Filter,
+		SortBy:     opts.SortBy,
+		Descending: opts.Descending,
+		Offset:     opts.Offset,
+		Limit:      opts.Limit,
+		Cluster:    s.oracleDB.Cluster,
+	}
+	if query.Limit <= 0 {
+		query.Limit = DefaultSessionPageSize
+	}
+	if query.Limit > MaxSessionPageSize {
+		return nil, fmt.Errorf("limit must not exceed %d", MaxSessionPageSize)
+	}
+	if query.Offset < 0 {
+		return nil, fmt.Errorf("offset must not be negative")
+	}
+	if opts.Cursor != "" {
+		if query.Offset > 0 {
+			return nil, fmt.Errorf("cursor and offset cannot be combined")
+		}
+		after, err := decodeSessionCursor(opts.Cursor, opts.SortBy, opts.Descending)
+		if err != nil {
+			return nil, err
+		}
+		query.After = after
+	}
+
+	// One extra row tells whether another page follows
+	query.Limit++
+	listQuery, args, err := query.Build()
 	if err != nil {
-		s.auditQueryFailure(ctx, userID, "GET_TABLESPACES", err)
-		return nil, fmt.Errorf("failed to query tablespaces: %w", err)
+		return nil, err
+	}
+	query.Limit--
+
+	rows, err := s.oracleDB.DB.QueryContext(ctx, listQuery, args...)
+	if err != nil {
+		s.auditQueryFailure(ctx, userID, "LIST_SESSIONS", err)
+		return nil, fmt.Errorf("failed to query sessions: %w", err)
 	}
 	defer rows.Close()
 
-	tablespaces := []*Tablespace{}
+	sessions := []*OracleSession{}
 	for rows.Next() {
-		ts := &Tablespace{}
+		session := &OracleSession{}
 		err := rows.Scan(
-			&ts.Name,
-			&ts.TotalSizeMB,
-			&ts.UsedSizeMB,
-			&ts.FreeSizeMB,
-			&ts.UsagePercentage,
-			&ts.Status,
-			&ts.Contents,
-			&ts.DatafileCount,
+			&session.SID,
+			&session.Serial,
+			&session.Username,
+			&session.SchemaName,
+			&session.OSUser,
+			&session.Machine,
+			&session.Program,
+			&session.Status,
+			&session.SQLID,
+			&session.SQLText,
+			&session.LogonTime,
+			&session.LastCallET,
+			&session.BlockingSession,
+			&session.WaitClass,
+			&session.Event,
+			&session.SecondsInWait,
+			&session.InstID,
+			&session.ConID,
 		)
 		if err != nil {
-			return nil, fmt.Errorf("failed to scan tablespace: %w", err)
+			return nil, fmt.Errorf("failed to scan session: %w", err)
 		}
-		tablespaces = append(tablespaces, ts)
+		sessions = append(sessions, session)
 	}
 
-	s.auditQuerySuccess(ctx, userID, "GET_TABLESPACES", len(tablespaces))
-	return tablespaces, nil
-}
+	page := &SessionPage{Sessions: sessions}
+	if len(sessions) > query.Limit {
+		page.Sessions = sessions[:query.Limit]
+		cursor, err := encodeSessionCursor(page.Sessions[query.Limit-1], opts.SortBy, opts.Descending)
+		if err != nil {
+			return nil, err
+		}
+		page.NextCursor = &cursor
+	}
 
-// ============================================================================
-// SQL PERFORMANCE MONITORING
-// ============================================================================
+	countQuery, countArgs := query.BuildCount()
+	if err := s.oracleDB.DB.QueryRowContext(ctx, countQuery, countArgs...).Scan(&page.TotalCount); err != nil {
+		s.auditQueryFailure(ctx, userID, "LIST_SESSIONS", err)
+		return nil, fmt.Errorf("failed to count sessions: %w", err)
+	}
 
-// SQLPerformance represents SQL query performance metrics
-type SQLPerformance struct {
-	SQLID            string
-	SQLText          *string
-	ParsingSchema    *string
-	Executions       int
-	ElapsedSeconds   float64
-	AvgElapsedSeconds float64
-	CPUSeconds       float64
-	DiskReads        int
-	BufferGets       int
-	RowsProcessed    int
-	FirstLoadTime    *time.Time
-	LastActiveTime   *time.Time
+	s.auditQuerySuccess(ctx, userID, "LIST_SESSIONS", len(page.Sessions))
+	return page, nil
 }
 
-// GetTopSQLByElapsedTime retrieves top SQL by elapsed time
-func (s *OracleService) GetTopSQLByElapsedTime(ctx context.Context, userID uuid.UUID, limit int) ([]*SQLPerformance, error) {
-	rows, err := s.oracleDB.DB.QueryContext(ctx, oracle.QueryTopSQLByElapsedTime, limit)
-	if err != nil {
-		s.auditQueryFailure(ctx, userID, "GET_TOP_SQL_BY_ELAPSED", err)
-		return nil, fmt.Errorf("failed to query top SQL by elapsed time: %w", err)
-	}
-	defer rows.Close()
+func encodeSessionCursor(last *OracleSession, sortBy string, descending bool) (string, error) {
+	cursor := sessionCursor{SortBy: sortBy, Descending: descending, SID: last.SID, InstID: last.InstID, Serial: last.Serial}
 
-	sqlPerf := []*SQLPerformance{}
-	for rows.Next() {
-		sp := &SQLPerformance{}
-		err := rows.Scan(
-			&sp.SQLID,
-			&sp.SQLText,
-			&sp.ParsingSchema,
-			&sp.Executions,
-			&sp.ElapsedSeconds,
-			&sp.AvgElapsedSeconds,
-			&sp.CPUSeconds,
-			&sp.DiskReads,
-			&sp.BufferGets,
-			&sp.RowsProcessed,
-			&sp.FirstLoadTime,
-			&sp.LastActiveTime,
-		)
-		if err != nil {
-			return nil, fmt.Errorf("failed to scan SQL performance: %w", err)
+	// Mirror the NVL'd sort expressions of the listing query
+	nvl := func(value *string) string {
+		if value == nil {
+			return " "
 		}
-		sqlPerf = append(sqlPerf, sp)
+		return *value
+	}
+	switch sortBy {
+	case oracle.SessionSortSID:
+		cursor.Value = last.SID
+	case oracle.SessionSortLogonTime:
+		if last.LogonTime == nil {
+			return "", fmt.Errorf("session %d has no logon time", last.SID)
+		}
+		cursor.Value = last.LogonTime.Format(time.RFC3339Nano)
+	case oracle.SessionSortIdleTime:
+		cursor.Value = last.LastCallET
+	case oracle.SessionSortUsername:
+		cursor.Value = nvl(last.Username)
+	case oracle.SessionSortMachine:
+		cursor.Value = nvl(last.Machine)
+	case oracle.SessionSortProgram:
+		cursor.Value = nvl(last.Program)
 	}
 
-	s.auditQuerySuccess(ctx, userID, "GET_TOP_SQL_BY_ELAPSED", len(sqlPerf))
-	return sqlPerf, nil
+	data, err := json.Marshal(cursor)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode cursor: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
 }
 
-// GetTopSQLByCPU retrieves top SQL by CPU time
-func (s *OracleService) GetTopSQLByCPU(ctx context.Context, userID uuid.UUID, limit int) ([]*SQLPerformance, error) {
-	rows, err := s.oracleDB.DB.QueryContext(ctx, oracle.QueryTopSQLByCPU, limit)
+func decodeSessionCursor(encoded, sortBy string, descending bool) (*oracle.SessionKey, error) {
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
 	if err != nil {
-		s.auditQueryFailure(ctx, userID, "GET_TOP_SQL_BY_CPU", err)
-		return nil, fmt.Errorf("failed to query top SQL by CPU: %w", err)
+		return nil, fmt.Errorf("invalid cursor")
+	}
+	var cursor sessionCursor
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return nil, fmt.Errorf("invalid cursor")
+	}
+	if cursor.SortBy != sortBy || cursor.Descending != descending {
+		return nil, fmt.Errorf("cursor was issued for a different sort order")
 	}
-	defer rows.Close()
 
-	sqlPerf := []*SQLPerformance{}
-	for rows.Next() {
-		sp := &SQLPerformance{}
-		err := rows.Scan(
-			&sp.SQLID,
-			&sp.SQLText,
-			&sp.ParsingSchema,
-			&sp.Executions,
-			&sp.CPUSeconds,
-			&sp.AvgElapsedSeconds,
-			&sp.ElapsedSeconds,
-			&sp.DiskReads,
-			&sp.BufferGets,
-			&sp.RowsProcessed,
-		)
-		if err != nil {
-			return nil, fmt.Errorf("failed to scan SQL performance: %w", err)
+	key := &oracle.SessionKey{SID: cursor.SID, InstID: cursor.InstID, Serial: cursor.Serial}
+	switch value := cursor.Value.(type) {
+	case float64:
+		key.SortValue = int(value)
+	case string:
+		key.SortValue = value
+		if sortBy == oracle.SessionSortLogonTime {
+			logonTime, err := time.Parse(time.RFC3339Nano, value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid cursor")
+			}
+			key.SortValue = logonTime
 		}
-		sqlPerf = append(sqlPerf, sp)
+	default:
+		return nil, fmt.Errorf("invalid cursor")
 	}
+	return key, nil
+}
 
-	s.auditQuerySuccess(ctx, userID, "GET_TOP_SQL_BY_CPU", len(sqlPerf))
-	return sqlPerf, nil
+// SessionDetail is the drill-down view of one session
+type SessionDetail struct {
+	Session          *OracleSession
+	State            *string
+	ClientIdentifier *string
+	ClientInfo       *string
+	Module           *string
+	Action           *string
+	ServiceName      *string
+	Terminal         *string
+	OSProcessID      *string
+	SQLExecStart     *time.Time
+	CurrentSQL       *SessionSQL
+	PreviousSQL      *SessionSQL
+	Statistics       *SessionStatistics
+	OpenCursors      []*SessionCursor
+	Locks            []*SessionLock
+	WaitHistory      []*SessionWait
 }
 
-// ============================================================================
-// DATABASE HEALTH
-// ============================================================================
+// SessionSQL is a statement a session is running or last ran, with its full text
+type SessionSQL struct {
+	SQLID       string
+	ChildNumber *int
+	FullText    *string
+}
 
-// DatabaseInstance represents Oracle database instance information
-type DatabaseInstance struct {
-	InstanceName   string
-	HostName       string
-	Version        string
-	StartupTime    time.Time
-	Status         string
-	DatabaseStatus string
-	InstanceRole   string
-	UptimeDays     float64
+// SessionStatistics are cumulative v$sesstat values since logon
+type SessionStatistics struct {
+	CPUSeconds        float64
+	LogicalReads      int
+	PhysicalReads     int
+	PGAMemoryBytes    int
+	PGAMemoryMaxBytes int
+	OpenCursors       int
 }
 
-// GetDatabaseInstance retrieves database instance information
-func (s *OracleService) GetDatabaseInstance(ctx context.Context, userID uuid.UUID) (*DatabaseInstance, error) {
-	row := s.oracleDB.DB.QueryRowContext(ctx, oracle.QueryDatabaseInstance)
-
-	instance := &DatabaseInstance{}
-	err := row.Scan(
-		&instance.InstanceName,
-		&instance.HostName,
-		&instance.Version,
-		&instance.StartupTime,
-		&instance.Status,
-		&instance.DatabaseStatus,
-		&instance.InstanceRole,
-		&instance.UptimeDays,
-	)
+// SessionCursor is a cursor held open by a session
+type SessionCursor struct {
+	SQLID          *string
+	SQLText        *string
+	CursorType     *string
+	LastActiveTime *time.Time
+}
+
+// SessionLock is a lock held or requested by a session
+type SessionLock struct {
+	Type          string
+	Mode          string
+	RequestedMode string
+	ID1           int
+	ID2           int
+	HeldSeconds   int
+	Blocking      bool
+	ObjectOwner   *string
+	ObjectName    *string
+	ObjectType    *string
+}
+
+// SessionWait is one of the last waits of a session
+type SessionWait struct {
+	Sequence               int
+	Event                  string
+	WaitClass              *string
+	WaitTimeMicro          int
+	TimeSinceLastWaitMicro *int
+	Parameters             *string
+}
 
+// GetSessionDetail retrieves everything known about one session, or nil if
+// no session has that SID. On a RAC cluster instID names the instance the
+// session runs on; without it the connected instance is used.
+func (s *OracleService) GetSessionDetail(ctx context.Context, userID uuid.UUID, sid int, instID *int) (*SessionDetail, error) {
+	detail, err := s.fetchSessionDetail(ctx, sid, instID)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return nil, fmt.Errorf("no instance information found")
-		}
-		s.auditQueryFailure(ctx, userID, "GET_DATABASE_INSTANCE", err)
-		return nil, fmt.Errorf("failed to query database instance: %w", err)
+		s.auditQueryFailure(ctx, userID, "GET_SESSION_DETAIL", err)
+		return nil, err
 	}
 
-	s.auditQuerySuccess(ctx, userID, "GET_DATABASE_INSTANCE", 1)
-	return instance, nil
+	count := 0
+	if detail != nil {
+		count = 1
+	}
+	s.auditQuerySuccess(ctx, userID, "GET_SESSION_DETAIL", count)
+	return detail, nil
 }
 
-// ============================================================================
-// SCHEMA MONITORING
-// ============================================================================
+func (s *OracleService) fetchSessionDetail(ctx context.Context, sid int, instID *int) (*SessionDetail, error) {
+	session := &OracleSession{}
+	detail := &SessionDetail{Session: session}
+	var sqlChild, prevChild *int
+	var prevSQLID *string
 
-// SchemaInfo represents schema metadata
-type SchemaInfo struct {
-	SchemaName      string
-	TotalObjects    int
-	TableCount      int
-	IndexCount      int
-	ViewCount       int
-	ProcedureCount  int
-	FunctionCount   int
-	PackageCount    int
+	err := s.oracleDB.DB.QueryRowContext(ctx,
+		s.clusterQuery(oracle.QuerySessionDetail, oracle.QueryClusterSessionDetail),
+		s.clusterArgs(instanceBind(instID), sid)...,
+	).Scan(
+		&session.SID,
+		&session.Serial,
+		&session.Username,
+		&session.SchemaName,
+		&session.OSUser,
+		&session.Machine,
+		&session.Program,
+		&session.Status,
+		&session.SQLID,
+		&session.SQLText,
+		&session.LogonTime,
+		&session.LastCallET,
+		&session.BlockingSession,
+		&session.WaitClass,
+		&session.Event,
+		&session.SecondsInWait,
+		&detail.State,
+		&detail.ClientIdentifier,
+		&detail.ClientInfo,
+		&detail.Module,
+		&detail.Action,
+		&detail.ServiceName,
+		&detail.Terminal,
+		&detail.OSProcessID,
+		&sqlChild,
+		&detail.SQLExecStart,
+		&prevSQLID,
+		&prevChild,
+		&session.InstID,
+		&session.ConID,
+	)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query session %d: %w", sid, err)
+	}
+	// Outside cluster mode only the connected instance can be queried
+	if instID != nil && *instID != session.InstID {
+		return nil, nil
+	}
+
+	inst := session.InstID
+	if session.SQLID != nil {
+		if detail.CurrentSQL, err = s.fetchSessionSQL(ctx, *session.SQLID, sqlChild, inst); err != nil {
+			return nil, err
+		}
+	}
+	if prevSQLID != nil {
+		if detail.PreviousSQL, err = s.fetchSessionSQL(ctx, *prevSQLID, prevChild, inst); err != nil {
+			return nil, err
+		}
+	}
+
+	if detail.Statistics, err = s.fetchSessionStatistics(ctx, sid, inst); err != nil {
+		return nil, err
+	}
+	if detail.OpenCursors, err = s.fetchSessionOpenCursors(ctx, sid, inst); err != nil {
+		return nil, err
+	}
+	if detail.Locks, err = s.fetchSessionLocks(ctx, sid, inst); err != nil {
+		return nil, err
+	}
+	if detail.WaitHistory, err = s.fetchSessionWaitHistory(ctx, sid, inst); err != nil {
+		return nil, err
+	}
+
+	return detail, nil
 }
 
-// GetSchemas retrieves all schemas with object counts
-func (s *OracleService) GetSchemas(ctx context.Context, userID uuid.UUID) ([]*SchemaInfo, error) {
-	rows, err := s.oracleDB.DB.QueryContext(ctx, oracle.QuerySchemas)
+// fetchSessionSQL reassembles the full text of a statement from v$sqltext
+// of the session's instance
+func (s *OracleService) fetchSessionSQL(ctx context.Context, sqlID string, childNumber *int, instID int) (*SessionSQL, error) {
+	rows, err := s.oracleDB.DB.QueryContext(ctx,
+		s.clusterQuery(oracle.QuerySQLFullText, oracle.QueryClusterSQLFullText), s.clusterArgs(instID, sqlID)...)
 	if err != nil {
-		s.auditQueryFailure(ctx, userID, "GET_SCHEMAS", err)
-		return nil, fmt.Errorf("failed to query schemas: %w", err)
+		return nil, fmt.Errorf("failed to query SQL text: %w", err)
 	}
 	defer rows.Close()
 
-	schemas := []*SchemaInfo{}
+	var text strings.Builder
+	var firstAddress []byte
 	for rows.Next() {
-		schema := &SchemaInfo{}
-		err := rows.Scan(
-			&schema.SchemaName,
-			&schema.TotalObjects,
+		var address []byte
+		var piece string
+		if err := rows.Scan(&address, &piece); err != nil {
+			return nil, fmt.Errorf("failed to scan SQL text: %w", err)
+		}
+		// Only use the pieces of one parent cursor
+		if firstAddress == nil {
+			firstAddress = address
+		} else if string(address) != string(firstAddress) {
+			break
+		}
+		text.WriteString(piece)
+	}
+
+	result := &SessionSQL{SQLID: sqlID, ChildNumber: childNumber}
+	if text.Len() > 0 {
+		fullText := text.String()
+		result.FullText = &fullText
+	}
+	return result, nil
+}
+
+func (s *OracleService) fetchSessionStatistics(ctx context.Context, sid, instID int) (*SessionStatistics, error) {
+	rows, err := s.oracleDB.DB.QueryContext(ctx,
+		s.clusterQuery(oracle.QuerySessionStats, oracle.QueryClusterSessionStats), s.clusterArgs(instID, sid)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query session statistics: %w", err)
+	}
+	defer rows.Close()
+
+	stats := &SessionStatistics{}
+	for rows.Next() {
+		var name string
+		var value float64
+		if err := rows.Scan(&name, &value); err != nil {
+			return nil, fmt.Errorf("failed to scan session statistic: %w", err)
+		}
+		switch name {
+		case "CPU used by this session":
+			// Reported in centiseconds
+			stats.CPUSeconds = value / 100
+		case "session logical reads":
+			stats.LogicalReads = int(value)
+		case "physical reads":
+			stats.PhysicalReads = int(value)
+		case "session pga memory":
+			stats.PGAMemoryBytes = int(value)
+		case "session pga memory max":
+			stats.PGAMemoryMaxBytes = int(value)
+		case "opened cursors current":
+			stats.OpenCursors = int(value)
+		}
+	}
+
+	return stats, nil
+}
+
+func (s *OracleService) fetchSessionOpenCursors(ctx context.Context, sid, instID int) ([]*SessionCursor, error) {
+	rows, err := s.oracleDB.DB.QueryContext(ctx,
+		s.clusterQuery(oracle.QuerySessionOpenCursors, oracle.QueryClusterSessionOpenCursors), s.clusterArgs(instID, sid)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query open cursors: %w", err)
+	}
+	defer rows.Close()
+
+	cursors := []*SessionCursor{}
+	for rows.Next() {
+		c := &SessionCursor{}
+		if err := rows.Scan(&c.SQLID, &c.SQLText, &c.CursorType, &c.LastActiveTime); err != nil {
+			return nil, fmt.Errorf("failed to scan open cursor: %w", err)
+		}
+		cursors = append(cursors, c)
+	}
+
+	return cursors, nil
+}
+
+func (s *OracleService) fetchSessionLocks(ctx context.Context, sid, instID int) ([]*SessionLock, error) {
+	rows, err := s.oracleDB.DB.QueryContext(ctx,
+		s.clusterQuery(oracle.QuerySessionLocks, oracle.QueryClusterSessionLocks), s.clusterArgs(instID, sid)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query session locks: %w", err)
+	}
+	defer rows.Close()
+
+	locks := []*SessionLock{}
+	for rows.Next() {
+		l := &SessionLock{}
+		var block int
+		err := rows.Scan(
+			&l.Type,
+			&l.Mode,
+			&l.RequestedMode,
+			&l.ID1,
+			&l.ID2,
+			&l.HeldSeconds,
+			&block,
+			&l.ObjectOwner,
+			&l.ObjectName,
+			&l.ObjectType,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan session lock: %w", err)
+		}
+		// 2 means potentially blocking another instance (RAC)
+		l.Blocking = block > 0
+		locks = append(locks, l)
+	}
+
+	return locks, nil
+}
+
+func (s *OracleService) fetchSessionWaitHistory(ctx context.Context, sid, instID int) ([]*SessionWait, error) {
+	rows, err := s.oracleDB.DB.QueryContext(ctx,
+		s.clusterQuery(oracle.QuerySessionWaitHistory, oracle.QueryClusterSessionWaitHistory), s.clusterArgs(instID, sid)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query wait history: %w", err)
+	}
+	defer rows.Close()
+
+	waits := []*SessionWait{}
+	for rows.Next() {
+		w := &SessionWait{}
+		var paramNames, paramValues [3]sql.NullString
+		err := rows.Scan(
+			&w.Sequence,
+			&w.Event,
+			&w.WaitClass,
+			&w.WaitTimeMicro,
+			&w.TimeSinceLastWaitMicro,
+			&paramNames[0], &paramValues[0],
+			&paramNames[1], &paramValues[1],
+			&paramNames[2], &paramValues[2],
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan wait history: %w", err)
+		}
+
+		params := []string{}
+		for i, name := range paramNames {
+			if name.String != "" {
+				params = append(params, name.String+"="+paramValues[i].String)
+			}
+		}
+		if len(params) > 0 {
+			joined := strings.Join(params, " ")
+			w.Parameters = &joined
+		}
+		waits = append(waits, w)
+	}
+
+	return waits, nil
+}
+
+// KillSession terminates a session with ALTER SYSTEM KILL SESSION. On a RAC
+// cluster instID names the instance the session runs on; without it Oracle
+// looks for the session on the connected instance.
+func (s *OracleService) KillSession(ctx context.Context, userID uuid.UUID, sid, serial int, instID *int) error {
+	if sid <= 0 || serial <= 0 {
+		return fmt.Errorf("sid and serial must be positive")
+	}
+
+	target := fmt.Sprintf("%d,%d", sid, serial)
+	if instID != nil {
+		if *instID <= 0 {
+			return fmt.Errorf("instance number must be positive")
+		}
+		target = fmt.Sprintf("%s,@%d", target, *instID)
+	}
+
+	// ALTER SYSTEM takes no bind variables; every part of target is an integer
+	_, err := s.oracleDB.DB.ExecContext(ctx, fmt.Sprintf("ALTER SYSTEM KILL SESSION '%s' IMMEDIATE", target))
+	if err != nil {
+		err = fmt.Errorf("failed to kill session %s: %w", target, err)
+	}
+	s.auditAction(ctx, userID, "KILL_SESSION", "ORACLE_SESSION", target, err)
+	return err
+}
+
+// ============================================================================
+// LONG-RUNNING OPERATIONS
+// ============================================================================
+
+// LongOperation is a v$session_longops entry (index builds, full scans, RMAN,
+// stats gathering...) with the session that owns it, if still connected
+type LongOperation struct {
+	SID              int
+	Serial           int
+	OpName           string
+	Target           *string
+	TargetDesc       *string
+	SoFar            int
+	TotalWork        int
+	Units            *string
+	PercentComplete  *float64
+	ElapsedSeconds   int
+	RemainingSeconds *int
+	StartTime        *time.Time
+	LastUpdateTime   *time.Time
+	Message          *string
+	SQLID            *string
+	InstID           int // RAC instance the operation runs on
+	Session          *OracleSession
+}
+
+// Completed reports whether the operation has done all of its work. Some
+// operations report no total work until they have sized it.
+func (op *LongOperation) Completed() bool {
+	return op.TotalWork > 0 && op.SoFar >= op.TotalWork
+}
+
+// GetLongOperations retrieves long-running operations, by default only those
+// still in progress, optionally only in one PDB
+func (s *OracleService) GetLongOperations(ctx context.Context, userID uuid.UUID, includeCompleted bool, pdb string) ([]*LongOperation, error) {
+	ops, err := s.queryLongOperations(ctx, s.clusterQuery(oracle.QueryLongOps, oracle.QueryClusterLongOps),
+		pdbBind(pdb), pdbBind(pdb))
+	if err != nil {
+		s.auditQueryFailure(ctx, userID, "GET_LONG_OPERATIONS", err)
+		return nil, err
+	}
+
+	if !includeCompleted {
+		inProgress := []*LongOperation{}
+		for _, op := range ops {
+			if !op.Completed() {
+				inProgress = append(inProgress, op)
+			}
+		}
+		ops = inProgress
+	}
+
+	s.auditQuerySuccess(ctx, userID, "GET_LONG_OPERATIONS", len(ops))
+	return ops, nil
+}
+
+// WatchLongOperations polls the long-running operations of a session and
+// sends each operation whenever its progress changes. The channel is closed
+// once the session has no operation left in progress, or when ctx ends. A
+// failed poll is audited and retried on the next tick. On a RAC cluster
+// instID names the session's instance, as for GetSessionDetail.
+func (s *OracleService) WatchLongOperations(ctx context.Context, userID uuid.UUID, sid, serial int, instID *int, interval time.Duration) (<-chan *LongOperation, error) {
+	ops, err := s.querySessionLongOperations(ctx, sid, serial, instID)
+	if err != nil {
+		s.auditQueryFailure(ctx, userID, "WATCH_LONG_OPERATIONS", err)
+		return nil, err
+	}
+	if len(ops) == 0 {
+		return nil, fmt.Errorf("session %d,%d has no long-running operations", sid, serial)
+	}
+	s.auditQuerySuccess(ctx, userID, "WATCH_LONG_OPERATIONS", len(ops))
+
+	updates := make(chan *LongOperation, len(ops))
+	go func() {
+		defer close(updates)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		// Last progress sent per operation, keyed by operation and start time
+		sent := map[string]int{}
+		failing := false
+		for {
+			inProgress := false
+			for i := len(ops) - 1; i >= 0; i-- {
+				op := ops[i]
+				// Operations of a disconnected session will never finish
+				if !op.Completed() && op.Session != nil {
+					inProgress = true
+				}
+
+				key := op.OpName
+				if op.StartTime != nil {
+					key += "@" + op.StartTime.String()
+				}
+				if progress, ok := sent[key]; ok && progress == op.SoFar {
+					continue
+				}
+				sent[key] = op.SoFar
+
+				select {
+				case updates <- op:
+				case <-ctx.Done():
+					return
+				}
+			}
+			if !inProgress {
+				return
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			polled, err := s.querySessionLongOperations(ctx, sid, serial, instID)
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				// Keep the last progress; a run of failures is audited once
+				if !failing {
+					s.auditQueryFailure(ctx, userID, "WATCH_LONG_OPERATIONS", err)
+				}
+				failing = true
+				continue
+			}
+			failing = false
+			ops = polled
+		}
+	}()
+
+	return updates, nil
+}
+
+func (s *OracleService) querySessionLongOperations(ctx context.Context, sid, serial int, instID *int) ([]*LongOperation, error) {
+	ops, err := s.queryLongOperations(ctx,
+		s.clusterQuery(oracle.QuerySessionLongOps, oracle.QueryClusterSessionLongOps),
+		s.clusterArgs(instanceBind(instID), sid, serial)...)
+	if err != nil || instID == nil {
+		return ops, err
+	}
+
+	// Outside cluster mode only the connected instance can be queried
+	onInstance := []*LongOperation{}
+	for _, op := range ops {
+		if op.InstID == *instID {
+			onInstance = append(onInstance, op)
+		}
+	}
+	return onInstance, nil
+}
+
+func (s *OracleService) queryLongOperations(ctx context.Context, query string, args ...interface{}) ([]*LongOperation, error) {
+	rows, err := s.oracleDB.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query long operations: %w", err)
+	}
+	defer rows.Close()
+
+	ops := []*LongOperation{}
+	for rows.Next() {
+		op := &LongOperation{}
+		session := &OracleSession{}
+		var sessionSID, lastCallET, conID sql.NullInt64
+		var status sql.NullString
+		err := rows.Scan(
+			&op.SID,
+			&op.Serial,
+			&op.OpName,
+			&op.Target,
+			&op.TargetDesc,
+			&op.SoFar,
+			&op.TotalWork,
+			&op.Units,
+			&op.PercentComplete,
+			&op.ElapsedSeconds,
+			&op.RemainingSeconds,
+			&op.StartTime,
+			&op.LastUpdateTime,
+			&op.Message,
+			&op.SQLID,
+			&sessionSID,
+			&session.Username,
+			&session.SchemaName,
+			&session.OSUser,
+			&session.Machine,
+			&session.Program,
+			&status,
+			&session.SQLID,
+			&session.SQLText,
+			&session.LogonTime,
+			&lastCallET,
+			&session.BlockingSession,
+			&session.WaitClass,
+			&session.Event,
+			&session.SecondsInWait,
+			&op.InstID,
+			&conID,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan long operation: %w", err)
+		}
+
+		if sessionSID.Valid {
+			session.SID = op.SID
+			session.Serial = op.Serial
+			session.Status = status.String
+			session.LastCallET = int(lastCallET.Int64)
+			session.InstID = op.InstID
+			session.ConID = int(conID.Int64)
+			op.Session = session
+		}
+		ops = append(ops, op)
+	}
+
+	return ops, nil
+}
+
+// ============================================================================
+// BLOCKING SESSIONS
+// ============================================================================
+
+// BlockingSession represents a blocking session relationship
+type BlockingSession struct {
+	BlockingSID           int
+	BlockingSerial        int
+	BlockingUser          *string
+	BlockingSchema        *string
+	BlockingStatus        string
+	BlockingSQLID         *string
+	BlockingSQLText       *string
+	BlockedSID            int
+	BlockedSerial         int
+	BlockedUser           *string
+	BlockedSchema         *string
+	BlockedWaitClass      *string
+	BlockedEvent          *string
+	BlockedDurationSeconds int
+	BlockedSQLText        *string
+	BlockingInstID        int
+	BlockedInstID         int
+}
+
+// GetBlockingSessions retrieves all blocking session relationships,
+// optionally only those blocking sessions of one PDB
+func (s *OracleService) GetBlockingSessions(ctx context.Context, userID uuid.UUID, pdb string) ([]*BlockingSession, error) {
+	blockingSessions, err := s.fetchBlockingSessions(ctx, pdb)
+	if err != nil {
+		s.auditQueryFailure(ctx, userID, "GET_BLOCKING_SESSIONS", err)
+		return nil, err
+	}
+
+	s.auditQuerySuccess(ctx, userID, "GET_BLOCKING_SESSIONS", len(blockingSessions))
+	return blockingSessions, nil
+}
+
+// fetchBlockingSessions queries blocking sessions without auditing (for background use)
+func (s *OracleService) fetchBlockingSessions(ctx context.Context, pdb string) ([]*BlockingSession, error) {
+	rows, err := s.oracleDB.DB.QueryContext(ctx, s.clusterQuery(oracle.QueryBlockingSessions, oracle.QueryClusterBlockingSessions),
+		pdbBind(pdb), pdbBind(pdb))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query blocking sessions: %w", err)
+	}
+	defer rows.Close()
+
+	blockingSessions := []*BlockingSession{}
+	for rows.Next() {
+		bs := &BlockingSession{}
+		err := rows.Scan(
+			&bs.BlockingSID,
+			&bs.BlockingSerial,
+			&bs.BlockingUser,
+			&bs.BlockingSchema,
+			&bs.BlockingStatus,
+			&bs.BlockingSQLID,
+			&bs.BlockingSQLText,
+			&bs.BlockedSID,
+			&bs.BlockedSerial,
+			&bs.BlockedUser,
+			&bs.BlockedSchema,
+			&bs.BlockedWaitClass,
+			&bs.BlockedEvent,
+			&bs.BlockedDurationSeconds,
+			&bs.BlockedSQLText,
+			&bs.BlockingInstID,
+			&bs.BlockedInstID,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan blocking session: %w", err)
+		}
+		blockingSessions = append(blockingSessions, bs)
+	}
+
+	return blockingSessions, nil
+}
+
+// ============================================================================
+// TABLESPACE MONITORING
+// ============================================================================
+
+// Tablespace represents Oracle tablespace information. UsagePercentage is
+// measured against the current file sizes, MaxUsagePercentage against the size
+// the files can autoextend to.
+type Tablespace struct {
+	Name               string
+	TotalSizeMB        float64
+	UsedSizeMB         float64
+	FreeSizeMB         float64
+	UsagePercentage    float64
+	MaxSizeMB          float64
+	MaxUsagePercentage float64
+	Autoextensible     bool
+	Status             string
+	Contents           string
+	DatafileCount      int
+	ConID              int
+	PDB                *string // nil for the CDB root and non-CDBs
+	Datafiles          []*Datafile
+}
+
+// Key identifies the tablespace across containers: its name, prefixed with
+// the PDB for tablespaces of a pluggable database
+func (ts *Tablespace) Key() string {
+	if ts.PDB != nil {
+		return *ts.PDB + "/" + ts.Name
+	}
+	return ts.Name
+}
+
+// Datafile is one data file or temp file of a tablespace
+type Datafile struct {
+	FileID         int
+	FileName       string
+	Tablespace     string
+	Status         string
+	SizeMB         float64
+	UsedMB         *float64 // unknown for temp files
+	Autoextensible bool
+	MaxSizeMB      float64
+	IncrementMB    float64
+	Temporary      bool
+	DiskGroup      *string // ASM disk group, nil for files outside ASM
+	ConID          int
+}
+
+// GetTablespaces retrieves all tablespace information with their data files,
+// only from the named PDB unless pdb is empty
+func (s *OracleService) GetTablespaces(ctx context.Context, userID uuid.UUID, pdb string) ([]*Tablespace, error) {
+	tablespaces, err := s.fetchTablespaces(ctx, pdb)
+	if err == nil {
+		err = s.attachDatafiles(ctx, tablespaces)
+	}
+	if err != nil {
+		s.auditQueryFailure(ctx, userID, "GET_TABLESPACES", err)
+		return nil, err
+	}
+
+	s.auditQuerySuccess(ctx, userID, "GET_TABLESPACES", len(tablespaces))
+	return tablespaces, nil
+}
+
+// GetTablespace retrieves one tablespace with its data files; nil if it does
+// not exist. Without a PDB every container is searched and the fullest
+// tablespace of that name is returned.
+func (s *OracleService) GetTablespace(ctx context.Context, userID uuid.UUID, name, pdb string) (*Tablespace, error) {
+	tablespaces, err := s.fetchTablespaces(ctx, pdb)
+	if err != nil {
+		s.auditQueryFailure(ctx, userID, "GET_TABLESPACE", err)
+		return nil, err
+	}
+
+	var tablespace *Tablespace
+	for _, ts := range tablespaces {
+		if strings.EqualFold(ts.Name, name) {
+			tablespace = ts
+			break
+		}
+	}
+
+	if tablespace != nil {
+		if err := s.attachDatafiles(ctx, []*Tablespace{tablespace}); err != nil {
+			s.auditQueryFailure(ctx, userID, "GET_TABLESPACE", err)
+			return nil, err
+		}
+	}
+
+	s.auditQuerySuccess(ctx, userID, "GET_TABLESPACE", len(tablespaces))
+	return tablespace, nil
+}
+
+// fetchTablespaces queries tablespace usage without auditing (for background
+// use); an empty pdb covers every container
+func (s *OracleService) fetchTablespaces(ctx context.Context, pdb string) ([]*Tablespace, error) {
+	rows, err := s.oracleDB.DB.QueryContext(ctx, oracle.QueryTablespaces, pdbBind(pdb), pdbBind(pdb))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query tablespaces: %w", err)
+	}
+	defer rows.Close()
+
+	tablespaces := []*Tablespace{}
+	for rows.Next() {
+		ts := &Tablespace{Datafiles: []*Datafile{}}
+		var autoextensible string
+		err := rows.Scan(
+			&ts.Name,
+			&ts.TotalSizeMB,
+			&ts.UsedSizeMB,
+			&ts.FreeSizeMB,
+			&ts.UsagePercentage,
+			&ts.MaxSizeMB,
+			&ts.MaxUsagePercentage,
+			&autoextensible,
+			&ts.Status,
+			&ts.Contents,
+			&ts.DatafileCount,
+			&ts.ConID,
+			&ts.PDB,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan tablespace: %w", err)
+		}
+		ts.Autoextensible = autoextensible == "YES"
+		tablespaces = append(tablespaces, ts)
+	}
+
+	return tablespaces, nil
+}
+
+// attachDatafiles fills in the data files of each tablespace
+func (s *OracleService) attachDatafiles(ctx context.Context, tablespaces []*Tablespace) error {
+	datafiles, err := s.fetchDatafiles(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Tablespace names are only unique within a container
+	type tablespaceKey struct {
+		conID int
+		name  string
+	}
+	byName := make(map[tablespaceKey]*Tablespace, len(tablespaces))
+	for _, ts := range tablespaces {
+		byName[tablespaceKey{ts.ConID, ts.Name}] = ts
+	}
+	for _, df := range datafiles {
+		if ts, ok := byName[tablespaceKey{df.ConID, df.Tablespace}]; ok {
+			ts.Datafiles = append(ts.Datafiles, df)
+		}
+	}
+
+	return nil
+}
+
+func (s *OracleService) fetchDatafiles(ctx context.Context) ([]*Datafile, error) {
+	rows, err := s.oracleDB.DB.QueryContext(ctx, oracle.QueryDatafiles)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query datafiles: %w", err)
+	}
+	defer rows.Close()
+
+	datafiles := []*Datafile{}
+	for rows.Next() {
+		df := &Datafile{}
+		var autoextensible string
+		var temporary int
+		err := rows.Scan(
+			&df.FileID,
+			&df.FileName,
+			&df.Tablespace,
+			&df.Status,
+			&df.SizeMB,
+			&df.UsedMB,
+			&autoextensible,
+			&df.MaxSizeMB,
+			&df.IncrementMB,
+			&temporary,
+			&df.ConID,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan datafile: %w", err)
+		}
+		df.Autoextensible = autoextensible == "YES"
+		df.Temporary = temporary == 1
+		df.DiskGroup = asmDiskGroupOf(df.FileName)
+		datafiles = append(datafiles, df)
+	}
+
+	return datafiles, nil
+}
+
+// ============================================================================
+// SEGMENTS
+// ============================================================================
+
+// Segment is the space allocated to a table, index, LOB or other segment, or
+// to one partition of it
+type Segment struct {
+	Owner          string
+	SegmentName    string
+	PartitionName  *string
+	SegmentType    string
+	TablespaceName string
+	SizeMB         float64
+	Extents        int
+	Blocks         int
+	ConID          int
+}
+
+// GetSegments retrieves the largest segments, optionally only those in one
+// PDB, in one tablespace or of one owner
+func (s *OracleService) GetSegments(ctx context.Context, userID uuid.UUID, tablespace, owner string, limit int, pdb string) ([]*Segment, error) {
+	segments, err := s.fetchSegments(ctx, tablespace, owner, limit, pdb)
+	if err != nil {
+		s.auditQueryFailure(ctx, userID, "GET_SEGMENTS", err)
+		return nil, err
+	}
+
+	s.auditQuerySuccess(ctx, userID, "GET_SEGMENTS", len(segments))
+	return segments, nil
+}
+
+// fetchSegments queries the largest segments without auditing (for background use)
+func (s *OracleService) fetchSegments(ctx context.Context, tablespace, owner string, limit int, pdb string) ([]*Segment, error) {
+	tablespaceBind := sql.NullString{String: strings.ToUpper(tablespace), Valid: tablespace != ""}
+	ownerBind := sql.NullString{String: strings.ToUpper(owner), Valid: owner != ""}
+
+	rows, err := s.oracleDB.DB.QueryContext(ctx, oracle.QuerySegments,
+		pdbBind(pdb), pdbBind(pdb), tablespaceBind, tablespaceBind, ownerBind, ownerBind, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query segments: %w", err)
+	}
+	defer rows.Close()
+
+	segments := []*Segment{}
+	for rows.Next() {
+		seg := &Segment{}
+		err := rows.Scan(
+			&seg.Owner,
+			&seg.SegmentName,
+			&seg.PartitionName,
+			&seg.SegmentType,
+			&seg.TablespaceName,
+			&seg.SizeMB,
+			&seg.Extents,
+			&seg.Blocks,
+			&seg.ConID,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan segment: %w", err)
+		}
+		segments = append(segments, seg)
+	}
+
+	return segments, nil
+}
+
+// ============================================================================
+// ASM STORAGE
+// ============================================================================
+
+// ASMDiskGroup is the capacity of one ASM disk group. UsableFileMB is the
+// space still available to files once mirroring is accounted for.
+type ASMDiskGroup struct {
+	GroupNumber          int
+	Name                 string
+	State                string
+	Redundancy           string
+	TotalMB              float64
+	FreeMB               float64
+	UsableFileMB         float64
+	RequiredMirrorFreeMB float64
+	UsagePercentage      float64
+	OfflineDisks         int
+	Disks                []*ASMDisk
+	Tablespaces          []string // tablespaces with at least one file in the group
+}
+
+// ASMDisk is one disk of an ASM disk group
+type ASMDisk struct {
+	DiskNumber   int
+	Name         *string
+	Path         string
+	FailGroup    *string
+	MountStatus  string
+	HeaderStatus string
+	ModeStatus   string
+	State        string
+	TotalMB      float64
+	FreeMB       float64
+}
+
+// GetASMDiskGroups retrieves ASM disk groups with their disks and the
+// tablespaces stored in each
+func (s *OracleService) GetASMDiskGroups(ctx context.Context, userID uuid.UUID) ([]*ASMDiskGroup, error) {
+	groups, err := s.fetchASMDiskGroups(ctx)
+	if err == nil {
+		err = s.attachASMDetail(ctx, groups)
+	}
+	if err != nil {
+		s.auditQueryFailure(ctx, userID, "GET_ASM_DISKGROUPS", err)
+		return nil, err
+	}
+
+	s.auditQuerySuccess(ctx, userID, "GET_ASM_DISKGROUPS", len(groups))
+	return groups, nil
+}
+
+// fetchASMDiskGroups queries disk group capacity without auditing (for background use)
+func (s *OracleService) fetchASMDiskGroups(ctx context.Context) ([]*ASMDiskGroup, error) {
+	rows, err := s.oracleDB.DB.QueryContext(ctx, oracle.QueryASMDiskGroups)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query ASM disk groups: %w", err)
+	}
+	defer rows.Close()
+
+	groups := []*ASMDiskGroup{}
+	for rows.Next() {
+		g := &ASMDiskGroup{Disks: []*ASMDisk{}, Tablespaces: []string{}}
+		err := rows.Scan(
+			&g.GroupNumber,
+			&g.Name,
+			&g.State,
+			&g.Redundancy,
+			&g.TotalMB,
+			&g.FreeMB,
+			&g.UsableFileMB,
+			&g.RequiredMirrorFreeMB,
+			&g.UsagePercentage,
+			&g.OfflineDisks,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan ASM disk group: %w", err)
+		}
+		groups = append(groups, g)
+	}
+
+	return groups, nil
+}
+
+// attachASMDetail fills in the disks of each group and the tablespaces whose
+// files live in it
+func (s *OracleService) attachASMDetail(ctx context.Context, groups []*ASMDiskGroup) error {
+	if len(groups) == 0 {
+		return nil
+	}
+
+	byNumber := make(map[int]*ASMDiskGroup, len(groups))
+	byName := make(map[string]*ASMDiskGroup, len(groups))
+	for _, g := range groups {
+		byNumber[g.GroupNumber] = g
+		byName[strings.ToUpper(g.Name)] = g
+	}
+
+	rows, err := s.oracleDB.DB.QueryContext(ctx, oracle.QueryASMDisks)
+	if err != nil {
+		return fmt.Errorf("failed to query ASM disks: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		d := &ASMDisk{}
+		var groupNumber int
+		err := rows.Scan(
+			&groupNumber,
+			&d.DiskNumber,
+			&d.Name,
+			&d.Path,
+			&d.FailGroup,
+			&d.MountStatus,
+			&d.HeaderStatus,
+			&d.ModeStatus,
+			&d.State,
+			&d.TotalMB,
+			&d.FreeMB,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to scan ASM disk: %w", err)
+		}
+		if g, ok := byNumber[groupNumber]; ok {
+			g.Disks = append(g.Disks, d)
+		}
+	}
+
+	datafiles, err := s.fetchDatafiles(ctx)
+	if err != nil {
+		return err
+	}
+	for _, df := range datafiles {
+		if df.DiskGroup == nil {
+			continue
+		}
+		if g, ok := byName[strings.ToUpper(*df.DiskGroup)]; ok {
+			g.Tablespaces = append(g.Tablespaces, df.Tablespace)
+		}
+	}
+	for _, g := range groups {
+		slices.Sort(g.Tablespaces)
+		g.Tablespaces = slices.Compact(g.Tablespaces)
+	}
+
+	return nil
+}
+
+// asmDiskGroupOf returns the disk group of an ASM file name such as
+// +DATA/ORCL/DATAFILE/users.259.1122334455
+func asmDiskGroupOf(fileName string) *string {
+	if !strings.HasPrefix(fileName, "+") {
+		return nil
+	}
+	group, _, _ := strings.Cut(fileName[1:], "/")
+	return &group
+}
+
+// ============================================================================
+// UNDO & TEMP SPACE
+// ============================================================================
+
+// ORA-01555 (snapshot too old) risk levels
+const (
+	UndoRiskLow    = "LOW"
+	UndoRiskMedium = "MEDIUM"
+	UndoRiskHigh   = "HIGH"
+)
+
+// TempUsage is temporary space held by one session for one statement
+type TempUsage struct {
+	SID         int
+	Serial      int
+	Username    *string
+	SQLID       *string
+	SQLText     *string
+	Tablespace  string
+	SegmentType string
+	UsedMB      float64
+}
+
+// UndoStat is one 10-minute v$undostat bucket
+type UndoStat struct {
+	BeginTime                 time.Time
+	EndTime                   time.Time
+	UndoBlocks                int
+	TransactionCount          int
+	MaxQuerySeconds           int
+	MaxQuerySQLID             *string
+	TunedUndoRetentionSeconds int
+	ActiveBlocks              int
+	UnexpiredBlocks           int
+	ExpiredBlocks             int
+	UnexpiredStealCount       int
+	SnapshotTooOldCount       int
+	NoSpaceCount              int
+}
+
+// UndoSummary compares undo retention with the longest running queries over
+// a window and rates the risk of ORA-01555
+type UndoSummary struct {
+	UndoTablespace            *string
+	UndoRetentionSeconds      int
+	TunedUndoRetentionSeconds int
+	LongestQuerySeconds       int
+	LongestQuerySQLID         *string
+	SnapshotTooOldCount       int
+	NoSpaceCount              int
+	UnexpiredStealCount       int
+	Risk                      string
+	RiskReasons               []string
+	History                   []*UndoStat
+}
+
+// ActiveTransaction is an open transaction and the undo it holds
+type ActiveTransaction struct {
+	SID            int
+	Serial         int
+	Username       *string
+	Program        *string
+	Status         string
+	StartTime      *time.Time
+	ElapsedSeconds int
+	UndoBlocks     int
+	UndoRecords    int
+	UndoMB         float64
+	SQLID          *string
+}
+
+// GetTempUsage retrieves the largest temporary space consumers, optionally
+// only in one PDB
+func (s *OracleService) GetTempUsage(ctx context.Context, userID uuid.UUID, limit int, pdb string) ([]*TempUsage, error) {
+	rows, err := s.oracleDB.DB.QueryContext(ctx, oracle.QueryTempUsage, pdbBind(pdb), pdbBind(pdb), limit)
+	if err != nil {
+		s.auditQueryFailure(ctx, userID, "GET_TEMP_USAGE", err)
+		return nil, fmt.Errorf("failed to query temp usage: %w", err)
+	}
+	defer rows.Close()
+
+	usage := []*TempUsage{}
+	for rows.Next() {
+		u := &TempUsage{}
+		err := rows.Scan(
+			&u.SID,
+			&u.Serial,
+			&u.Username,
+			&u.SQLID,
+			&u.SQLText,
+			&u.Tablespace,
+			&u.SegmentType,
+			&u.UsedMB,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan temp usage: %w", err)
+		}
+		usage = append(usage, u)
+	}
+
+	s.auditQuerySuccess(ctx, userID, "GET_TEMP_USAGE", len(usage))
+	return usage, nil
+}
+
+// GetUndoSummary analyses undo statistics over the last N hours
+func (s *OracleService) GetUndoSummary(ctx context.Context, userID uuid.UUID, hours int) (*UndoSummary, error) {
+	summary, err := s.fetchUndoSummary(ctx, hours)
+	if err != nil {
+		s.auditQueryFailure(ctx, userID, "GET_UNDO_SUMMARY", err)
+		return nil, err
+	}
+
+	s.auditQuerySuccess(ctx, userID, "GET_UNDO_SUMMARY", len(summary.History))
+	return summary, nil
+}
+
+func (s *OracleService) fetchUndoSummary(ctx context.Context, hours int) (*UndoSummary, error) {
+	summary := &UndoSummary{RiskReasons: []string{}}
+
+	var retention sql.NullInt64
+	err := s.oracleDB.DB.QueryRowContext(ctx, oracle.QueryUndoParameters).Scan(&summary.UndoTablespace, &retention)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query undo parameters: %w", err)
+	}
+	summary.UndoRetentionSeconds = int(retention.Int64)
+
+	rows, err := s.oracleDB.DB.QueryContext(ctx, oracle.QueryUndoStat, hours)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query undo statistics: %w", err)
+	}
+	defer rows.Close()
+
+	summary.History = []*UndoStat{}
+	for rows.Next() {
+		u := &UndoStat{}
+		err := rows.Scan(
+			&u.BeginTime,
+			&u.EndTime,
+			&u.UndoBlocks,
+			&u.TransactionCount,
+			&u.MaxQuerySeconds,
+			&u.MaxQuerySQLID,
+			&u.TunedUndoRetentionSeconds,
+			&u.ActiveBlocks,
+			&u.UnexpiredBlocks,
+			&u.ExpiredBlocks,
+			&u.UnexpiredStealCount,
+			&u.SnapshotTooOldCount,
+			&u.NoSpaceCount,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan undo statistics: %w", err)
+		}
+		summary.History = append(summary.History, u)
+	}
+
+	for i, u := range summary.History {
+		// Buckets are most recent first
+		if i == 0 {
+			summary.TunedUndoRetentionSeconds = u.TunedUndoRetentionSeconds
+		}
+		if u.MaxQuerySeconds > summary.LongestQuerySeconds {
+			summary.LongestQuerySeconds = u.MaxQuerySeconds
+			summary.LongestQuerySQLID = u.MaxQuerySQLID
+		}
+		summary.SnapshotTooOldCount += u.SnapshotTooOldCount
+		summary.NoSpaceCount += u.NoSpaceCount
+		summary.UnexpiredStealCount += u.UnexpiredStealCount
+	}
+
+	assessUndoRisk(summary)
+	return summary, nil
+}
+
+// assessUndoRisk rates ORA-01555 risk: queries outliving the retention Oracle
+// can honour, or unexpired undo already being stolen, mean old read-consistent
+// images may be gone before a long query needs them
+func assessUndoRisk(summary *UndoSummary) {
+	retention := summary.TunedUndoRetentionSeconds
+	if retention == 0 {
+		retention = summary.UndoRetentionSeconds
+	}
+
+	summary.Risk = UndoRiskLow
+	raise := func(level, reason string) {
+		if level == UndoRiskHigh || summary.Risk == UndoRiskLow {
+			summary.Risk = level
+		}
+		summary.RiskReasons = append(summary.RiskReasons, reason)
+	}
+
+	if summary.SnapshotTooOldCount > 0 {
+		raise(UndoRiskHigh, fmt.Sprintf("%d ORA-01555 errors in the window", summary.SnapshotTooOldCount))
+	}
+	if retention > 0 && summary.LongestQuerySeconds > retention {
+		raise(UndoRiskHigh, fmt.Sprintf("longest query (%ds) exceeds undo retention (%ds)",
+			summary.LongestQuerySeconds, retention))
+	} else if retention > 0 && summary.LongestQuerySeconds*10 > retention*8 {
+		raise(UndoRiskMedium, fmt.Sprintf("longest query (%ds) is above 80%% of undo retention (%ds)",
+			summary.LongestQuerySeconds, retention))
+	}
+	if summary.UnexpiredStealCount > 0 {
+		raise(UndoRiskMedium, fmt.Sprintf("%d attempts to steal unexpired undo", summary.UnexpiredStealCount))
+	}
+	if summary.NoSpaceCount > 0 {
+		raise(UndoRiskMedium, fmt.Sprintf("%d undo space errors", summary.NoSpaceCount))
+	}
+}
+
+// GetActiveTransactions retrieves open transactions by undo consumed,
+// optionally only in one PDB
+func (s *OracleService) GetActiveTransactions(ctx context.Context, userID uuid.UUID, limit int, pdb string) ([]*ActiveTransaction, error) {
+	rows, err := s.oracleDB.DB.QueryContext(ctx, oracle.QueryActiveTransactions, pdbBind(pdb), pdbBind(pdb), limit)
+	if err != nil {
+		s.auditQueryFailure(ctx, userID, "GET_ACTIVE_TRANSACTIONS", err)
+		return nil, fmt.Errorf("failed to query active transactions: %w", err)
+	}
+	defer rows.Close()
+
+	transactions := []*ActiveTransaction{}
+	for rows.Next() {
+		t := &ActiveTransaction{}
+		err := rows.Scan(
+			&t.SID,
+			&t.Serial,
+			&t.Username,
+			&t.Program,
+			&t.Status,
+			&t.StartTime,
+			&t.ElapsedSeconds,
+			&t.UndoBlocks,
+			&t.UndoRecords,
+			&t.UndoMB,
+			&t.SQLID,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan active transaction: %w", err)
+		}
+		transactions = append(transactions, t)
+	}
+
+	s.auditQuerySuccess(ctx, userID, "GET_ACTIVE_TRANSACTIONS", len(transactions))
+	return transactions, nil
+}
+
+// ============================================================================
+// REDO & ARCHIVING
+// ============================================================================
+
+// DefaultMaxLogSwitchesPerHour is the switch rate above which redo logs are
+// considered undersized (one switch every 10 minutes)
+const DefaultMaxLogSwitchesPerHour = 6
+
+// RedoLogGroup is one online redo log group
+type RedoLogGroup struct {
+	GroupNumber int
+	Thread      int
+	Sequence    int64
+	SizeMB      float64
+	MemberCount int
+	Archived    bool
+	Status      string
+	FirstTime   *time.Time
+	Members     []*RedoLogMember
+}
+
+// RedoLogMember is one file of a redo log group
+type RedoLogMember struct {
+	Member string
+	Type   string
+	Status *string
+}
+
+// LogSwitchHour is the number of log switches (all threads) in one hour
+type LogSwitchHour struct {
+	Hour     time.Time
+	Switches int
+}
+
+// ArchiveDay is the archived redo generated on one day
+type ArchiveDay struct {
+	Day          time.Time
+	ArchivedLogs int
+	SizeMB       float64
+}
+
+// RedoSummary describes the online redo configuration, the log switch rate and
+// archive generation over a window, and flags undersized redo or an archiver
+// that is falling behind
+type RedoSummary struct {
+	LogMode              string
+	Groups               []*RedoLogGroup
+	SwitchesPerHour      []*LogSwitchHour
+	ArchivePerDay        []*ArchiveDay
+	MaxSwitchesPerHour   int
+	PeakSwitchesPerHour  int
+	HoursOverThreshold   int
+	Undersized           bool
+	PendingArchiveGroups int
+	ArchivingBehind      bool
+	Flags                []string
+}
+
+// GetRedoSummary retrieves redo log and archive activity over the last N days
+func (s *OracleService) GetRedoSummary(ctx context.Context, userID uuid.UUID, days, maxSwitchesPerHour int) (*RedoSummary, error) {
+	summary, err := s.fetchRedoSummary(ctx, days, maxSwitchesPerHour)
+	if err != nil {
+		s.auditQueryFailure(ctx, userID, "GET_REDO_SUMMARY", err)
+		return nil, err
+	}
+
+	s.auditQuerySuccess(ctx, userID, "GET_REDO_SUMMARY", len(summary.Groups))
+	return summary, nil
+}
+
+func (s *OracleService) fetchRedoSummary(ctx context.Context, days, maxSwitchesPerHour int) (*RedoSummary, error) {
+	summary := &RedoSummary{MaxSwitchesPerHour: maxSwitchesPerHour, Flags: []string{}}
+
+	err := s.oracleDB.DB.QueryRowContext(ctx, oracle.QueryLogMode).Scan(&summary.LogMode)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query log mode: %w", err)
+	}
+
+	if summary.Groups, err = s.fetchRedoLogGroups(ctx); err != nil {
+		return nil, err
+	}
+	if summary.SwitchesPerHour, err = s.fetchLogSwitchesPerHour(ctx, days); err != nil {
+		return nil, err
+	}
+	if summary.ArchivePerDay, err = s.fetchArchiveGenerationPerDay(ctx, days); err != nil {
+		return nil, err
+	}
+
+	for _, h := range summary.SwitchesPerHour {
+		summary.PeakSwitchesPerHour = max(summary.PeakSwitchesPerHour, h.Switches)
+		if h.Switches > maxSwitchesPerHour {
+			summary.HoursOverThreshold++
+		}
+	}
+	if summary.HoursOverThreshold > 0 {
+		summary.Undersized = true
+		summary.Flags = append(summary.Flags, fmt.Sprintf(
+			"%d hours with more than %d log switches (peak %d); redo logs may be undersized",
+			summary.HoursOverThreshold, maxSwitchesPerHour, summary.PeakSwitchesPerHour))
+	}
+
+	if summary.LogMode == "ARCHIVELOG" {
+		// A filled log is normally archived right after the switch; once every
+		// inactive group is waiting, the next switch stalls the database
+		waiting := 0
+		for _, g := range summary.Groups {
+			if g.Status == "CURRENT" {
+				continue
+			}
+			waiting++
+			if !g.Archived {
+				summary.PendingArchiveGroups++
+			}
+		}
+		if summary.PendingArchiveGroups > 1 || (summary.PendingArchiveGroups > 0 && summary.PendingArchiveGroups == waiting) {
+			summary.ArchivingBehind = true
+			summary.Flags = append(summary.Flags, fmt.Sprintf(
+				"%d of %d filled redo log groups are not yet archived", summary.PendingArchiveGroups, waiting))
+		}
+	}
+
+	return summary, nil
+}
+
+func (s *OracleService) fetchRedoLogGroups(ctx context.Context) ([]*RedoLogGroup, error) {
+	rows, err := s.oracleDB.DB.QueryContext(ctx, oracle.QueryRedoLogGroups)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query redo log groups: %w", err)
+	}
+	defer rows.Close()
+
+	groups := []*RedoLogGroup{}
+	byNumber := map[int]*RedoLogGroup{}
+	for rows.Next() {
+		g := &RedoLogGroup{Members: []*RedoLogMember{}}
+		var archived string
+		err := rows.Scan(
+			&g.GroupNumber,
+			&g.Thread,
+			&g.Sequence,
+			&g.SizeMB,
+			&g.MemberCount,
+			&archived,
+			&g.Status,
+			&g.FirstTime,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan redo log group: %w", err)
+		}
+		g.Archived = archived == "YES"
+		groups = append(groups, g)
+		byNumber[g.GroupNumber] = g
+	}
+	rows.Close()
+
+	rows, err = s.oracleDB.DB.QueryContext(ctx, oracle.QueryRedoLogFiles)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query redo log files: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		m := &RedoLogMember{}
+		var groupNumber int
+		if err := rows.Scan(&groupNumber, &m.Member, &m.Type, &m.Status); err != nil {
+			return nil, fmt.Errorf("failed to scan redo log file: %w", err)
+		}
+		// Standby redo logs have no v$log entry
+		if g, ok := byNumber[groupNumber]; ok {
+			g.Members = append(g.Members, m)
+		}
+	}
+
+	return groups, nil
+}
+
+func (s *OracleService) fetchLogSwitchesPerHour(ctx context.Context, days int) ([]*LogSwitchHour, error) {
+	rows, err := s.oracleDB.DB.QueryContext(ctx, oracle.QueryLogSwitchesPerHour, days)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query log switches: %w", err)
+	}
+	defer rows.Close()
+
+	hours := []*LogSwitchHour{}
+	for rows.Next() {
+		h := &LogSwitchHour{}
+		if err := rows.Scan(&h.Hour, &h.Switches); err != nil {
+			return nil, fmt.Errorf("failed to scan log switches: %w", err)
+		}
+		hours = append(hours, h)
+	}
+
+	return hours, nil
+}
+
+func (s *OracleService) fetchArchiveGenerationPerDay(ctx context.Context, days int) ([]*ArchiveDay, error) {
+	rows, err := s.oracleDB.DB.QueryContext(ctx, oracle.QueryArchiveGenerationPerDay, days)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query archive generation: %w", err)
+	}
+	defer rows.Close()
+
+	archiveDays := []*ArchiveDay{}
+	for rows.Next() {
+		d := &ArchiveDay{}
+		if err := rows.Scan(&d.Day, &d.ArchivedLogs, &d.SizeMB); err != nil {
+			return nil, fmt.Errorf("failed to scan archive generation: %w", err)
+		}
+		archiveDays = append(archiveDays, d)
+	}
+
+	return archiveDays, nil
+}
+
+// ============================================================================
+// RECOVERY AREA
+// ============================================================================
+
+// RecoveryArea is the space of the Fast Recovery Area. Reclaimable space is
+// held by files (obsolete backups, flashback logs past their target) that
+// Oracle deletes on demand, so only non-reclaimable usage can fill the area.
+type RecoveryArea struct {
+	Name                     string
+	SpaceLimitMB             float64
+	SpaceUsedMB              float64
+	SpaceReclaimableMB       float64
+	NonReclaimableMB         float64
+	UsagePercentage          float64
+	NonReclaimablePercentage float64
+	FileCount                int
+	FileTypes                []*RecoveryAreaFileType
+}
+
+// RecoveryAreaFileType is the recovery area space used by one file type
+type RecoveryAreaFileType struct {
+	FileType                string
+	PercentSpaceUsed        float64
+	PercentSpaceReclaimable float64
+	UsedMB                  float64
+	ReclaimableMB           float64
+	FileCount               int
+}
+
+// GetRecoveryArea retrieves Fast Recovery Area usage; nil when none is configured
+func (s *OracleService) GetRecoveryArea(ctx context.Context, userID uuid.UUID) (*RecoveryArea, error) {
+	area, err := s.fetchRecoveryArea(ctx)
+	if err == nil && area != nil {
+		err = s.attachRecoveryAreaUsage(ctx, area)
+	}
+	if err != nil {
+		s.auditQueryFailure(ctx, userID, "GET_RECOVERY_AREA", err)
+		return nil, err
+	}
+
+	count := 0
+	if area != nil {
+		count = 1
+	}
+	s.auditQuerySuccess(ctx, userID, "GET_RECOVERY_AREA", count)
+	return area, nil
+}
+
+// fetchRecoveryArea queries recovery area totals without auditing (for background use)
+func (s *OracleService) fetchRecoveryArea(ctx context.Context) (*RecoveryArea, error) {
+	area := &RecoveryArea{FileTypes: []*RecoveryAreaFileType{}}
+	err := s.oracleDB.DB.QueryRowContext(ctx, oracle.QueryRecoveryFileDest).Scan(
+		&area.Name,
+		&area.SpaceLimitMB,
+		&area.SpaceUsedMB,
+		&area.SpaceReclaimableMB,
+		&area.FileCount,
+	)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query recovery area: %w", err)
+	}
+
+	area.NonReclaimableMB = area.SpaceUsedMB - area.SpaceReclaimableMB
+	area.UsagePercentage = area.SpaceUsedMB / area.SpaceLimitMB * 100
+	area.NonReclaimablePercentage = area.NonReclaimableMB / area.SpaceLimitMB * 100
+	return area, nil
+}
+
+func (s *OracleService) attachRecoveryAreaUsage(ctx context.Context, area *RecoveryArea) error {
+	rows, err := s.oracleDB.DB.QueryContext(ctx, oracle.QueryRecoveryAreaUsage)
+	if err != nil {
+		return fmt.Errorf("failed to query recovery area usage: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		ft := &RecoveryAreaFileType{}
+		err := rows.Scan(
+			&ft.FileType,
+			&ft.PercentSpaceUsed,
+			&ft.PercentSpaceReclaimable,
+			&ft.FileCount,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to scan recovery area usage: %w", err)
+		}
+		ft.UsedMB = area.SpaceLimitMB * ft.PercentSpaceUsed / 100
+		ft.ReclaimableMB = area.SpaceLimitMB * ft.PercentSpaceReclaimable / 100
+		area.FileTypes = append(area.FileTypes, ft)
+	}
+
+	return nil
+}
+
+// ============================================================================
+// BACKUP & RECOVERY
+// ============================================================================
+
+// Default backup coverage requirements
+const (
+	DefaultFullBackupMaxAgeDays        = 7
+	DefaultArchivelogBackupMaxAgeHours = 24
+)
+
+// BackupJob is one RMAN job from v$rman_backup_job_details
+type BackupJob struct {
+	SessionKey       int64
+	CommandID        *string
+	StartTime        time.Time
+	EndTime          *time.Time
+	Status           string
+	InputType        string
+	OutputDeviceType *string
+	ElapsedSeconds   *float64
+	InputMB          float64
+	OutputMB         float64
+	CompressionRatio *float64
+}
+
+// BackupSet is one backup set recorded in the controlfile. Type is FULL,
+// INCREMENTAL or ARCHIVELOG.
+type BackupSet struct {
+	SetStamp            int64
+	SetCount            int64
+	Type                string
+	IncrementalLevel    *int
+	Pieces              int
+	AvailablePieces     int
+	StartTime           time.Time
+	CompletionTime      time.Time
+	ElapsedSeconds      float64
+	ControlfileIncluded bool
+	SizeMB              float64
+}
+
+// DatafileBackup is the most recent full backup of one datafile
+type DatafileBackup struct {
+	FileID         int
+	FileName       string
+	LastFullBackup *time.Time
+	AgeHours       float64 // since database creation when never backed up
+}
+
+// BackupCoverage is the age of the most recent backup of each kind. The full
+// backup of a database is as old as its least recently backed up datafile;
+// ages count from database creation when no such backup exists.
+type BackupCoverage struct {
+	DatabaseCreated          time.Time
+	LogMode                  string
+	LastFullBackup           *time.Time
+	LastIncrementalBackup    *time.Time
+	LastArchivelogBackup     *time.Time
+	LastControlfileBackup    *time.Time
+	LastSpfileBackup         *time.Time
+	FullBackupAgeHours       float64
+	ArchivelogBackupAgeHours *float64 // nil when the database does not archive redo
+	Datafiles                []*DatafileBackup
+
+	FullBackupMaxAgeDays        int
+	ArchivelogBackupMaxAgeHours int
+	FullBackupOverdue           bool
+	ArchivelogBackupOverdue     bool
+	UncoveredDatafiles          []*DatafileBackup
+	Issues                      []string
+}
+
+// BackupStatus combines recent RMAN jobs, backup sets and coverage checks
+type BackupStatus struct {
+	Coverage   *BackupCoverage
+	Jobs       []*BackupJob
+	BackupSets []*BackupSet
+}
+
+// GetBackupStatus retrieves RMAN activity over the last N days and checks
+// backup coverage against the given maximum ages
+func (s *OracleService) GetBackupStatus(ctx context.Context, userID uuid.UUID, days, fullMaxAgeDays, archivelogMaxAgeHours int) (*BackupStatus, error) {
+	status, err := s.fetchBackupStatus(ctx, days, fullMaxAgeDays, archivelogMaxAgeHours)
+	if err != nil {
+		s.auditQueryFailure(ctx, userID, "GET_BACKUP_STATUS", err)
+		return nil, err
+	}
+
+	s.auditQuerySuccess(ctx, userID, "GET_BACKUP_STATUS", len(status.Jobs))
+	return status, nil
+}
+
+func (s *OracleService) fetchBackupStatus(ctx context.Context, days, fullMaxAgeDays, archivelogMaxAgeHours int) (*BackupStatus, error) {
+	coverage, err := s.fetchBackupCoverage(ctx)
+	if err != nil {
+		return nil, err
+	}
+	checkBackupCoverage(coverage, fullMaxAgeDays, archivelogMaxAgeHours)
+
+	status := &BackupStatus{Coverage: coverage}
+	if status.Jobs, err = s.fetchBackupJobs(ctx, days); err != nil {
+		return nil, err
+	}
+	if status.BackupSets, err = s.fetchBackupSets(ctx, days); err != nil {
+		return nil, err
+	}
+
+	return status, nil
+}
+
+// fetchBackupCoverage queries the most recent backups without auditing (for background use)
+func (s *OracleService) fetchBackupCoverage(ctx context.Context) (*BackupCoverage, error) {
+	coverage := &BackupCoverage{
+		Datafiles:          []*DatafileBackup{},
+		UncoveredDatafiles: []*DatafileBackup{},
+		Issues:             []string{},
+	}
+
+	// Ages are measured against the database clock, like the backup times
+	var now time.Time
+	err := s.oracleDB.DB.QueryRowContext(ctx, oracle.QueryBackupCoverage).Scan(
+		&now,
+		&coverage.DatabaseCreated,
+		&coverage.LogMode,
+		&coverage.LastIncrementalBackup,
+		&coverage.LastArchivelogBackup,
+		&coverage.LastControlfileBackup,
+		&coverage.LastSpfileBackup,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query backup coverage: %w", err)
+	}
+
+	rows, err := s.oracleDB.DB.QueryContext(ctx, oracle.QueryDatafileBackupCoverage)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query datafile backup coverage: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		df := &DatafileBackup{}
+		if err := rows.Scan(&df.FileID, &df.FileName, &df.LastFullBackup); err != nil {
+			return nil, fmt.Errorf("failed to scan datafile backup coverage: %w", err)
+		}
+		df.AgeHours = backupAgeHours(now, df.LastFullBackup, coverage.DatabaseCreated)
+		coverage.Datafiles = append(coverage.Datafiles, df)
+	}
+
+	// Files never backed up sort first, then the oldest backup
+	coverage.FullBackupAgeHours = backupAgeHours(now, nil, coverage.DatabaseCreated)
+	if len(coverage.Datafiles) > 0 {
+		coverage.LastFullBackup = coverage.Datafiles[0].LastFullBackup
+		coverage.FullBackupAgeHours = coverage.Datafiles[0].AgeHours
+	}
+
+	if coverage.LogMode == "ARCHIVELOG" {
+		age := backupAgeHours(now, coverage.LastArchivelogBackup, coverage.DatabaseCreated)
+		coverage.ArchivelogBackupAgeHours = &age
+	}
+
+	return coverage, nil
+}
+
+// checkBackupCoverage flags a missing or stale full backup and, for databases
+// in ARCHIVELOG mode, a stale archivelog backup
+func checkBackupCoverage(coverage *BackupCoverage, fullMaxAgeDays, archivelogMaxAgeHours int) {
+	coverage.FullBackupMaxAgeDays = fullMaxAgeDays
+	coverage.ArchivelogBackupMaxAgeHours = archivelogMaxAgeHours
+
+	fullMaxAgeHours := float64(fullMaxAgeDays * 24)
+	neverBackedUp := 0
+	for _, df := range coverage.Datafiles {
+		if df.AgeHours > fullMaxAgeHours {
+			coverage.UncoveredDatafiles = append(coverage.UncoveredDatafiles, df)
+		}
+		if df.LastFullBackup == nil {
+			neverBackedUp++
+		}
+	}
+
+	if coverage.FullBackupAgeHours > fullMaxAgeHours {
+		coverage.FullBackupOverdue = true
+		coverage.Issues = append(coverage.Issues, fmt.Sprintf(
+			"%d datafiles without a successful full backup within %d days", len(coverage.UncoveredDatafiles), fullMaxAgeDays))
+	}
+	if neverBackedUp > 0 {
+		coverage.Issues = append(coverage.Issues, fmt.Sprintf("%d datafiles have never been backed up", neverBackedUp))
+	}
+
+	if age := coverage.ArchivelogBackupAgeHours; age != nil && *age > float64(archivelogMaxAgeHours) {
+		coverage.ArchivelogBackupOverdue = true
+		if coverage.LastArchivelogBackup == nil {
+			coverage.Issues = append(coverage.Issues, "archived redo logs have never been backed up")
+		} else {
+			coverage.Issues = append(coverage.Issues, fmt.Sprintf(
+				"no archivelog backup within %d hours (last %.0f hours ago)", archivelogMaxAgeHours, *age))
+		}
+	}
+}
+
+func backupAgeHours(now time.Time, lastBackup *time.Time, created time.Time) float64 {
+	if lastBackup == nil {
+		return now.Sub(created).Hours()
+	}
+	return now.Sub(*lastBackup).Hours()
+}
+
+func (s *OracleService) fetchBackupJobs(ctx context.Context, days int) ([]*BackupJob, error) {
+	rows, err := s.oracleDB.DB.QueryContext(ctx, oracle.QueryBackupJobs, days)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query backup jobs: %w", err)
+	}
+	defer rows.Close()
+
+	jobs := []*BackupJob{}
+	for rows.Next() {
+		job := &BackupJob{}
+		err := rows.Scan(
+			&job.SessionKey,
+			&job.CommandID,
+			&job.StartTime,
+			&job.EndTime,
+			&job.Status,
+			&job.InputType,
+			&job.OutputDeviceType,
+			&job.ElapsedSeconds,
+			&job.InputMB,
+			&job.OutputMB,
+			&job.CompressionRatio,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan backup job: %w", err)
+		}
+		jobs = append(jobs, job)
+	}
+
+	return jobs, nil
+}
+
+func (s *OracleService) fetchBackupSets(ctx context.Context, days int) ([]*BackupSet, error) {
+	rows, err := s.oracleDB.DB.QueryContext(ctx, oracle.QueryBackupSets, days)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query backup sets: %w", err)
+	}
+	defer rows.Close()
+
+	sets := []*BackupSet{}
+	for rows.Next() {
+		set := &BackupSet{}
+		var backupType, controlfileIncluded string
+		err := rows.Scan(
+			&set.SetStamp,
+			&set.SetCount,
+			&backupType,
+			&set.IncrementalLevel,
+			&set.Pieces,
+			&set.StartTime,
+			&set.CompletionTime,
+			&set.ElapsedSeconds,
+			&controlfileIncluded,
+			&set.SizeMB,
+			&set.AvailablePieces,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan backup set: %w", err)
+		}
+		switch backupType {
+		case "D":
+			set.Type = "FULL"
+		case "I":
+			set.Type = "INCREMENTAL"
+		case "L":
+			set.Type = "ARCHIVELOG"
+		default:
+			set.Type = backupType
+		}
+		set.ControlfileIncluded = controlfileIncluded != "NO"
+		sets = append(sets, set)
+	}
+
+	return sets, nil
+}
+
+// ============================================================================
+// DATA GUARD
+// ============================================================================
+
+// DataGuardStatus describes the Data Guard configuration as seen from this
+// database. Lag and apply rate are only reported by a standby; gaps are
+// detected from destination status on a primary and v$archive_gap on a standby.
+type DataGuardStatus struct {
+	DBUniqueName             string
+	DatabaseRole             string
+	ProtectionMode           string
+	ProtectionLevel          string
+	SwitchoverStatus         string
+	BrokerEnabled            bool
+	TransportLagSeconds      *float64
+	ApplyLagSeconds          *float64
+	ApplyFinishSeconds       *float64
+	ApplyRateKBPerSec        *float64
+	AverageApplyRateKBPerSec *float64
+	Destinations             []*ArchiveDestination
+	Processes                []*StandbyProcess
+	Gaps                     []*ArchiveGap
+	GapDetected              bool
+}
+
+// ArchiveDestination is the status of one redo transport destination
+type ArchiveDestination struct {
+	DestID         int
+	DestName       string
+	Status         string
+	Type           string
+	DatabaseMode   *string
+	RecoveryMode   *string
+	Destination    *string
+	ArchivedThread int
+	ArchivedSeq    int64
+	AppliedThread  int
+	AppliedSeq     int64
+	GapStatus      *string
+	Error          *string
+}
+
+// StandbyProcess is one redo transport or apply process
+type StandbyProcess struct {
+	Process       string
+	PID           string
+	Status        string
+	ClientProcess *string
+	Thread        int
+	Sequence      int64
+	Block         int64
+	Blocks        int64
+}
+
+// ArchiveGap is a range of archived log sequences a standby is missing
+type ArchiveGap struct {
+	Thread       int
+	LowSequence  int64
+	HighSequence int64
+}
+
+// GetDataGuardStatus retrieves Data Guard role, protection, lag and transport status
+func (s *OracleService) GetDataGuardStatus(ctx context.Context, userID uuid.UUID) (*DataGuardStatus, error) {
+	status, err := s.fetchDataGuardStatus(ctx)
+	if err != nil {
+		s.auditQueryFailure(ctx, userID, "GET_DATAGUARD_STATUS", err)
+		return nil, err
+	}
+
+	s.auditQuerySuccess(ctx, userID, "GET_DATAGUARD_STATUS", len(status.Destinations))
+	return status, nil
+}
+
+func (s *OracleService) fetchDataGuardStatus(ctx context.Context) (*DataGuardStatus, error) {
+	status := &DataGuardStatus{
+		Destinations: []*ArchiveDestination{},
+		Processes:    []*StandbyProcess{},
+		Gaps:         []*ArchiveGap{},
+	}
+
+	var broker string
+	err := s.oracleDB.DB.QueryRowContext(ctx, oracle.QueryDataGuardDatabase).Scan(
+		&status.DBUniqueName,
+		&status.DatabaseRole,
+		&status.ProtectionMode,
+		&status.ProtectionLevel,
+		&status.SwitchoverStatus,
+		&broker,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query Data Guard configuration: %w", err)
+	}
+	status.BrokerEnabled = broker == "ENABLED"
+
+	lags, err := s.fetchDataGuardStats(ctx)
+	if err != nil {
+		return nil, err
+	}
+	status.TransportLagSeconds = lags["transport lag"]
+	status.ApplyLagSeconds = lags["apply lag"]
+	status.ApplyFinishSeconds = lags["apply finish time"]
+
+	rows, err := s.oracleDB.DB.QueryContext(ctx, oracle.QueryApplyRate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query apply rate: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var item string
+		var rate float64
+		if err := rows.Scan(&item, &rate); err != nil {
+			return nil, fmt.Errorf("failed to scan apply rate: %w", err)
+		}
+		if item == "Active Apply Rate" {
+			status.ApplyRateKBPerSec = &rate
+		} else {
+			status.AverageApplyRateKBPerSec = &rate
+		}
+	}
+	rows.Close()
+
+	if status.Destinations, err = s.fetchArchiveDestinations(ctx); err != nil {
+		return nil, err
+	}
+	if status.Processes, err = s.fetchStandbyProcesses(ctx); err != nil {
+		return nil, err
+	}
+	if status.Gaps, err = s.fetchArchiveGaps(ctx); err != nil {
+		return nil, err
+	}
+
+	status.GapDetected = len(status.Gaps) > 0
+	for _, dest := range status.Destinations {
+		if dest.GapStatus != nil && *dest.GapStatus != "NO GAP" && *dest.GapStatus != "" {
+			status.GapDetected = true
+		}
+	}
+
+	return status, nil
+}
+
+// fetchDataGuardStats queries lag figures in seconds without auditing (for
+// background use); figures Oracle has not computed are nil
+func (s *OracleService) fetchDataGuardStats(ctx context.Context) (map[string]*float64, error) {
+	rows, err := s.oracleDB.DB.QueryContext(ctx, oracle.QueryDataGuardStats)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query Data Guard statistics: %w", err)
+	}
+	defer rows.Close()
+
+	stats := map[string]*float64{}
+	for rows.Next() {
+		var name string
+		var value *string
+		if err := rows.Scan(&name, &value); err != nil {
+			return nil, fmt.Errorf("failed to scan Data Guard statistic: %w", err)
+		}
+		if value != nil {
+			stats[name] = parseDataGuardInterval(*value)
+		}
+	}
+
+	return stats, nil
+}
+
+// parseDataGuardInterval converts a v$dataguard_stats value such as
+// "+00 00:01:05" (days hours:minutes:seconds) to seconds
+func parseDataGuardInterval(value string) *float64 {
+	var days, hours, minutes int
+	var seconds float64
+	_, err := fmt.Sscanf(strings.TrimSpace(value), "%d %d:%d:%f", &days, &hours, &minutes, &seconds)
+	if err != nil {
+		return nil
+	}
+
+	total := float64(days*86400+hours*3600+minutes*60) + seconds
+	return &total
+}
+
+func (s *OracleService) fetchArchiveDestinations(ctx context.Context) ([]*ArchiveDestination, error) {
+	rows, err := s.oracleDB.DB.QueryContext(ctx, oracle.QueryArchiveDestStatus)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query archive destinations: %w", err)
+	}
+	defer rows.Close()
+
+	destinations := []*ArchiveDestination{}
+	for rows.Next() {
+		d := &ArchiveDestination{}
+		err := rows.Scan(
+			&d.DestID,
+			&d.DestName,
+			&d.Status,
+			&d.Type,
+			&d.DatabaseMode,
+			&d.RecoveryMode,
+			&d.Destination,
+			&d.ArchivedThread,
+			&d.ArchivedSeq,
+			&d.AppliedThread,
+			&d.AppliedSeq,
+			&d.GapStatus,
+			&d.Error,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan archive destination: %w", err)
+		}
+		destinations = append(destinations, d)
+	}
+
+	return destinations, nil
+}
+
+func (s *OracleService) fetchStandbyProcesses(ctx context.Context) ([]*StandbyProcess, error) {
+	rows, err := s.oracleDB.DB.QueryContext(ctx, oracle.QueryManagedStandby)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query standby processes: %w", err)
+	}
+	defer rows.Close()
+
+	processes := []*StandbyProcess{}
+	for rows.Next() {
+		p := &StandbyProcess{}
+		err := rows.Scan(
+			&p.Process,
+			&p.PID,
+			&p.Status,
+			&p.ClientProcess,
+			&p.Thread,
+			&p.Sequence,
+			&p.Block,
+			&p.Blocks,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan standby process: %w", err)
+		}
+		processes = append(processes, p)
+	}
+
+	return processes, nil
+}
+
+func (s *OracleService) fetchArchiveGaps(ctx context.Context) ([]*ArchiveGap, error) {
+	rows, err := s.oracleDB.DB.QueryContext(ctx, oracle.QueryArchiveGap)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query archive gaps: %w", err)
+	}
+	defer rows.Close()
+
+	gaps := []*ArchiveGap{}
+	for rows.Next() {
+		g := &ArchiveGap{}
+		if err := rows.Scan(&g.Thread, &g.LowSequence, &g.HighSequence); err != nil {
+			return nil, fmt.Errorf("failed to scan archive gap: %w", err)
+		}
+		gaps = append(gaps, g)
+	}
+
+	return gaps, nil
+}
+
+// ============================================================================
+// SQL PERFORMANCE MONITORING
+// ============================================================================
+
+// SQLPerformance represents SQL query performance metrics
+type SQLPerformance struct {
+	SQLID            string
+	SQLText          *string
+	ParsingSchema    *string
+	Executions       int
+	ElapsedSeconds   float64
+	AvgElapsedSeconds float64
+	CPUSeconds       float64
+	DiskReads        int
+	BufferGets       int
+	RowsProcessed    int
+	FirstLoadTime    *time.Time
+	LastActiveTime   *time.Time
+	PlanHashValue    *int64
+	InstID           *int // nil when the statement is cached on several instances
+	ConID            *int // nil when the statement is cached in several containers
+}
+
+// GetTopSQLByElapsedTime retrieves top SQL by elapsed time, only from the
+// named PDB unless pdb is empty
+func (s *OracleService) GetTopSQLByElapsedTime(ctx context.Context, userID uuid.UUID, limit int, pdb string) ([]*SQLPerformance, error) {
+	rows, err := s.oracleDB.DB.QueryContext(ctx, s.clusterQuery(oracle.QueryTopSQLByElapsedTime, oracle.QueryClusterTopSQLByElapsedTime),
+		pdbBind(pdb), pdbBind(pdb), limit)
+	if err != nil {
+		s.auditQueryFailure(ctx, userID, "GET_TOP_SQL_BY_ELAPSED", err)
+		return nil, fmt.Errorf("failed to query top SQL by elapsed time: %w", err)
+	}
+	defer rows.Close()
+
+	sqlPerf := []*SQLPerformance{}
+	for rows.Next() {
+		sp := &SQLPerformance{}
+		err := rows.Scan(
+			&sp.SQLID,
+			&sp.SQLText,
+			&sp.ParsingSchema,
+			&sp.Executions,
+			&sp.ElapsedSeconds,
+			&sp.AvgElapsedSeconds,
+			&sp.CPUSeconds,
+			&sp.DiskReads,
+			&sp.BufferGets,
+			&sp.RowsProcessed,
+			&sp.FirstLoadTime,
+			&sp.LastActiveTime,
+			&sp.InstID,
+			&sp.ConID,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan SQL performance: %w", err)
+		}
+		sqlPerf = append(sqlPerf, sp)
+	}
+
+	s.auditQuerySuccess(ctx, userID, "GET_TOP_SQL_BY_ELAPSED", len(sqlPerf))
+	return sqlPerf, nil
+}
+
+// GetTopSQLByCPU retrieves top SQL by CPU time, only from the named PDB
+// unless pdb is empty
+func (s *OracleService) GetTopSQLByCPU(ctx context.Context, userID uuid.UUID, limit int, pdb string) ([]*SQLPerformance, error) {
+	rows, err := s.oracleDB.DB.QueryContext(ctx, s.clusterQuery(oracle.QueryTopSQLByCPU, oracle.QueryClusterTopSQLByCPU),
+		pdbBind(pdb), pdbBind(pdb), limit)
+	if err != nil {
+		s.auditQueryFailure(ctx, userID, "GET_TOP_SQL_BY_CPU", err)
+		return nil, fmt.Errorf("failed to query top SQL by CPU: %w", err)
+	}
+	defer rows.Close()
+
+	sqlPerf := []*SQLPerformance{}
+	for rows.Next() {
+		sp := &SQLPerformance{}
+		err := rows.Scan(
+			&sp.SQLID,
+			&sp.SQLText,
+			&sp.ParsingSchema,
+			&sp.Executions,
+			&sp.CPUSeconds,
+			&sp.AvgElapsedSeconds,
+			&sp.ElapsedSeconds,
+			&sp.DiskReads,
+			&sp.BufferGets,
+			&sp.RowsProcessed,
+			&sp.InstID,
+			&sp.ConID,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan SQL performance: %w", err)
+		}
+		sqlPerf = append(sqlPerf, sp)
+	}
+
+	s.auditQuerySuccess(ctx, userID, "GET_TOP_SQL_BY_CPU", len(sqlPerf))
+	return sqlPerf, nil
+}
+
+// fetchSQLSnapshot captures cumulative statistics for the top SQL statements
+// by elapsed time, for delta computation against a previous snapshot
+func (s *OracleService) fetchSQLSnapshot(ctx context.Context, limit int) ([]*repository.QueryMetric, error) {
+	rows, err := s.oracleDB.DB.QueryContext(ctx, oracle.QuerySQLSnapshot, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query SQL snapshot: %w", err)
+	}
+	defer rows.Close()
+
+	metrics := []*repository.QueryMetric{}
+	for rows.Next() {
+		m := &repository.QueryMetric{}
+		err := rows.Scan(
+			&m.SQLID,
+			&m.SQLText,
+			&m.ParsingSchema,
+			&m.Executions,
+			&m.ElapsedTimeMS,
+			&m.CPUTimeMS,
+			&m.DiskReads,
+			&m.BufferGets,
+			&m.RowsProcessed,
+			&m.LastActiveTime,
+			&m.PlanHashValue,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan SQL snapshot: %w", err)
+		}
+		metrics = append(metrics, m)
+	}
+
+	return metrics, nil
+}
+
+// GetSQLByID retrieves statistics for one SQL_ID, or nil if it is no longer
+// in the shared pool
+func (s *OracleService) GetSQLByID(ctx context.Context, userID uuid.UUID, sqlID string) (*SQLPerformance, error) {
+	sp := &SQLPerformance{}
+	err := s.oracleDB.DB.QueryRowContext(ctx, s.clusterQuery(oracle.QuerySQLByID, oracle.QueryClusterSQLByID), sqlID).Scan(
+		&sp.SQLID,
+		&sp.SQLText,
+		&sp.ParsingSchema,
+		&sp.Executions,
+		&sp.ElapsedSeconds,
+		&sp.AvgElapsedSeconds,
+		&sp.CPUSeconds,
+		&sp.DiskReads,
+		&sp.BufferGets,
+		&sp.RowsProcessed,
+		&sp.FirstLoadTime,
+		&sp.LastActiveTime,
+		&sp.PlanHashValue,
+		&sp.InstID,
+		&sp.ConID,
+	)
+	if err == sql.ErrNoRows {
+		s.auditQuerySuccess(ctx, userID, "GET_SQL_BY_ID", 0)
+		return nil, nil
+	}
+	if err != nil {
+		s.auditQueryFailure(ctx, userID, "GET_SQL_BY_ID", err)
+		return nil, fmt.Errorf("failed to query SQL %s: %w", sqlID, err)
+	}
+
+	s.auditQuerySuccess(ctx, userID, "GET_SQL_BY_ID", 1)
+	return sp, nil
+}
+
+// ExecutionPlan is the plan of one child cursor, both as DBMS_XPLAN output
+// and as structured v$sql_plan operations
+type ExecutionPlan struct {
+	SQLID         string
+	ChildNumber   int
+	PlanHashValue int64
+	Text          string
+	Steps         []*PlanStep // ordered by step ID, parents before children
+}
+
+// PlanStep is one operation of an execution plan
+type PlanStep struct {
+	ID               int
+	ParentID         *int
+	Depth            int
+	Operation        string
+	Options          *string
+	ObjectOwner      *string
+	ObjectName       *string
+	Cost             *int
+	Cardinality      *int
+	Bytes            *int
+	CPUCost          *int
+	IOCost           *int
+	Time             *int
+	AccessPredicates *string
+	FilterPredicates *string
+}
+
+// GetExecutionPlan retrieves the execution plan of a cursor. Without a child
+// number, the most recently active child is used.
+func (s *OracleService) GetExecutionPlan(ctx context.Context, userID uuid.UUID, sqlID string, childNumber *int) (*ExecutionPlan, error) {
+	plan, err := s.fetchExecutionPlan(ctx, sqlID, childNumber)
+	if err != nil {
+		s.auditQueryFailure(ctx, userID, "GET_EXECUTION_PLAN", err)
+		return nil, err
+	}
+
+	s.auditQuerySuccess(ctx, userID, "GET_EXECUTION_PLAN", len(plan.Steps))
+	return plan, nil
+}
+
+func (s *OracleService) fetchExecutionPlan(ctx context.Context, sqlID string, childNumber *int) (*ExecutionPlan, error) {
+	plan := &ExecutionPlan{SQLID: sqlID}
+
+	if childNumber != nil {
+		plan.ChildNumber = *childNumber
+	} else {
+		err := s.oracleDB.DB.QueryRowContext(ctx, oracle.QueryLatestChildNumber, sqlID).Scan(&plan.ChildNumber)
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("SQL %s not found in the shared pool", sqlID)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to find child cursor: %w", err)
+		}
+	}
+
+	rows, err := s.oracleDB.DB.QueryContext(ctx, oracle.QueryExecutionPlanSteps, sqlID, plan.ChildNumber)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query plan steps: %w", err)
+	}
+	defer rows.Close()
+
+	plan.Steps = []*PlanStep{}
+	for rows.Next() {
+		step := &PlanStep{}
+		err := rows.Scan(
+			&step.ID,
+			&step.ParentID,
+			&step.Depth,
+			&step.Operation,
+			&step.Options,
+			&step.ObjectOwner,
+			&step.ObjectName,
+			&step.Cost,
+			&step.Cardinality,
+			&step.Bytes,
+			&step.CPUCost,
+			&step.IOCost,
+			&step.Time,
+			&step.AccessPredicates,
+			&step.FilterPredicates,
+			&plan.PlanHashValue,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan plan step: %w", err)
+		}
+		plan.Steps = append(plan.Steps, step)
+	}
+	if len(plan.Steps) == 0 {
+		return nil, fmt.Errorf("no plan found for SQL %s child %d", sqlID, plan.ChildNumber)
+	}
+
+	textRows, err := s.oracleDB.DB.QueryContext(ctx, oracle.QueryExecutionPlanText, sqlID, plan.ChildNumber)
+	if err != nil {
+		return nil, fmt.Errorf("failed to display cursor plan: %w", err)
+	}
+	defer textRows.Close()
+
+	lines := []string{}
+	for textRows.Next() {
+		var line sql.NullString
+		if err := textRows.Scan(&line); err != nil {
+			return nil, fmt.Errorf("failed to scan plan output: %w", err)
+		}
+		lines = append(lines, line.String)
+	}
+	plan.Text = strings.Join(lines, "\n")
+
+	return plan, nil
+}
+
+// sqlPlanStat is the cumulative workload of one plan of a SQL_ID
+type sqlPlanStat struct {
+	Executions int
+	ElapsedMS  float64
+}
+
+// fetchSQLPlanStats reads cumulative statistics per plan of a SQL_ID without auditing (for background use)
+func (s *OracleService) fetchSQLPlanStats(ctx context.Context, sqlID string) (map[int64]*sqlPlanStat, error) {
+	rows, err := s.oracleDB.DB.QueryContext(ctx, oracle.QuerySQLPlanStats, sqlID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query SQL plan statistics: %w", err)
+	}
+	defer rows.Close()
+
+	stats := map[int64]*sqlPlanStat{}
+	for rows.Next() {
+		var planHashValue int64
+		stat := &sqlPlanStat{}
+		if err := rows.Scan(&planHashValue, &stat.Executions, &stat.ElapsedMS); err != nil {
+			return nil, fmt.Errorf("failed to scan SQL plan statistics: %w", err)
+		}
+		stats[planHashValue] = stat
+	}
+
+	return stats, nil
+}
+
+// ============================================================================
+// SYSTEM STATISTICS
+// ============================================================================
+
+// fetchSystemEvents reads cumulative non-idle wait event totals keyed by event
+func (s *OracleService) fetchSystemEvents(ctx context.Context) (map[string]*repository.WaitEventDelta, error) {
+	rows, err := s.oracleDB.DB.QueryContext(ctx, oracle.QuerySystemEvents)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query system events: %w", err)
+	}
+	defer rows.Close()
+
+	events := map[string]*repository.WaitEventDelta{}
+	for rows.Next() {
+		e := &repository.WaitEventDelta{}
+		if err := rows.Scan(&e.Event, &e.WaitClass, &e.Waits, &e.TimeWaitedMicro); err != nil {
+			return nil, fmt.Errorf("failed to scan system event: %w", err)
+		}
+		events[e.Event] = e
+	}
+
+	return events, nil
+}
+
+// fetchNamedValues reads a two-column name/value statistics query
+func (s *OracleService) fetchNamedValues(ctx context.Context, query string) (map[string]float64, error) {
+	rows, err := s.oracleDB.DB.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query statistics: %w", err)
+	}
+	defer rows.Close()
+
+	values := map[string]float64{}
+	for rows.Next() {
+		var name string
+		var value float64
+		if err := rows.Scan(&name, &value); err != nil {
+			return nil, fmt.Errorf("failed to scan statistic: %w", err)
+		}
+		values[name] = value
+	}
+
+	return values, nil
+}
+
+// ============================================================================
+// DATABASE HEALTH
+// ============================================================================
+
+// DatabaseInstance represents Oracle database instance information
+type DatabaseInstance struct {
+	InstID         int
+	InstanceName   string
+	HostName       string
+	Version        string
+	StartupTime    time.Time
+	Status         string
+	DatabaseStatus string
+	InstanceRole   string
+	UptimeDays     float64
+}
+
+// GetDatabaseInstances retrieves instance information: the connected instance,
+// or every open instance in cluster mode
+func (s *OracleService) GetDatabaseInstances(ctx context.Context, userID uuid.UUID) ([]*DatabaseInstance, error) {
+	rows, err := s.oracleDB.DB.QueryContext(ctx, s.clusterQuery(oracle.QueryDatabaseInstance, oracle.QueryClusterDatabaseInstances))
+	if err != nil {
+		s.auditQueryFailure(ctx, userID, "GET_DATABASE_INSTANCE", err)
+		return nil, fmt.Errorf("failed to query database instance: %w", err)
+	}
+	defer rows.Close()
+
+	instances := []*DatabaseInstance{}
+	for rows.Next() {
+		instance := &DatabaseInstance{}
+		err := rows.Scan(
+			&instance.InstID,
+			&instance.InstanceName,
+			&instance.HostName,
+			&instance.Version,
+			&instance.StartupTime,
+			&instance.Status,
+			&instance.DatabaseStatus,
+			&instance.InstanceRole,
+			&instance.UptimeDays,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan database instance: %w", err)
+		}
+		instances = append(instances, instance)
+	}
+
+	s.auditQuerySuccess(ctx, userID, "GET_DATABASE_INSTANCE", len(instances))
+	return instances, nil
+}
+
+// PDB is a pluggable database of a CDB as seen by one instance
+type PDB struct {
+	ConID       int
+	Name        string
+	DBID        int64
+	OpenMode    string
+	Restricted  bool
+	OpenTime    *time.Time
+	TotalSizeMB float64
+	InstID      int
+}
+
+// GetPDBs retrieves the pluggable databases; empty on a non-CDB. In cluster
+// mode each PDB is listed once per instance.
+func (s *OracleService) GetPDBs(ctx context.Context, userID uuid.UUID) ([]*PDB, error) {
+	rows, err := s.oracleDB.DB.QueryContext(ctx, s.clusterQuery(oracle.QueryPDBs, oracle.QueryClusterPDBs))
+	if err != nil {
+		s.auditQueryFailure(ctx, userID, "GET_PDBS", err)
+		return nil, fmt.Errorf("failed to query PDBs: %w", err)
+	}
+	defer rows.Close()
+
+	pdbs := []*PDB{}
+	for rows.Next() {
+		p := &PDB{}
+		var restricted sql.NullString
+		err := rows.Scan(
+			&p.ConID,
+			&p.Name,
+			&p.DBID,
+			&p.OpenMode,
+			&restricted,
+			&p.OpenTime,
+			&p.TotalSizeMB,
+			&p.InstID,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan PDB: %w", err)
+		}
+		p.Restricted = restricted.String == "YES"
+		pdbs = append(pdbs, p)
+	}
+
+	s.auditQuerySuccess(ctx, userID, "GET_PDBS", len(pdbs))
+	return pdbs, nil
+}
+
+// ============================================================================
+// MEMORY
+// ============================================================================
+
+// SGAComponent is one line of v$sgainfo
+type SGAComponent struct {
+	Name       string
+	SizeMB     float64
+	Resizeable bool
+}
+
+// SGADynamicComponent is an SGA pool sized by automatic memory management,
+// with its range since startup and its last resize
+type SGADynamicComponent struct {
+	Component           string
+	CurrentSizeMB       float64
+	MinSizeMB           float64
+	MaxSizeMB           float64
+	UserSpecifiedSizeMB float64
+	OperationCount      int
+	LastOperationType   *string
+	LastOperationMode   *string
+	LastOperationTime   *time.Time
+	GranuleSizeMB       float64
+}
+
+// PGAStat is one v$pgastat statistic; byte values are converted to MB
+type PGAStat struct {
+	Name  string
+	Value float64
+	Unit  *string
+}
+
+// MemorySummary is the current SGA and PGA allocation. The PGA figures are
+// picked out of PGAStats; PGACacheHitPercentage is nil until work areas ran.
+type MemorySummary struct {
+	SGAComponents         []*SGAComponent
+	DynamicComponents     []*SGADynamicComponent
+	PGAStats              []*PGAStat
+	SGAMaxSizeMB          float64
+	SGAFreeMB             float64
+	PGATargetMB           float64
+	PGAAllocatedMB        float64
+	PGAInUseMB            float64
+	PGAMaxAllocatedMB     float64
+	PGACacheHitPercentage *float64
+	PGAOverAllocations    int64
+}
+
+// ProcessMemory is the PGA of one server or background process
+type ProcessMemory struct {
+	PID            int
+	SPID           *string
+	SID            *int
+	Serial         *int
+	Username       *string
+	Program        *string
+	UsedMB         float64
+	AllocatedMB    float64
+	FreeableMB     float64
+	MaxAllocatedMB float64
+	Categories     []*ProcessMemoryCategory
+}
+
+// ProcessMemoryCategory is the PGA a process holds for one purpose
+type ProcessMemoryCategory struct {
+	Category       string
+	AllocatedMB    float64
+	UsedMB         *float64
+	MaxAllocatedMB *float64
+}
+
+// MemoryResizeOperation is a grow or shrink of an SGA component or the PGA
+// target done automatically or by ALTER SYSTEM
+type MemoryResizeOperation struct {
+	Component     string
+	OperationType string
+	OperationMode *string
+	Parameter     *string
+	InitialSizeMB float64
+	TargetSizeMB  float64
+	FinalSizeMB   float64
+	Status        string
+	StartTime     time.Time
+	EndTime       *time.Time
+}
+
+// MemoryAdvice holds the memory advisor curves. In each, the point with a
+// size factor of 1 is the current size.
+type MemoryAdvice struct {
+	SGATarget []*SGATargetAdvice
+	PGATarget []*PGATargetAdvice
+	DBCache   []*DBCacheAdviceSeries
+}
+
+// SGATargetAdvice is the estimated workload at one SGA_TARGET size
+type SGATargetAdvice struct {
+	SGASizeMB              float64
+	SizeFactor             float64
+	EstimatedDBTime        float64
+	EstimatedDBTimeFactor  float64
+	EstimatedPhysicalReads int64
+}
+
+// PGATargetAdvice is the estimated work area behaviour at one
+// PGA_AGGREGATE_TARGET size
+type PGATargetAdvice struct {
+	PGATargetMB                 float64
+	TargetFactor                float64
+	EstimatedCacheHitPercentage float64
+	EstimatedOverAllocations    int64
+	EstimatedExtraMBReadWritten float64
+}
+
+// DBCacheAdviceSeries is the buffer cache advice for one pool and block size
+type DBCacheAdviceSeries struct {
+	Name      string
+	BlockSize int
+	Points    []*DBCacheAdvice
+}
+
+// DBCacheAdvice is the estimated physical reads at one buffer cache size
+type DBCacheAdvice struct {
+	SizeMB                      float64
+	SizeFactor                  float64
+	EstimatedPhysicalReadFactor *float64
+	EstimatedPhysicalReads      int64
+	EstimatedReadTimeSeconds    *float64
+}
+
+// GetMemorySummary retrieves the current SGA and PGA allocation
+func (s *OracleService) GetMemorySummary(ctx context.Context, userID uuid.UUID) (*MemorySummary, error) {
+	summary, err := s.fetchMemorySummary(ctx)
+	if err != nil {
+		s.auditQueryFailure(ctx, userID, "GET_MEMORY_SUMMARY", err)
+		return nil, err
+	}
+
+	s.auditQuerySuccess(ctx, userID, "GET_MEMORY_SUMMARY", len(summary.SGAComponents))
+	return summary, nil
+}
+
+func (s *OracleService) fetchMemorySummary(ctx context.Context) (*MemorySummary, error) {
+	summary := &MemorySummary{}
+
+	var err error
+	if summary.SGAComponents, err = s.fetchSGAInfo(ctx); err != nil {
+		return nil, err
+	}
+	if summary.DynamicComponents, err = s.fetchSGADynamicComponents(ctx); err != nil {
+		return nil, err
+	}
+	if summary.PGAStats, err = s.fetchPGAStats(ctx); err != nil {
+		return nil, err
+	}
+
+	for _, c := range summary.SGAComponents {
+		switch c.Name {
+		case "Maximum SGA Size":
+			summary.SGAMaxSizeMB = c.SizeMB
+		case "Free SGA Memory Available":
+			summary.SGAFreeMB = c.SizeMB
+		}
+	}
+	for _, stat := range summary.PGAStats {
+		switch stat.Name {
+		case "aggregate PGA target parameter":
+			summary.PGATargetMB = stat.Value
+		case "total PGA allocated":
+			summary.PGAAllocatedMB = stat.Value
+		case "total PGA inuse":
+			summary.PGAInUseMB = stat.Value
+		case "maximum PGA allocated":
+			summary.PGAMaxAllocatedMB = stat.Value
+		case "cache hit percentage":
+			if stat.Value > 0 {
+				summary.PGACacheHitPercentage = &stat.Value
+			}
+		case "over allocation count":
+			summary.PGAOverAllocations = int64(stat.Value)
+		}
+	}
+
+	return summary, nil
+}
+
+func (s *OracleService) fetchSGAInfo(ctx context.Context) ([]*SGAComponent, error) {
+	rows, err := s.oracleDB.DB.QueryContext(ctx, oracle.QuerySGAInfo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query SGA info: %w", err)
+	}
+	defer rows.Close()
+
+	components := []*SGAComponent{}
+	for rows.Next() {
+		c := &SGAComponent{}
+		var resizeable string
+		if err := rows.Scan(&c.Name, &c.SizeMB, &resizeable); err != nil {
+			return nil, fmt.Errorf("failed to scan SGA info: %w", err)
+		}
+		c.Resizeable = resizeable == "Yes"
+		components = append(components, c)
+	}
+
+	return components, nil
+}
+
+func (s *OracleService) fetchSGADynamicComponents(ctx context.Context) ([]*SGADynamicComponent, error) {
+	rows, err := s.oracleDB.DB.QueryContext(ctx, oracle.QuerySGADynamicComponents)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query SGA dynamic components: %w", err)
+	}
+	defer rows.Close()
+
+	components := []*SGADynamicComponent{}
+	for rows.Next() {
+		c := &SGADynamicComponent{}
+		err := rows.Scan(
+			&c.Component,
+			&c.CurrentSizeMB,
+			&c.MinSizeMB,
+			&c.MaxSizeMB,
+			&c.UserSpecifiedSizeMB,
+			&c.OperationCount,
+			&c.LastOperationType,
+			&c.LastOperationMode,
+			&c.LastOperationTime,
+			&c.GranuleSizeMB,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan SGA dynamic component: %w", err)
+		}
+		components = append(components, c)
+	}
+
+	return components, nil
+}
+
+func (s *OracleService) fetchPGAStats(ctx context.Context) ([]*PGAStat, error) {
+	rows, err := s.oracleDB.DB.QueryContext(ctx, oracle.QueryPGAStat)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query PGA statistics: %w", err)
+	}
+	defer rows.Close()
+
+	stats := []*PGAStat{}
+	for rows.Next() {
+		stat := &PGAStat{}
+		if err := rows.Scan(&stat.Name, &stat.Value, &stat.Unit); err != nil {
+			return nil, fmt.Errorf("failed to scan PGA statistic: %w", err)
+		}
+		if stat.Unit != nil && *stat.Unit == "bytes" {
+			stat.Value = math.Round(stat.Value/1024/1024*100) / 100
+			unit := "MB"
+			stat.Unit = &unit
+		}
+		stats = append(stats, stat)
+	}
+
+	return stats, nil
+}
+
+// GetProcessMemory retrieves the processes holding the most PGA
+func (s *OracleService) GetProcessMemory(ctx context.Context, userID uuid.UUID, limit int) ([]*ProcessMemory, error) {
+	rows, err := s.oracleDB.DB.QueryContext(ctx, oracle.QueryProcessMemory, limit)
+	if err != nil {
+		s.auditQueryFailure(ctx, userID, "GET_PROCESS_MEMORY", err)
+		return nil, fmt.Errorf("failed to query process memory: %w", err)
+	}
+	defer rows.Close()
+
+	processes := []*ProcessMemory{}
+	var current *ProcessMemory
+	for rows.Next() {
+		p := &ProcessMemory{Categories: []*ProcessMemoryCategory{}}
+		var category sql.NullString
+		var allocatedMB sql.NullFloat64
+		c := &ProcessMemoryCategory{}
+		err := rows.Scan(
+			&p.PID,
+			&p.SPID,
+			&p.SID,
+			&p.Serial,
+			&p.Username,
+			&p.Program,
+			&p.UsedMB,
+			&p.AllocatedMB,
+			&p.FreeableMB,
+			&p.MaxAllocatedMB,
+			&category,
+			&allocatedMB,
+			&c.UsedMB,
+			&c.MaxAllocatedMB,
+		)
+		if err != nil {
+			s.auditQueryFailure(ctx, userID, "GET_PROCESS_MEMORY", err)
+			return nil, fmt.Errorf("failed to scan process memory: %w", err)
+		}
+
+		// Rows of one process are adjacent, one per memory category
+		if current == nil || current.PID != p.PID {
+			current = p
+			processes = append(processes, current)
+		}
+		if category.Valid {
+			c.Category = category.String
+			c.AllocatedMB = allocatedMB.Float64
+			current.Categories = append(current.Categories, c)
+		}
+	}
+
+	s.auditQuerySuccess(ctx, userID, "GET_PROCESS_MEMORY", len(processes))
+	return processes, nil
+}
+
+// GetMemoryResizeOperations retrieves the SGA and PGA resize operations
+// started in a window
+func (s *OracleService) GetMemoryResizeOperations(ctx context.Context, userID uuid.UUID, start, end time.Time) ([]*MemoryResizeOperation, error) {
+	if !end.After(start) {
+		return nil, fmt.Errorf("end must be after start")
+	}
+
+	rows, err := s.oracleDB.DB.QueryContext(ctx, oracle.QueryMemoryResizeOps, start, end)
+	if err != nil {
+		s.auditQueryFailure(ctx, userID, "GET_MEMORY_RESIZE_OPERATIONS", err)
+		return nil, fmt.Errorf("failed to query memory resize operations: %w", err)
+	}
+	defer rows.Close()
+
+	operations := []*MemoryResizeOperation{}
+	for rows.Next() {
+		op := &MemoryResizeOperation{}
+		err := rows.Scan(
+			&op.Component,
+			&op.OperationType,
+			&op.OperationMode,
+			&op.Parameter,
+			&op.InitialSizeMB,
+			&op.TargetSizeMB,
+			&op.FinalSizeMB,
+			&op.Status,
+			&op.StartTime,
+			&op.EndTime,
+		)
+		if err != nil {
+			s.auditQueryFailure(ctx, userID, "GET_MEMORY_RESIZE_OPERATIONS", err)
+			return nil, fmt.Errorf("failed to scan memory resize operation: %w", err)
+		}
+		operations = append(operations, op)
+	}
+
+	s.auditQuerySuccess(ctx, userID, "GET_MEMORY_RESIZE_OPERATIONS", len(operations))
+	return operations, nil
+}
+
+// GetMemoryAdvice retrieves the SGA target, PGA target and buffer cache
+// advisor curves. A curve is empty when its advisor is off (STATISTICS_LEVEL
+// BASIC, or SGA_TARGET unset for the SGA advice).
+func (s *OracleService) GetMemoryAdvice(ctx context.Context, userID uuid.UUID) (*MemoryAdvice, error) {
+	advice, err := s.fetchMemoryAdvice(ctx)
+	if err != nil {
+		s.auditQueryFailure(ctx, userID, "GET_MEMORY_ADVICE", err)
+		return nil, err
+	}
+
+	s.auditQuerySuccess(ctx, userID, "GET_MEMORY_ADVICE", len(advice.SGATarget)+len(advice.PGATarget)+len(advice.DBCache))
+	return advice, nil
+}
+
+func (s *OracleService) fetchMemoryAdvice(ctx context.Context) (*MemoryAdvice, error) {
+	advice := &MemoryAdvice{
+		SGATarget: []*SGATargetAdvice{},
+		PGATarget: []*PGATargetAdvice{},
+		DBCache:   []*DBCacheAdviceSeries{},
+	}
+
+	rows, err := s.oracleDB.DB.QueryContext(ctx, oracle.QuerySGATargetAdvice)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query SGA target advice: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		a := &SGATargetAdvice{}
+		err := rows.Scan(&a.SGASizeMB, &a.SizeFactor, &a.EstimatedDBTime, &a.EstimatedDBTimeFactor, &a.EstimatedPhysicalReads)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan SGA target advice: %w", err)
+		}
+		advice.SGATarget = append(advice.SGATarget, a)
+	}
+
+	pgaRows, err := s.oracleDB.DB.QueryContext(ctx, oracle.QueryPGATargetAdvice)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query PGA target advice: %w", err)
+	}
+	defer pgaRows.Close()
+	for pgaRows.Next() {
+		a := &PGATargetAdvice{}
+		err := pgaRows.Scan(
+			&a.PGATargetMB,
+			&a.TargetFactor,
+			&a.EstimatedCacheHitPercentage,
+			&a.EstimatedOverAllocations,
+			&a.EstimatedExtraMBReadWritten,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan PGA target advice: %w", err)
+		}
+		advice.PGATarget = append(advice.PGATarget, a)
+	}
+
+	cacheRows, err := s.oracleDB.DB.QueryContext(ctx, oracle.QueryDBCacheAdvice)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query buffer cache advice: %w", err)
+	}
+	defer cacheRows.Close()
+	var series *DBCacheAdviceSeries
+	for cacheRows.Next() {
+		var name string
+		var blockSize int
+		a := &DBCacheAdvice{}
+		err := cacheRows.Scan(
+			&name,
+			&blockSize,
+			&a.SizeMB,
+			&a.SizeFactor,
+			&a.EstimatedPhysicalReadFactor,
+			&a.EstimatedPhysicalReads,
+			&a.EstimatedReadTimeSeconds,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan buffer cache advice: %w", err)
+		}
+		if series == nil || series.Name != name || series.BlockSize != blockSize {
+			series = &DBCacheAdviceSeries{Name: name, BlockSize: blockSize, Points: []*DBCacheAdvice{}}
+			advice.DBCache = append(advice.DBCache, series)
+		}
+		series.Points = append(series.Points, a)
+	}
+
+	return advice, nil
+}
+
+// ============================================================================
+// INITIALIZATION PARAMETERS
+// ============================================================================
+
+// How a parameter can be changed with ALTER SYSTEM
+const (
+	ParameterModifiableImmediate = "IMMEDIATE" // takes effect at once
+	ParameterModifiableDeferred  = "DEFERRED"  // takes effect for new sessions
+	ParameterModifiableNone      = "NONE"      // needs a restart (SCOPE=SPFILE)
+)
+
+// Parameter is an initialization parameter of the connected instance with
+// its spfile value. ModifiedSinceStartup is set when it was changed with
+// ALTER SESSION or ALTER SYSTEM after the instance started.
+type Parameter struct {
+	Name                 string
+	Value                *string
+	SPFileValue          *string // nil when not set in the spfile
+	IsDefault            bool
+	ModifiedSinceStartup bool
+	Deprecated           bool
+	SessionModifiable    bool
+	SystemModifiable     string
+	PDBModifiable        bool
+	Description          *string
+}
+
+// SPFileDiffers reports whether the spfile holds a different value than the
+// one in effect, i.e. the parameter changes at the next restart
+func (p *Parameter) SPFileDiffers() bool {
+	if p.SPFileValue == nil {
+		return false
+	}
+	return !parameterValuesEqual(p.Value, p.SPFileValue)
+}
+
+// parameterValuesEqual compares parameter values the way Oracle treats them:
+// case and surrounding blanks do not matter
+func parameterValuesEqual(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return strings.EqualFold(strings.TrimSpace(*a), strings.TrimSpace(*b))
+}
+
+// GetParameters retrieves the initialization parameters, optionally only
+// those whose name contains name and those set to a non-default value
+func (s *OracleService) GetParameters(ctx context.Context, userID uuid.UUID, name string, nonDefaultOnly bool) ([]*Parameter, error) {
+	params, err := s.fetchParameters(ctx)
+	if err != nil {
+		s.auditQueryFailure(ctx, userID, "GET_PARAMETERS", err)
+		return nil, err
+	}
+
+	name = strings.ToLower(name)
+	filtered := []*Parameter{}
+	for _, p := range params {
+		if nonDefaultOnly && p.IsDefault {
+			continue
+		}
+		if name != "" && !strings.Contains(p.Name, name) {
+			continue
+		}
+		filtered = append(filtered, p)
+	}
+
+	s.auditQuerySuccess(ctx, userID, "GET_PARAMETERS", len(filtered))
+	return filtered, nil
+}
+
+// fetchParameters queries every initialization parameter without auditing
+// (for background use)
+func (s *OracleService) fetchParameters(ctx context.Context) ([]*Parameter, error) {
+	rows, err := s.oracleDB.DB.QueryContext(ctx, oracle.QueryParameters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query parameters: %w", err)
+	}
+	defer rows.Close()
+
+	params := []*Parameter{}
+	for rows.Next() {
+		p := &Parameter{}
+		var isDefault, isModified, isDeprecated, sessionModifiable, pdbModifiable string
+		err := rows.Scan(
+			&p.Name,
+			&p.Value,
+			&p.SPFileValue,
+			&isDefault,
+			&isModified,
+			&isDeprecated,
+			&sessionModifiable,
+			&p.SystemModifiable,
+			&pdbModifiable,
+			&p.Description,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan parameter: %w", err)
+		}
+		p.IsDefault = isDefault == "TRUE"
+		p.ModifiedSinceStartup = isModified != "FALSE"
+		p.Deprecated = isDeprecated == "TRUE"
+		p.SessionModifiable = sessionModifiable == "TRUE"
+		p.PDBModifiable = pdbModifiable == "TRUE"
+		if p.SystemModifiable == "FALSE" {
+			p.SystemModifiable = ParameterModifiableNone
+		}
+		params = append(params, p)
+	}
+
+	return params, nil
+}
+
+// ============================================================================
+// SCHEMA MONITORING
+// ============================================================================
+
+// SchemaInfo represents schema metadata
+type SchemaInfo struct {
+	SchemaName      string
+	TotalObjects    int
+	TableCount      int
+	IndexCount      int
+	ViewCount       int
+	ProcedureCount  int
+	FunctionCount   int
+	PackageCount    int
+	ConID           int
+}
+
+// GetSchemas retrieves all schemas with object counts, only from the named
+// PDB unless pdb is empty
+func (s *OracleService) GetSchemas(ctx context.Context, userID uuid.UUID, pdb string) ([]*SchemaInfo, error) {
+	rows, err := s.oracleDB.DB.QueryContext(ctx, oracle.QuerySchemas, pdbBind(pdb), pdbBind(pdb))
+	if err != nil {
+		s.auditQueryFailure(ctx, userID, "GET_SCHEMAS", err)
+		return nil, fmt.Errorf("failed to query schemas: %w", err)
+	}
+	defer rows.Close()
+
+	schemas := []*SchemaInfo{}
+	for rows.Next() {
+		schema := &SchemaInfo{}
+		err := rows.Scan(
+			&schema.SchemaName,
+			&schema.TotalObjects,
 			&schema.TableCount,
 			&schema.IndexCount,
 			&schema.ViewCount,
 			&schema.ProcedureCount,
 			&schema.FunctionCount,
 			&schema.PackageCount,
+			&schema.ConID,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan schema: %w", err)
+		}
+		schemas = append(schemas, schema)
+	}
+
+	s.auditQuerySuccess(ctx, userID, "GET_SCHEMAS", len(schemas))
+	return schemas, nil
+}
+
+// fetchInvalidObjectCounts counts invalid objects per schema without auditing (for background use)
+func (s *OracleService) fetchInvalidObjectCounts(ctx context.Context) (map[string]int, error) {
+	rows, err := s.oracleDB.DB.QueryContext(ctx, oracle.QueryInvalidObjectCounts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query invalid object counts: %w", err)
+	}
+	defer rows.Close()
+
+	counts := map[string]int{}
+	for rows.Next() {
+		var schema string
+		var count int
+		if err := rows.Scan(&schema, &count); err != nil {
+			return nil, fmt.Errorf("failed to scan invalid object count: %w", err)
+		}
+		counts[schema] = count
+	}
+
+	return counts, nil
+}
+
+// InvalidObject is a stored object that failed to compile, with the errors
+// recorded by its last compilation
+type InvalidObject struct {
+	Owner       string
+	ObjectName  string
+	ObjectType  string
+	Status      string
+	LastDDLTime *time.Time
+	Created     *time.Time
+	ConID       int
+	Errors      []*CompileError
+}
+
+// CompileError is one cdb_errors line; Attribute is ERROR or WARNING
+type CompileError struct {
+	Line      int
+	Position  int
+	Text      string
+	Attribute string
+}
+
+// RecompileResult is the outcome of recompiling one object. Error is set when
+// the compile statement itself failed.
+type RecompileResult struct {
+	Owner        string
+	ObjectName   string
+	ObjectType   string
+	StatusBefore string
+	StatusAfter  string
+	Error        *string
+	Errors       []*CompileError
+}
+
+// compileStatements are the statements recompiling each object type
+var compileStatements = map[string]string{
+	"PACKAGE":           "ALTER PACKAGE %s COMPILE",
+	"PACKAGE BODY":      "ALTER PACKAGE %s COMPILE BODY",
+	"TYPE":              "ALTER TYPE %s COMPILE",
+	"TYPE BODY":         "ALTER TYPE %s COMPILE BODY",
+	"PROCEDURE":         "ALTER PROCEDURE %s COMPILE",
+	"FUNCTION":          "ALTER FUNCTION %s COMPILE",
+	"TRIGGER":           "ALTER TRIGGER %s COMPILE",
+	"VIEW":              "ALTER VIEW %s COMPILE",
+	"MATERIALIZED VIEW": "ALTER MATERIALIZED VIEW %s COMPILE",
+	"SYNONYM":           "ALTER SYNONYM %s COMPILE",
+}
+
+// GetInvalidObjects retrieves invalid objects with their compile errors,
+// optionally only those of one PDB or schema
+func (s *OracleService) GetInvalidObjects(ctx context.Context, userID uuid.UUID, schemaName, pdb string) ([]*InvalidObject, error) {
+	objects, err := s.fetchInvalidObjects(ctx, strings.ToUpper(schemaName), pdb)
+	if err != nil {
+		s.auditQueryFailure(ctx, userID, "GET_INVALID_OBJECTS", err)
+		return nil, err
+	}
+
+	s.auditQuerySuccess(ctx, userID, "GET_INVALID_OBJECTS", len(objects))
+	return objects, nil
+}
+
+func (s *OracleService) fetchInvalidObjects(ctx context.Context, owner, pdb string) ([]*InvalidObject, error) {
+	ownerBind := sql.NullString{String: owner, Valid: owner != ""}
+	rows, err := s.oracleDB.DB.QueryContext(ctx, oracle.QueryInvalidObjects, pdbBind(pdb), pdbBind(pdb), ownerBind, ownerBind)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query invalid objects: %w", err)
+	}
+	defer rows.Close()
+
+	objects := []*InvalidObject{}
+	for rows.Next() {
+		obj := &InvalidObject{}
+		err := rows.Scan(
+			&obj.Owner,
+			&obj.ObjectName,
+			&obj.ObjectType,
+			&obj.Status,
+			&obj.LastDDLTime,
+			&obj.Created,
+			&obj.ConID,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan invalid object: %w", err)
+		}
+		objects = append(objects, obj)
+	}
+
+	compileErrors, err := s.fetchCompileErrors(ctx, owner, pdb)
+	if err != nil {
+		return nil, err
+	}
+	for _, obj := range objects {
+		obj.Errors = compileErrorsOf(compileErrors, obj.ConID, obj.Owner, obj.ObjectType, obj.ObjectName)
+	}
+
+	return objects, nil
+}
+
+// fetchCompileErrors reads cdb_errors keyed by container, owner, object type
+// and name
+func (s *OracleService) fetchCompileErrors(ctx context.Context, owner, pdb string) (map[string][]*CompileError, error) {
+	ownerBind := sql.NullString{String: owner, Valid: owner != ""}
+	rows, err := s.oracleDB.DB.QueryContext(ctx, oracle.QueryObjectErrors, pdbBind(pdb), pdbBind(pdb), ownerBind, ownerBind)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query compile errors: %w", err)
+	}
+	defer rows.Close()
+
+	compileErrors := map[string][]*CompileError{}
+	for rows.Next() {
+		var errOwner, name, objectType string
+		var conID int
+		e := &CompileError{}
+		if err := rows.Scan(&errOwner, &name, &objectType, &e.Line, &e.Position, &e.Text, &e.Attribute, &conID); err != nil {
+			return nil, fmt.Errorf("failed to scan compile error: %w", err)
+		}
+		e.Text = strings.TrimSpace(e.Text)
+		key := objectKey(conID, errOwner, objectType, name)
+		compileErrors[key] = append(compileErrors[key], e)
+	}
+
+	return compileErrors, nil
+}
+
+func compileErrorsOf(compileErrors map[string][]*CompileError, conID int, owner, objectType, name string) []*CompileError {
+	if errs, ok := compileErrors[objectKey(conID, owner, objectType, name)]; ok {
+		return errs
+	}
+	return []*CompileError{}
+}
+
+// RecompileObjects recompiles invalid objects of a schema: the named ones
+// with ALTER ... COMPILE, or every one with UTL_RECOMP when no names are
+// given. Each object's outcome is audited.
+func (s *OracleService) RecompileObjects(ctx context.Context, userID uuid.UUID, schema string, names []string) ([]*RecompileResult, error) {
+	schema = strings.ToUpper(strings.TrimSpace(schema))
+	if schema == "" {
+		return nil, fmt.Errorf("schema is required")
+	}
+
+	// Objects are compiled in the connected container
+	pdb, err := s.connectedPDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	invalid, err := s.fetchInvalidObjects(ctx, schema, pdb)
+	if err != nil {
+		return nil, err
+	}
+	if len(names) > 0 {
+		wanted := map[string]bool{}
+		for _, name := range names {
+			wanted[strings.ToUpper(strings.TrimSpace(name))] = true
+		}
+		invalid = slices.DeleteFunc(invalid, func(obj *InvalidObject) bool {
+			return !wanted[obj.ObjectName]
+		})
+	}
+
+	results := make([]*RecompileResult, len(invalid))
+	conIDs := make([]int, len(invalid))
+	for i, obj := range invalid {
+		conIDs[i] = obj.ConID
+		results[i] = &RecompileResult{
+			Owner:        obj.Owner,
+			ObjectName:   obj.ObjectName,
+			ObjectType:   obj.ObjectType,
+			StatusBefore: obj.Status,
+		}
+	}
+	if len(results) == 0 {
+		return results, nil
+	}
+
+	if len(names) == 0 {
+		_, err := s.oracleDB.DB.ExecContext(ctx, oracle.ExecRecompileSchema, schema)
+		if err != nil {
+			err = fmt.Errorf("failed to recompile schema %s: %w", schema, err)
+		}
+		s.auditAction(ctx, userID, "RECOMPILE_SCHEMA", "ORACLE_SCHEMA", schema, err)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		for _, result := range results {
+			if err := s.compileObject(ctx, result.Owner, result.ObjectName, result.ObjectType); err != nil {
+				errMsg := err.Error()
+				result.Error = &errMsg
+			}
+		}
+	}
+
+	compileErrors, err := s.fetchCompileErrors(ctx, schema, pdb)
+	if err != nil {
+		return nil, err
+	}
+	for i, result := range results {
+		// A failure here is audited with the object rather than aborting, so
+		// every recompiled object keeps an audit entry
+		outcome := s.oracleDB.DB.QueryRowContext(ctx, oracle.QueryObjectStatus,
+			result.Owner, result.ObjectName, result.ObjectType).Scan(&result.StatusAfter)
+		if outcome != nil {
+			result.StatusAfter = "UNKNOWN"
+			outcome = fmt.Errorf("failed to query status after recompiling: %w", outcome)
+		} else if result.Error != nil {
+			outcome = fmt.Errorf("%s", *result.Error)
+		} else if result.StatusAfter != "VALID" {
+			outcome = fmt.Errorf("still %s after recompiling", result.StatusAfter)
+		}
+		result.Errors = compileErrorsOf(compileErrors, conIDs[i], result.Owner, result.ObjectType, result.ObjectName)
+
+		resourceID := fmt.Sprintf("%s.%s (%s)", result.Owner, result.ObjectName, result.ObjectType)
+		s.auditAction(ctx, userID, "RECOMPILE_OBJECT", "ORACLE_OBJECT", resourceID, outcome)
+	}
+
+	return results, nil
+}
+
+// compileObject recompiles one object with ALTER ... COMPILE. Compiling with
+// errors is reported by Oracle as ORA-24344.
+func (s *OracleService) compileObject(ctx context.Context, owner, name, objectType string) error {
+	statement, err := compileStatement(owner, name, objectType)
+	if err != nil {
+		return err
+	}
+
+	if _, err := s.oracleDB.DB.ExecContext(ctx, statement); err != nil {
+		return fmt.Errorf("failed to compile %s %s.%s: %w", strings.ToLower(objectType), owner, name, err)
+	}
+	return nil
+}
+
+// compileStatement builds the ALTER ... COMPILE statement of one object.
+// DDL takes no bind variables; identifiers come from dba_objects and are
+// quoted. Public synonyms are owned by PUBLIC but named without an owner.
+func compileStatement(owner, name, objectType string) (string, error) {
+	if objectType == "SYNONYM" && owner == "PUBLIC" {
+		return fmt.Sprintf("ALTER PUBLIC SYNONYM %s COMPILE", quoteIdentifier(name)), nil
+	}
+
+	statement, ok := compileStatements[objectType]
+	if !ok {
+		return "", fmt.Errorf("objects of type %s cannot be recompiled individually", objectType)
+	}
+	return fmt.Sprintf(statement, quoteIdentifier(owner)+"."+quoteIdentifier(name)), nil
+}
+
+// ============================================================================
+// OPTIMIZER STATISTICS
+// ============================================================================
+
+// Optimizer statistics states of a table
+const (
+	StatisticsCurrent = "CURRENT"
+	StatisticsStale   = "STALE"   // more rows modified than STALE_PERCENT since gathered
+	StatisticsMissing = "MISSING" // never gathered
+)
+
+// TableStatistics is the state of a table's optimizer statistics with the
+// DML done since they were gathered. Locked statistics are skipped by the
+// automatic job, so they stay stale until unlocked.
+type TableStatistics struct {
+	Owner           string
+	TableName       string
+	NumRows         *int
+	LastAnalyzed    *time.Time
+	Status          string
+	Locked          bool
+	LockType        *string // ALL, DATA or CACHE
+	Inserts         int
+	Updates         int
+	Deletes         int
+	Truncated       bool
+	LastModified    *time.Time
+	PercentModified *float64
+	ConID           int
+}
+
+// SchemaStatistics summarises the optimizer statistics of one schema's tables
+type SchemaStatistics struct {
+	Owner          string
+	Tables         int
+	StaleTables    int
+	MissingTables  int
+	LockedTables   int
+	OldestAnalyzed *time.Time
+	ConID          int
+}
+
+// GatherStatsOptions tunes DBMS_STATS.GATHER_TABLE_STATS; nil fields keep
+// Oracle's automatic choice
+type GatherStatsOptions struct {
+	EstimatePercent *float64
+	Cascade         *bool
+}
+
+// AutoStatsJobHistory is the automatic statistics gathering task with its runs
+type AutoStatsJobHistory struct {
+	Enabled bool
+	Runs    []*AutoStatsJobRun
+}
+
+// AutoStatsJobRun is one run of the automatic statistics gathering job in a
+// maintenance window
+type AutoStatsJobRun struct {
+	WindowName      *string
+	WindowStartTime *time.Time
+	JobName         string
+	Status          string
+	StartTime       *time.Time
+	DurationSeconds *float64
+	ErrorCode       *int
+	Info            *string
+}
+
+// GetTableStatistics retrieves the optimizer statistics of every table,
+// optionally of one PDB or schema and only those stale, missing or locked
+func (s *OracleService) GetTableStatistics(ctx context.Context, userID uuid.UUID, schemaName string, problemsOnly bool, pdb string) ([]*TableStatistics, error) {
+	tables, err := s.fetchTableStatistics(ctx, strings.ToUpper(schemaName), "", pdb)
+	if err != nil {
+		s.auditQueryFailure(ctx, userID, "GET_TABLE_STATISTICS", err)
+		return nil, err
+	}
+
+	if problemsOnly {
+		tables = slices.DeleteFunc(tables, func(t *TableStatistics) bool {
+			return t.Status == StatisticsCurrent && !t.Locked
+		})
+	}
+
+	s.auditQuerySuccess(ctx, userID, "GET_TABLE_STATISTICS", len(tables))
+	return tables, nil
+}
+
+// GetSchemaStatistics retrieves per-schema counts of tables with stale,
+// missing or locked optimizer statistics
+func (s *OracleService) GetSchemaStatistics(ctx context.Context, userID uuid.UUID) ([]*SchemaStatistics, error) {
+	tables, err := s.fetchTableStatistics(ctx, "", "", "")
+	if err != nil {
+		s.auditQueryFailure(ctx, userID, "GET_SCHEMA_STATISTICS", err)
+		return nil, err
+	}
+
+	// Tables arrive ordered by container and owner
+	schemas := []*SchemaStatistics{}
+	var current *SchemaStatistics
+	for _, t := range tables {
+		if current == nil || current.ConID != t.ConID || current.Owner != t.Owner {
+			current = &SchemaStatistics{Owner: t.Owner, ConID: t.ConID}
+			schemas = append(schemas, current)
+		}
+		current.Tables++
+		switch t.Status {
+		case StatisticsStale:
+			current.StaleTables++
+		case StatisticsMissing:
+			current.MissingTables++
+		}
+		if t.Locked {
+			current.LockedTables++
+		}
+		if t.LastAnalyzed != nil && (current.OldestAnalyzed == nil || t.LastAnalyzed.Before(*current.OldestAnalyzed)) {
+			current.OldestAnalyzed = t.LastAnalyzed
+		}
+	}
+
+	s.auditQuerySuccess(ctx, userID, "GET_SCHEMA_STATISTICS", len(schemas))
+	return schemas, nil
+}
+
+func (s *OracleService) fetchTableStatistics(ctx context.Context, owner, tableName, pdb string) ([]*TableStatistics, error) {
+	ownerBind := sql.NullString{String: owner, Valid: owner != ""}
+	tableBind := sql.NullString{String: tableName, Valid: tableName != ""}
+
+	rows, err := s.oracleDB.DB.QueryContext(ctx, oracle.QueryTableStatistics,
+		pdbBind(pdb), pdbBind(pdb), ownerBind, ownerBind, tableBind, tableBind)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query table statistics: %w", err)
+	}
+	defer rows.Close()
+
+	tables := []*TableStatistics{}
+	for rows.Next() {
+		t := &TableStatistics{}
+		var staleStats sql.NullString
+		var truncated string
+		err := rows.Scan(
+			&t.Owner,
+			&t.TableName,
+			&t.NumRows,
+			&t.LastAnalyzed,
+			&staleStats,
+			&t.LockType,
+			&t.Inserts,
+			&t.Updates,
+			&t.Deletes,
+			&truncated,
+			&t.LastModified,
+			&t.PercentModified,
+			&t.ConID,
 		)
 		if err != nil {
-			return nil, fmt.Errorf("failed to scan schema: %w", err)
+			return nil, fmt.Errorf("failed to scan table statistics: %w", err)
 		}
-		schemas = append(schemas, schema)
+
+		switch {
+		case t.LastAnalyzed == nil:
+			t.Status = StatisticsMissing
+		case staleStats.String == "YES":
+			t.Status = StatisticsStale
+		default:
+			t.Status = StatisticsCurrent
+		}
+		t.Locked = t.LockType != nil
+		t.Truncated = truncated == "YES"
+		tables = append(tables, t)
 	}
 
-	s.auditQuerySuccess(ctx, userID, "GET_SCHEMAS", len(schemas))
-	return schemas, nil
+	return tables, nil
+}
+
+// GatherTableStats runs DBMS_STATS.GATHER_TABLE_STATS for one table and
+// returns its refreshed statistics. Names are taken as unquoted identifiers.
+// Gathering fails on tables with locked statistics.
+func (s *OracleService) GatherTableStats(ctx context.Context, userID uuid.UUID, owner, tableName string, opts GatherStatsOptions) (*TableStatistics, error) {
+	owner = strings.ToUpper(strings.TrimSpace(owner))
+	tableName = strings.ToUpper(strings.TrimSpace(tableName))
+	if owner == "" || tableName == "" {
+		return nil, fmt.Errorf("owner and table name are required")
+	}
+	if opts.EstimatePercent != nil && (*opts.EstimatePercent <= 0 || *opts.EstimatePercent > 100) {
+		return nil, fmt.Errorf("estimate percent must be between 0 and 100")
+	}
+
+	estimate := sql.NullFloat64{}
+	if opts.EstimatePercent != nil {
+		estimate = sql.NullFloat64{Float64: *opts.EstimatePercent, Valid: true}
+	}
+	cascade := sql.NullInt64{}
+	if opts.Cascade != nil {
+		cascade.Valid = true
+		if *opts.Cascade {
+			cascade.Int64 = 1
+		}
+	}
+
+	target := owner + "." + tableName
+	_, err := s.oracleDB.DB.ExecContext(ctx, oracle.ExecGatherTableStats, owner, tableName, estimate, cascade)
+	if err != nil {
+		err = fmt.Errorf("failed to gather statistics for %s: %w", target, err)
+	}
+	s.auditAction(ctx, userID, "GATHER_TABLE_STATS", "ORACLE_TABLE", target, err)
+	if err != nil {
+		return nil, err
+	}
+
+	// Statistics were gathered in the connected container
+	pdb, err := s.connectedPDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tables, err := s.fetchTableStatistics(ctx, owner, tableName, pdb)
+	if err != nil {
+		return nil, err
+	}
+	if len(tables) == 0 {
+		return nil, fmt.Errorf("table not found: %s", target)
+	}
+	return tables[0], nil
+}
+
+// GetAutoStatsJobHistory retrieves whether automatic statistics gathering is
+// enabled and its runs started in a window
+func (s *OracleService) GetAutoStatsJobHistory(ctx context.Context, userID uuid.UUID, start, end time.Time) (*AutoStatsJobHistory, error) {
+	if !end.After(start) {
+		return nil, fmt.Errorf("end must be after start")
+	}
+
+	history, err := s.fetchAutoStatsJobHistory(ctx, start, end)
+	if err != nil {
+		s.auditQueryFailure(ctx, userID, "GET_AUTO_STATS_JOB_HISTORY", err)
+		return nil, err
+	}
+
+	s.auditQuerySuccess(ctx, userID, "GET_AUTO_STATS_JOB_HISTORY", len(history.Runs))
+	return history, nil
+}
+
+func (s *OracleService) fetchAutoStatsJobHistory(ctx context.Context, start, end time.Time) (*AutoStatsJobHistory, error) {
+	history := &AutoStatsJobHistory{Runs: []*AutoStatsJobRun{}}
+
+	var status string
+	err := s.oracleDB.DB.QueryRowContext(ctx, oracle.QueryAutoStatsClient).Scan(&status)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, fmt.Errorf("failed to query automatic statistics task: %w", err)
+	}
+	history.Enabled = status == "ENABLED"
+
+	rows, err := s.oracleDB.DB.QueryContext(ctx, oracle.QueryAutoStatsJobHistory, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query automatic statistics job history: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		run := &AutoStatsJobRun{}
+		err := rows.Scan(
+			&run.WindowName,
+			&run.WindowStartTime,
+			&run.JobName,
+			&run.Status,
+			&run.StartTime,
+			&run.DurationSeconds,
+			&run.ErrorCode,
+			&run.Info,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan automatic statistics job run: %w", err)
+		}
+		history.Runs = append(history.Runs, run)
+	}
+
+	return history, nil
+}
+
+// ============================================================================
+// INDEX ANALYSIS
+// ============================================================================
+
+// Index finding types
+const (
+	IndexFindingUnusable            = "UNUSABLE"              // unusable index or partition, or disabled function-based index
+	IndexFindingUnindexedForeignKey = "UNINDEXED_FOREIGN_KEY" // parent DML locks the child table and scans it
+	IndexFindingDuplicate           = "DUPLICATE"             // same columns as another index on the table
+	IndexFindingRedundant           = "REDUNDANT"             // columns are a leading prefix of another index
+	IndexFindingUnused              = "UNUSED"                // no recorded use
+)
+
+// Index finding priorities
+const (
+	IndexPriorityHigh   = "HIGH"
+	IndexPriorityMedium = "MEDIUM"
+	IndexPriorityLow    = "LOW"
+)
+
+// Index usage sources, newest first
+const (
+	IndexUsageSourceIndexUsage  = "DBA_INDEX_USAGE"  // 12.2+, every index
+	IndexUsageSourceObjectUsage = "DBA_OBJECT_USAGE" // indexes under MONITORING USAGE
+)
+
+// UnindexedForeignKeyHighRows is the child table size from which an unindexed
+// foreign key is a high priority finding
+const UnindexedForeignKeyHighRows = 100000
+
+var indexPriorityRank = map[string]int{
+	IndexPriorityHigh:   0,
+	IndexPriorityMedium: 1,
+	IndexPriorityLow:    2,
+}
+
+// IndexAnalysis is the outcome of analysing the indexes of one or every
+// schema. UsageSource is empty when no index usage data is available, in
+// which case no index is reported as unused.
+type IndexAnalysis struct {
+	UsageSource string
+	Schemas     []*SchemaIndexFindings
+	Usage       []*IndexUsage
+}
+
+// SchemaIndexFindings are the findings on the tables of one schema, highest
+// priority first
+type SchemaIndexFindings struct {
+	SchemaName string
+	ConID      int
+	IndexCount int
+	Findings   []*IndexFinding
+}
+
+// IndexFinding is one index problem with the DDL that fixes it. IndexName
+// is empty for an unindexed foreign key; RelatedIndexName is the index that
+// makes a duplicate or redundant one unnecessary.
+type IndexFinding struct {
+	Type             string
+	Priority         string
+	Owner            string
+	TableName        string
+	IndexName        string
+	ConstraintName   string
+	Columns          []string
+	RelatedIndexName string
+	Description      string
+	DDL              string
+}
+
+// IndexUsage is the recorded use of one index. Counts are only known from
+// DBA_INDEX_USAGE; MonitoringSince only from DBA_OBJECT_USAGE.
+type IndexUsage struct {
+	Owner           string
+	IndexName       string
+	TableName       string
+	Used            bool
+	AccessCount     *int
+	ExecutionCount  *int
+	RowsReturned    *int
+	LastUsed        *time.Time
+	MonitoringSince *time.Time
+	ConID           int
+}
+
+// indexDefinition is an index as needed for the analysis
+type indexDefinition struct {
+	Owner              string
+	Name               string
+	TableOwner         string
+	TableName          string
+	IndexType          string
+	Unique             bool
+	Status             string
+	FuncIdxStatus      *string
+	Constraint         *string
+	Columns            []string
+	UnusablePartitions []string
+	ConID              int
+}
+
+// droppable reports whether the index can be dropped on its own: indexes
+// enforcing uniqueness or a key are never reported as unnecessary
+func (idx *indexDefinition) droppable() bool {
+	return !idx.Unique && idx.Constraint == nil
+}
+
+// foreignKey is a foreign key as needed for the analysis
+type foreignKey struct {
+	Owner           string
+	TableName       string
+	ConstraintName  string
+	Columns         []string
+	ReferencedOwner string
+	ReferencedTable string
+	NumRows         *int
+	ConID           int
+}
+
+// GetIndexAnalysis finds unusable, duplicate, redundant and unused indexes
+// and unindexed foreign keys, on the tables of one PDB or schema or of all
+// of them
+func (s *OracleService) GetIndexAnalysis(ctx context.Context, userID uuid.UUID, schemaName, pdb string) (*IndexAnalysis, error) {
+	analysis, err := s.analyzeIndexes(ctx, strings.ToUpper(schemaName), pdb)
+	if err != nil {
+		s.auditQueryFailure(ctx, userID, "GET_INDEX_ANALYSIS", err)
+		return nil, err
+	}
+
+	count := 0
+	for _, schema := range analysis.Schemas {
+		count += len(schema.Findings)
+	}
+	s.auditQuerySuccess(ctx, userID, "GET_INDEX_ANALYSIS", count)
+	return analysis, nil
+}
+
+func (s *OracleService) analyzeIndexes(ctx context.Context, owner, pdb string) (*IndexAnalysis, error) {
+	indexes, err := s.fetchIndexDefinitions(ctx, owner, pdb)
+	if err != nil {
+		return nil, err
+	}
+	foreignKeys, err := s.fetchForeignKeys(ctx, owner, pdb)
+	if err != nil {
+		return nil, err
+	}
+	usage, source := s.fetchIndexUsage(ctx)
+
+	analysis := &IndexAnalysis{UsageSource: source, Schemas: []*SchemaIndexFindings{}, Usage: []*IndexUsage{}}
+	bySchema := map[string]*SchemaIndexFindings{}
+	schema := func(conID int, name string) *SchemaIndexFindings {
+		key := objectKey(conID, name)
+		if bySchema[key] == nil {
+			bySchema[key] = &SchemaIndexFindings{SchemaName: name, ConID: conID, Findings: []*IndexFinding{}}
+			analysis.Schemas = append(analysis.Schemas, bySchema[key])
+		}
+		return bySchema[key]
+	}
+
+	byTable := map[string][]*indexDefinition{}
+	indexUsage := map[*indexDefinition]*IndexUsage{}
+	for _, idx := range indexes {
+		key := objectKey(idx.ConID, idx.TableOwner, idx.TableName)
+		byTable[key] = append(byTable[key], idx)
+		schema(idx.ConID, idx.TableOwner).IndexCount++
+
+		u, ok := usage[objectKey(idx.ConID, idx.Owner, idx.Name)]
+		if !ok && source == IndexUsageSourceIndexUsage {
+			// Indexes never used have no row in DBA_INDEX_USAGE
+			u, ok = &IndexUsage{Owner: idx.Owner, IndexName: idx.Name, ConID: idx.ConID}, true
+		}
+		if ok {
+			u.TableName = idx.TableName
+			indexUsage[idx] = u
+			analysis.Usage = append(analysis.Usage, u)
+		}
+	}
+
+	// flagged indexes are not also reported as unused
+	flagged := map[*indexDefinition]bool{}
+	for _, idx := range indexes {
+		if finding := unusableIndexFinding(idx); finding != nil {
+			flagged[idx] = true
+			findings := schema(idx.ConID, idx.TableOwner)
+			findings.Findings = append(findings.Findings, finding)
+		}
+	}
+	for _, tableIndexes := range byTable {
+		// Every index of a table is in the table's container
+		findings := schema(tableIndexes[0].ConID, tableIndexes[0].TableOwner)
+		findings.Findings = append(findings.Findings, duplicateIndexFindings(tableIndexes, flagged)...)
+	}
+	for _, fk := range foreignKeys {
+		if foreignKeyIndexed(fk, byTable[objectKey(fk.ConID, fk.Owner, fk.TableName)]) {
+			continue
+		}
+		findings := schema(fk.ConID, fk.Owner)
+		findings.Findings = append(findings.Findings, unindexedForeignKeyFinding(fk))
+	}
+	for _, idx := range indexes {
+		if u := indexUsage[idx]; u != nil && !u.Used && idx.droppable() && !flagged[idx] {
+			findings := schema(idx.ConID, idx.TableOwner)
+			findings.Findings = append(findings.Findings, unusedIndexFinding(idx, source))
+		}
+	}
+
+	for _, schema := range analysis.Schemas {
+		slices.SortStableFunc(schema.Findings, func(a, b *IndexFinding) int {
+			if rank := indexPriorityRank[a.Priority] - indexPriorityRank[b.Priority]; rank != 0 {
+				return rank
+			}
+			if a.TableName != b.TableName {
+				return strings.Compare(a.TableName, b.TableName)
+			}
+			return strings.Compare(a.IndexName, b.IndexName)
+		})
+	}
+	slices.SortFunc(analysis.Schemas, func(a, b *SchemaIndexFindings) int {
+		if a.ConID != b.ConID {
+			return a.ConID - b.ConID
+		}
+		return strings.Compare(a.SchemaName, b.SchemaName)
+	})
+
+	return analysis, nil
+}
+
+// objectKey identifies a schema or schema object across containers, as the
+// same name can exist in more than one PDB
+func objectKey(conID int, names ...string) string {
+	return fmt.Sprintf("%d/%s", conID, strings.Join(names, "."))
+}
+
+func (s *OracleService) fetchIndexDefinitions(ctx context.Context, owner, pdb string) ([]*indexDefinition, error) {
+	ownerBind := sql.NullString{String: owner, Valid: owner != ""}
+	rows, err := s.oracleDB.DB.QueryContext(ctx, oracle.QueryIndexes, pdbBind(pdb), pdbBind(pdb), ownerBind, ownerBind)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query indexes: %w", err)
+	}
+	defer rows.Close()
+
+	indexes := []*indexDefinition{}
+	for rows.Next() {
+		idx := &indexDefinition{}
+		var uniqueness, columns string
+		var unusablePartitions sql.NullString
+		err := rows.Scan(
+			&idx.Owner,
+			&idx.Name,
+			&idx.TableOwner,
+			&idx.TableName,
+			&idx.IndexType,
+			&uniqueness,
+			&idx.Status,
+			&idx.FuncIdxStatus,
+			&idx.Constraint,
+			&columns,
+			&unusablePartitions,
+			&idx.ConID,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan index: %w", err)
+		}
+		idx.Unique = uniqueness == "UNIQUE"
+		idx.Columns = strings.Split(columns, ",")
+		if unusablePartitions.Valid {
+			idx.UnusablePartitions = strings.Split(unusablePartitions.String, ",")
+		}
+		indexes = append(indexes, idx)
+	}
+
+	return indexes, nil
+}
+
+func (s *OracleService) fetchForeignKeys(ctx context.Context, owner, pdb string) ([]*foreignKey, error) {
+	ownerBind := sql.NullString{String: owner, Valid: owner != ""}
+	rows, err := s.oracleDB.DB.QueryContext(ctx, oracle.QueryForeignKeys, pdbBind(pdb), pdbBind(pdb), ownerBind, ownerBind)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query foreign keys: %w", err)
+	}
+	defer rows.Close()
+
+	foreignKeys := []*foreignKey{}
+	for rows.Next() {
+		fk := &foreignKey{}
+		var columns string
+		err := rows.Scan(
+			&fk.Owner,
+			&fk.TableName,
+			&fk.ConstraintName,
+			&columns,
+			&fk.ReferencedOwner,
+			&fk.ReferencedTable,
+			&fk.NumRows,
+			&fk.ConID,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan foreign key: %w", err)
+		}
+		fk.Columns = strings.Split(columns, ",")
+		foreignKeys = append(foreignKeys, fk)
+	}
+
+	return foreignKeys, nil
+}
+
+// fetchIndexUsage reads index usage keyed by objectKey from the newest
+// source available. Usage is optional, so an unavailable source (older
+// release, no privilege) is skipped rather than failing the analysis.
+func (s *OracleService) fetchIndexUsage(ctx context.Context) (map[string]*IndexUsage, string) {
+	if usage, err := s.fetchIndexUsageStats(ctx); err == nil {
+		return usage, IndexUsageSourceIndexUsage
+	}
+	if usage, err := s.fetchMonitoredIndexUsage(ctx); err == nil && len(usage) > 0 {
+		return usage, IndexUsageSourceObjectUsage
+	}
+	return map[string]*IndexUsage{}, ""
+}
+
+func (s *OracleService) fetchIndexUsageStats(ctx context.Context) (map[string]*IndexUsage, error) {
+	rows, err := s.oracleDB.DB.QueryContext(ctx, oracle.QueryIndexUsage)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query index usage: %w", err)
+	}
+	defer rows.Close()
+
+	usage := map[string]*IndexUsage{}
+	for rows.Next() {
+		u := &IndexUsage{}
+		err := rows.Scan(&u.Owner, &u.IndexName, &u.AccessCount, &u.ExecutionCount, &u.RowsReturned, &u.LastUsed, &u.ConID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan index usage: %w", err)
+		}
+		u.Used = u.AccessCount != nil && *u.AccessCount > 0
+		usage[objectKey(u.ConID, u.Owner, u.IndexName)] = u
+	}
+
+	return usage, nil
+}
+
+func (s *OracleService) fetchMonitoredIndexUsage(ctx context.Context) (map[string]*IndexUsage, error) {
+	rows, err := s.oracleDB.DB.QueryContext(ctx, oracle.QueryObjectUsage)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query monitored index usage: %w", err)
+	}
+	defer rows.Close()
+
+	usage := map[string]*IndexUsage{}
+	for rows.Next() {
+		u := &IndexUsage{}
+		var used string
+		if err := rows.Scan(&u.Owner, &u.IndexName, &used, &u.MonitoringSince, &u.ConID); err != nil {
+			return nil, fmt.Errorf("failed to scan monitored index usage: %w", err)
+		}
+		u.Used = used == "YES"
+		usage[objectKey(u.ConID, u.Owner, u.IndexName)] = u
+	}
+
+	return usage, nil
+}
+
+func unusableIndexFinding(idx *indexDefinition) *IndexFinding {
+	finding := &IndexFinding{
+		Type:      IndexFindingUnusable,
+		Priority:  IndexPriorityHigh,
+		Owner:     idx.TableOwner,
+		TableName: idx.TableName,
+		IndexName: idx.Name,
+		Columns:   idx.Columns,
+	}
+	if idx.Constraint != nil {
+		finding.ConstraintName = *idx.Constraint
+	}
+
+	name := quoteIdentifier(idx.Owner) + "." + quoteIdentifier(idx.Name)
+	switch {
+	case idx.Status == "UNUSABLE":
+		finding.Description = "Index is unusable: the optimizer ignores it and DML fails if it enforces uniqueness"
+		finding.DDL = fmt.Sprintf("ALTER INDEX %s REBUILD;", name)
+	case len(idx.UnusablePartitions) > 0:
+		finding.Description = fmt.Sprintf("%d index partitions are unusable", len(idx.UnusablePartitions))
+		statements := make([]string, len(idx.UnusablePartitions))
+		for i, partition := range idx.UnusablePartitions {
+			statements[i] = fmt.Sprintf("ALTER INDEX %s REBUILD PARTITION %s;", name, quoteIdentifier(partition))
+		}
+		finding.DDL = strings.Join(statements, "\n")
+	case idx.FuncIdxStatus != nil && *idx.FuncIdxStatus == "DISABLED":
+		finding.Description = "Function-based index is disabled, usually because its function was changed"
+		finding.DDL = fmt.Sprintf("ALTER INDEX %s ENABLE;", name)
+	default:
+		return nil
+	}
+	return finding
+}
+
+// duplicateIndexFindings compares the indexes of one table: an index with the
+// same columns as another is a duplicate, one whose columns lead another is
+// redundant. Indexes enforcing uniqueness or a key are kept.
+func duplicateIndexFindings(indexes []*indexDefinition, flagged map[*indexDefinition]bool) []*IndexFinding {
+	// Prefer keeping indexes that cannot be dropped, then the widest ones, so
+	// that an index redundant to several is related to one that is kept
+	sorted := slices.Clone(indexes)
+	slices.SortStableFunc(sorted, func(a, b *indexDefinition) int {
+		if a.droppable() != b.droppable() {
+			if a.droppable() {
+				return 1
+			}
+			return -1
+		}
+		if len(a.Columns) != len(b.Columns) {
+			return len(b.Columns) - len(a.Columns)
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	findings := []*IndexFinding{}
+	for i, idx := range sorted {
+		if !idx.droppable() {
+			continue
+		}
+		for j, other := range sorted {
+			if i == j || flagged[other] || idx.IndexType != other.IndexType || len(idx.Columns) > len(other.Columns) {
+				continue
+			}
+			if !slices.Equal(idx.Columns, other.Columns[:len(idx.Columns)]) {
+				continue
+			}
+
+			finding := &IndexFinding{
+				Owner:            idx.TableOwner,
+				TableName:        idx.TableName,
+				IndexName:        idx.Name,
+				Columns:          idx.Columns,
+				RelatedIndexName: other.Name,
+				DDL:              fmt.Sprintf("DROP INDEX %s.%s;", quoteIdentifier(idx.Owner), quoteIdentifier(idx.Name)),
+			}
+			if len(idx.Columns) == len(other.Columns) {
+				// Of two identical droppable indexes only the later one is dropped
+				if j > i && other.droppable() {
+					continue
+				}
+				finding.Type = IndexFindingDuplicate
+				finding.Priority = IndexPriorityMedium
+				finding.Description = fmt.Sprintf("Same columns as %s; every DML maintains both", other.Name)
+			} else {
+				finding.Type = IndexFindingRedundant
+				finding.Priority = IndexPriorityLow
+				finding.Description = fmt.Sprintf("Columns are the leading columns of %s, which can serve the same lookups", other.Name)
+			}
+			flagged[idx] = true
+			findings = append(findings, finding)
+			break
+		}
+	}
+
+	return findings
+}
+
+// foreignKeyIndexed reports whether an index on the child table starts with
+// the foreign key columns, in any order
+func foreignKeyIndexed(fk *foreignKey, indexes []*indexDefinition) bool {
+	for _, idx := range indexes {
+		if len(idx.Columns) < len(fk.Columns) {
+			continue
+		}
+		leading := slices.Clone(idx.Columns[:len(fk.Columns)])
+		columns := slices.Clone(fk.Columns)
+		slices.Sort(leading)
+		slices.Sort(columns)
+		if slices.Equal(leading, columns) {
+			return true
+		}
+	}
+	return false
+}
+
+func unindexedForeignKeyFinding(fk *foreignKey) *IndexFinding {
+	priority := IndexPriorityMedium
+	if fk.NumRows != nil && *fk.NumRows >= UnindexedForeignKeyHighRows {
+		priority = IndexPriorityHigh
+	}
+
+	// Keep generated names within the 30 character limit of older releases
+	indexName := fk.ConstraintName + "_IX"
+	if len(indexName) > 30 {
+		indexName = fk.ConstraintName[:27] + "_IX"
+	}
+
+	columns := make([]string, len(fk.Columns))
+	for i, column := range fk.Columns {
+		columns[i] = quoteIdentifier(column)
+	}
+
+	return &IndexFinding{
+		Type:           IndexFindingUnindexedForeignKey,
+		Priority:       priority,
+		Owner:          fk.Owner,
+		TableName:      fk.TableName,
+		ConstraintName: fk.ConstraintName,
+		Columns:        fk.Columns,
+		Description: fmt.Sprintf("Foreign key to %s.%s has no index: updating or deleting parent rows locks and scans %s",
+			fk.ReferencedOwner, fk.ReferencedTable, fk.TableName),
+		DDL: fmt.Sprintf("CREATE INDEX %s.%s ON %s.%s (%s);",
+			quoteIdentifier(fk.Owner), quoteIdentifier(indexName),
+			quoteIdentifier(fk.Owner), quoteIdentifier(fk.TableName), strings.Join(columns, ", ")),
+	}
+}
+
+func unusedIndexFinding(idx *indexDefinition, source string) *IndexFinding {
+	return &IndexFinding{
+		Type:      IndexFindingUnused,
+		Priority:  IndexPriorityLow,
+		Owner:     idx.TableOwner,
+		TableName: idx.TableName,
+		IndexName: idx.Name,
+		Columns:   idx.Columns,
+		Description: fmt.Sprintf("No use recorded in %s; make it INVISIBLE over a full business cycle before dropping it",
+			source),
+		DDL: fmt.Sprintf("DROP INDEX %s.%s;", quoteIdentifier(idx.Owner), quoteIdentifier(idx.Name)),
+	}
+}
+
+// quoteIdentifier quotes an Oracle identifier for generated DDL
+func quoteIdentifier(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
 }
 
 // ============================================================================
@@ -502,3 +4811,21 @@ func (s *OracleService) auditQueryFailure(ctx context.Context, userID uuid.UUID,
 	}
 	_ = s.auditRepo.Create(ctx, log)
 }
+
+// auditAction records a change made to the monitored database, failed or not
+func (s *OracleService) auditAction(ctx context.Context, userID uuid.UUID, action, resourceType, resourceID string, err error) {
+	log := &repository.AuditLog{
+		UserID:       &userID,
+		Username:     userID.String(),
+		Action:       action,
+		ResourceType: resourceType,
+		ResourceID:   &resourceID,
+		Status:       "SUCCESS",
+	}
+	if err != nil {
+		errMsg := err.Error()
+		log.Status = "FAILURE"
+		log.ErrorMessage = &errMsg
+	}
+	_ = s.auditRepo.Create(ctx, log)
+}
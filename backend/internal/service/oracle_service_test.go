@@ -0,0 +1,249 @@
+package service
+
+import (
+	"strings"
+	"testing"
+)
+
+// testIndex is a droppable NORMAL index on SCOTT.ORDERS
+func testIndex(name string, columns ...string) *indexDefinition {
+	return &indexDefinition{
+		Owner: "SCOTT", Name: name, TableOwner: "SCOTT", TableName: "ORDERS",
+		IndexType: "NORMAL", Status: "VALID", Columns: columns,
+	}
+}
+
+func uniqueIndex(name string, columns ...string) *indexDefinition {
+	idx := testIndex(name, columns...)
+	idx.Unique = true
+	return idx
+}
+
+func constraintIndex(name string, columns ...string) *indexDefinition {
+	idx := testIndex(name, columns...)
+	idx.Constraint = &name
+	return idx
+}
+
+func TestDuplicateIndexFindings(t *testing.T) {
+	bitmap := testIndex("ORD_STATUS_BIX", "STATUS")
+	bitmap.IndexType = "BITMAP"
+
+	type want struct{ findingType, related string }
+	tests := []struct {
+		name    string
+		indexes []*indexDefinition
+		flagged []string // indexes already reported as unusable
+		want    map[string]want
+	}{
+		{
+			name:    "identical droppable indexes drop the later one",
+			indexes: []*indexDefinition{testIndex("ORD_B", "CUST_ID", "ORDER_DATE"), testIndex("ORD_A", "CUST_ID", "ORDER_DATE")},
+			want:    map[string]want{"ORD_B": {IndexFindingDuplicate, "ORD_A"}},
+		},
+		{
+			name:    "duplicate of a unique index",
+			indexes: []*indexDefinition{testIndex("ORD_A", "ORDER_ID"), uniqueIndex("ORD_UK", "ORDER_ID")},
+			want:    map[string]want{"ORD_A": {IndexFindingDuplicate, "ORD_UK"}},
+		},
+		{
+			name:    "redundant to a constraint index",
+			indexes: []*indexDefinition{constraintIndex("ORD_PK", "ORDER_ID", "LINE_NO"), testIndex("ORD_A", "ORDER_ID")},
+			want:    map[string]want{"ORD_A": {IndexFindingRedundant, "ORD_PK"}},
+		},
+		{
+			name:    "leading columns are redundant",
+			indexes: []*indexDefinition{testIndex("ORD_A", "CUST_ID"), testIndex("ORD_B", "CUST_ID", "ORDER_DATE")},
+			want:    map[string]want{"ORD_A": {IndexFindingRedundant, "ORD_B"}},
+		},
+		{
+			name: "prefix chain relates to the widest index",
+			indexes: []*indexDefinition{
+				testIndex("ORD_A", "CUST_ID"),
+				testIndex("ORD_B", "CUST_ID", "ORDER_DATE"),
+				testIndex("ORD_C", "CUST_ID", "ORDER_DATE", "STATUS"),
+			},
+			want: map[string]want{
+				"ORD_A": {IndexFindingRedundant, "ORD_C"},
+				"ORD_B": {IndexFindingRedundant, "ORD_C"},
+			},
+		},
+		{
+			name:    "shared leading column only overlaps",
+			indexes: []*indexDefinition{testIndex("ORD_A", "CUST_ID", "STATUS"), testIndex("ORD_B", "CUST_ID", "ORDER_DATE")},
+			want:    map[string]want{},
+		},
+		{
+			name:    "same columns in another order",
+			indexes: []*indexDefinition{testIndex("ORD_A", "CUST_ID", "ORDER_DATE"), testIndex("ORD_B", "ORDER_DATE", "CUST_ID")},
+			want:    map[string]want{},
+		},
+		{
+			name:    "columns not leading the other index",
+			indexes: []*indexDefinition{testIndex("ORD_A", "ORDER_DATE"), testIndex("ORD_B", "CUST_ID", "ORDER_DATE")},
+			want:    map[string]want{},
+		},
+		{
+			name:    "unique index redundant to a wider one is kept",
+			indexes: []*indexDefinition{uniqueIndex("ORD_UK", "ORDER_ID"), testIndex("ORD_A", "ORDER_ID", "STATUS")},
+			want:    map[string]want{},
+		},
+		{
+			name:    "different index types",
+			indexes: []*indexDefinition{bitmap, testIndex("ORD_STATUS_IX", "STATUS", "ORDER_DATE")},
+			want:    map[string]want{},
+		},
+		{
+			name:    "unusable index does not cover others",
+			indexes: []*indexDefinition{testIndex("ORD_A", "CUST_ID"), testIndex("ORD_B", "CUST_ID", "ORDER_DATE")},
+			flagged: []string{"ORD_B"},
+			want:    map[string]want{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flagged := map[*indexDefinition]bool{}
+			for _, idx := range tt.indexes {
+				for _, name := range tt.flagged {
+					if idx.Name == name {
+						flagged[idx] = true
+					}
+				}
+			}
+
+			findings := duplicateIndexFindings(tt.indexes, flagged)
+			if len(findings) != len(tt.want) {
+				t.Fatalf("got %d findings, want %d: %+v", len(findings), len(tt.want), findings)
+			}
+			for _, finding := range findings {
+				w, ok := tt.want[finding.IndexName]
+				if !ok {
+					t.Errorf("unexpected finding on %s", finding.IndexName)
+					continue
+				}
+				if finding.Type != w.findingType || finding.RelatedIndexName != w.related {
+					t.Errorf("%s: %s related to %s, want %s related to %s",
+						finding.IndexName, finding.Type, finding.RelatedIndexName, w.findingType, w.related)
+				}
+				if finding.DDL != `DROP INDEX "SCOTT".`+`"`+finding.IndexName+`";` {
+					t.Errorf("%s: DDL = %q", finding.IndexName, finding.DDL)
+				}
+			}
+		})
+	}
+}
+
+func TestForeignKeyIndexed(t *testing.T) {
+	fk := &foreignKey{Owner: "SCOTT", TableName: "ORDERS", ConstraintName: "ORD_CUST_FK", Columns: []string{"CUST_ID", "REGION_ID"}}
+
+	tests := []struct {
+		name    string
+		indexes []*indexDefinition
+		want    bool
+	}{
+		{"no indexes", nil, false},
+		{"exact columns", []*indexDefinition{testIndex("ORD_A", "CUST_ID", "REGION_ID")}, true},
+		{"leading columns in another order", []*indexDefinition{testIndex("ORD_A", "REGION_ID", "CUST_ID", "ORDER_DATE")}, true},
+		{"index on part of the key", []*indexDefinition{testIndex("ORD_A", "CUST_ID")}, false},
+		{"key columns not leading", []*indexDefinition{testIndex("ORD_A", "CUST_ID", "ORDER_DATE", "REGION_ID")}, false},
+		{"second index covers", []*indexDefinition{testIndex("ORD_A", "ORDER_DATE"), testIndex("ORD_B", "CUST_ID", "REGION_ID", "STATUS")}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := foreignKeyIndexed(fk, tt.indexes); got != tt.want {
+				t.Errorf("foreignKeyIndexed() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnindexedForeignKeyFinding(t *testing.T) {
+	rows := func(n int) *int { return &n }
+
+	tests := []struct {
+		name         string
+		constraint   string
+		numRows      *int
+		wantPriority string
+		wantIndex    string
+	}{
+		{"no statistics", "ORD_CUST_FK", nil, IndexPriorityMedium, `"ORD_CUST_FK_IX"`},
+		{"small table", "ORD_CUST_FK", rows(UnindexedForeignKeyHighRows - 1), IndexPriorityMedium, `"ORD_CUST_FK_IX"`},
+		{"large table", "ORD_CUST_FK", rows(UnindexedForeignKeyHighRows), IndexPriorityHigh, `"ORD_CUST_FK_IX"`},
+		{"long constraint name is truncated", "ORDERS_CUSTOMER_ACCOUNT_REGION_FK", nil, IndexPriorityMedium, `"ORDERS_CUSTOMER_ACCOUNT_REG_IX"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fk := &foreignKey{
+				Owner: "SCOTT", TableName: "ORDERS", ConstraintName: tt.constraint,
+				Columns: []string{"CUST_ID", "REGION_ID"}, ReferencedOwner: "SCOTT", ReferencedTable: "CUSTOMERS",
+				NumRows: tt.numRows,
+			}
+
+			finding := unindexedForeignKeyFinding(fk)
+			if finding.Priority != tt.wantPriority {
+				t.Errorf("priority = %s, want %s", finding.Priority, tt.wantPriority)
+			}
+			wantDDL := `CREATE INDEX "SCOTT".` + tt.wantIndex + ` ON "SCOTT"."ORDERS" ("CUST_ID", "REGION_ID");`
+			if finding.DDL != wantDDL {
+				t.Errorf("DDL = %q\nwant  %q", finding.DDL, wantDDL)
+			}
+			if name := strings.Trim(tt.wantIndex, `"`); len(name) > 30 {
+				t.Errorf("generated index name %s is longer than 30 characters", name)
+			}
+		})
+	}
+}
+
+func TestCompileStatement(t *testing.T) {
+	tests := []struct {
+		owner, name, objectType string
+		want                    string
+		wantErr                 bool
+	}{
+		{"SCOTT", "PAYROLL", "PACKAGE BODY", `ALTER PACKAGE "SCOTT"."PAYROLL" COMPILE BODY`, false},
+		{"SCOTT", "EMP_V", "VIEW", `ALTER VIEW "SCOTT"."EMP_V" COMPILE`, false},
+		{"SCOTT", "EMP", "SYNONYM", `ALTER SYNONYM "SCOTT"."EMP" COMPILE`, false},
+		{"PUBLIC", "EMP", "SYNONYM", `ALTER PUBLIC SYNONYM "EMP" COMPILE`, false},
+		{"PUBLIC", "EMP_V", "VIEW", `ALTER VIEW "PUBLIC"."EMP_V" COMPILE`, false},
+		{"SCOTT", `ODD"NAME`, "PROCEDURE", `ALTER PROCEDURE "SCOTT"."ODD""NAME" COMPILE`, false},
+		{"SCOTT", "EMP", "TABLE", "", true},
+	}
+
+	for _, tt := range tests {
+		got, err := compileStatement(tt.owner, tt.name, tt.objectType)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("compileStatement(%s, %s, %s) error = %v, wantErr %v", tt.owner, tt.name, tt.objectType, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("compileStatement(%s, %s, %s) = %q, want %q", tt.owner, tt.name, tt.objectType, got, tt.want)
+		}
+	}
+}
+
+func TestLongOperationCompleted(t *testing.T) {
+	tests := []struct {
+		name             string
+		soFar, totalWork int
+		want             bool
+	}{
+		{"in progress", 40, 100, false},
+		{"done", 100, 100, true},
+		{"past the estimate", 120, 100, true},
+		{"total work not yet known", 0, 0, false},
+		{"work done before total is known", 15, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			op := &LongOperation{SoFar: tt.soFar, TotalWork: tt.totalWork}
+			if got := op.Completed(); got != tt.want {
+				t.Errorf("Completed() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
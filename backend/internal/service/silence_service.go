@@ -0,0 +1,365 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aashiq-04/oracle-dba/internal/repository"
+)
+
+// Maintenance window recurrences
+const (
+	RecurrenceOnce    = "ONCE"
+	RecurrenceDaily   = "DAILY"
+	RecurrenceWeekly  = "WEEKLY"
+	RecurrenceMonthly = "MONTHLY"
+)
+
+// Alert labels silences can match on
+const (
+	LabelTarget   = "target"
+	LabelRule     = "rule"
+	LabelMetric   = "metric"
+	LabelSeverity = "severity"
+	LabelObject   = "object"
+)
+
+var silenceLabels = map[string]bool{
+	LabelTarget:   true,
+	LabelRule:     true,
+	LabelMetric:   true,
+	LabelSeverity: true,
+	LabelObject:   true,
+}
+
+// Shortest period of each recurrence; a window must be shorter than it
+var recurrencePeriods = map[string]time.Duration{
+	RecurrenceOnce:    0,
+	RecurrenceDaily:   24 * time.Hour,
+	RecurrenceWeekly:  7 * 24 * time.Hour,
+	RecurrenceMonthly: 28 * 24 * time.Hour,
+}
+
+// Suppression explains why an alert notification was not delivered
+type Suppression struct {
+	Reason       string
+	SuppressedBy *uuid.UUID
+}
+
+// SilenceService manages maintenance windows and ad hoc silences, and decides
+// whether an alert's notifications are currently suppressed
+type SilenceService struct {
+	silenceRepo repository.SilenceRepository
+	windowRepo  repository.MaintenanceWindowRepository
+	auditRepo   repository.AuditLogRepository
+}
+
+// NewSilenceService creates a new silence service
+func NewSilenceService(
+	silenceRepo repository.SilenceRepository,
+	windowRepo repository.MaintenanceWindowRepository,
+	auditRepo repository.AuditLogRepository,
+) *SilenceService {
+	return &SilenceService{
+		silenceRepo: silenceRepo,
+		windowRepo:  windowRepo,
+		auditRepo:   auditRepo,
+	}
+}
+
+// CheckSuppression returns why notifications for alert are suppressed at the
+// given time, or nil if they are not. Maintenance windows take precedence.
+func (s *SilenceService) CheckSuppression(ctx context.Context, alert *repository.Alert, at time.Time) (*Suppression, error) {
+	windows, err := s.windowRepo.ListEnabled(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, window := range windows {
+		if !matchPattern(&window.Target, alert.Target) {
+			continue
+		}
+		if _, end, ok := maintenanceOccurrence(window, at); ok {
+			id := window.ID
+			return &Suppression{
+				Reason: fmt.Sprintf("target %s in maintenance window %q until %s",
+					alert.Target, window.Name, end.Format(time.RFC3339)),
+				SuppressedBy: &id,
+			}, nil
+		}
+	}
+
+	silences, err := s.silenceRepo.ListActive(ctx, at)
+	if err != nil {
+		return nil, err
+	}
+
+	labels := alertLabels(alert)
+	for _, silence := range silences {
+		if !silenceMatches(silence, labels) {
+			continue
+		}
+		id := silence.ID
+		return &Suppression{
+			Reason: fmt.Sprintf("silenced by %s until %s: %s",
+				silence.CreatedByName, silence.EndsAt.Format(time.RFC3339), silence.Comment),
+			SuppressedBy: &id,
+		}, nil
+	}
+
+	return nil, nil
+}
+
+func alertLabels(alert *repository.Alert) map[string]string {
+	return map[string]string{
+		LabelTarget:   alert.Target,
+		LabelRule:     alert.RuleName,
+		LabelMetric:   alert.Metric,
+		LabelSeverity: alert.Severity,
+		LabelObject:   alert.ObjectKey,
+	}
+}
+
+func silenceMatches(silence *repository.Silence, labels map[string]string) bool {
+	for label, pattern := range silence.Matchers {
+		pattern := pattern
+		if !matchPattern(&pattern, labels[label]) {
+			return false
+		}
+	}
+	return true
+}
+
+// MaintenanceWindowActive reports whether a window covers the given time
+func MaintenanceWindowActive(window *repository.MaintenanceWindow, at time.Time) bool {
+	if !window.Enabled {
+		return false
+	}
+	_, _, ok := maintenanceOccurrence(window, at)
+	return ok
+}
+
+// maintenanceOccurrence finds the occurrence of a window covering at. Each
+// recurrence repeats the first occurrence's wall-clock time in the window's
+// timezone, so windows follow daylight saving changes.
+func maintenanceOccurrence(window *repository.MaintenanceWindow, at time.Time) (time.Time, time.Time, bool) {
+	loc, err := time.LoadLocation(window.Timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+
+	first := window.StartsAt.In(loc)
+	now := at.In(loc)
+	duration := time.Duration(window.DurationMinutes) * time.Minute
+
+	if now.Before(first) {
+		return time.Time{}, time.Time{}, false
+	}
+	if window.Until != nil && now.After(*window.Until) {
+		return time.Time{}, time.Time{}, false
+	}
+
+	// Latest occurrence starting at or before now
+	start := first
+	switch window.Recurrence {
+	case RecurrenceDaily:
+		start = atClock(now, first, loc)
+		if start.After(now) {
+			start = start.AddDate(0, 0, -1)
+		}
+	case RecurrenceWeekly:
+		daysBack := (int(now.Weekday()) - int(first.Weekday()) + 7) % 7
+		start = atClock(now.AddDate(0, 0, -daysBack), first, loc)
+		if start.After(now) {
+			start = start.AddDate(0, 0, -7)
+		}
+	case RecurrenceMonthly:
+		start = monthlyOccurrence(now.Year(), now.Month(), first, loc)
+		if start.After(now) {
+			start = monthlyOccurrence(now.Year(), now.Month()-1, first, loc)
+		}
+	}
+
+	end := start.Add(duration)
+	if now.Before(end) {
+		return start, end, true
+	}
+	return time.Time{}, time.Time{}, false
+}
+
+// monthlyOccurrence returns the occurrence of a monthly window in the given
+// month. Days missing from shorter months are clamped to the month's last
+// day, so a window anchored on the 31st runs on Feb 28 (or 29).
+func monthlyOccurrence(year int, month time.Month, first time.Time, loc *time.Location) time.Time {
+	// Day 0 of the next month is the last day of this one; normalizes month 0 too
+	lastDay := time.Date(year, month+1, 0, 0, 0, 0, 0, loc)
+	day := first.Day()
+	if day > lastDay.Day() {
+		day = lastDay.Day()
+	}
+	return time.Date(lastDay.Year(), lastDay.Month(), day,
+		first.Hour(), first.Minute(), first.Second(), 0, loc)
+}
+
+// atClock returns day's date at clock's time of day
+func atClock(day, clock time.Time, loc *time.Location) time.Time {
+	return time.Date(day.Year(), day.Month(), day.Day(),
+		clock.Hour(), clock.Minute(), clock.Second(), 0, loc)
+}
+
+// ============================================================================
+// SILENCES
+// ============================================================================
+
+// ListSilences returns current and upcoming silences, optionally with expired ones
+func (s *SilenceService) ListSilences(ctx context.Context, includeExpired bool) ([]*repository.Silence, error) {
+	return s.silenceRepo.List(ctx, includeExpired)
+}
+
+// CreateSilence validates and stores an ad hoc silence authored by a user
+func (s *SilenceService) CreateSilence(ctx context.Context, userID uuid.UUID, username string, silence *repository.Silence) error {
+	if len(silence.Matchers) == 0 {
+		return fmt.Errorf("at least one matcher is required")
+	}
+	for label, pattern := range silence.Matchers {
+		if !silenceLabels[label] {
+			return fmt.Errorf("unknown label: %s", label)
+		}
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid pattern %q: %w", pattern, err)
+		}
+	}
+	if strings.TrimSpace(silence.Comment) == "" {
+		return fmt.Errorf("comment is required")
+	}
+	if silence.StartsAt.IsZero() {
+		silence.StartsAt = time.Now()
+	}
+	if !silence.EndsAt.After(silence.StartsAt) {
+		return fmt.Errorf("silence must end after it starts")
+	}
+
+	silence.CreatedBy = &userID
+	silence.CreatedByName = username
+	if err := s.silenceRepo.Create(ctx, silence); err != nil {
+		return fmt.Errorf("failed to create silence: %w", err)
+	}
+
+	s.auditSilenceAction(ctx, userID, "CREATE_SILENCE", "SILENCE", silence.ID)
+	return nil
+}
+
+// ExpireSilence ends a silence immediately
+func (s *SilenceService) ExpireSilence(ctx context.Context, userID, id uuid.UUID) (*repository.Silence, error) {
+	if err := s.silenceRepo.Expire(ctx, id, time.Now()); err != nil {
+		return nil, err
+	}
+
+	s.auditSilenceAction(ctx, userID, "EXPIRE_SILENCE", "SILENCE", id)
+	return s.silenceRepo.GetByID(ctx, id)
+}
+
+// ============================================================================
+// MAINTENANCE WINDOWS
+// ============================================================================
+
+// ListMaintenanceWindows returns all maintenance windows
+func (s *SilenceService) ListMaintenanceWindows(ctx context.Context) ([]*repository.MaintenanceWindow, error) {
+	return s.windowRepo.List(ctx)
+}
+
+// GetMaintenanceWindow returns a maintenance window by ID
+func (s *SilenceService) GetMaintenanceWindow(ctx context.Context, id uuid.UUID) (*repository.MaintenanceWindow, error) {
+	return s.windowRepo.GetByID(ctx, id)
+}
+
+// CreateMaintenanceWindow validates and stores a maintenance window
+func (s *SilenceService) CreateMaintenanceWindow(ctx context.Context, userID uuid.UUID, window *repository.MaintenanceWindow) error {
+	if err := validateMaintenanceWindow(window); err != nil {
+		return err
+	}
+
+	window.CreatedBy = &userID
+	if err := s.windowRepo.Create(ctx, window); err != nil {
+		return fmt.Errorf("failed to create maintenance window: %w", err)
+	}
+
+	s.auditSilenceAction(ctx, userID, "CREATE_MAINTENANCE_WINDOW", "MAINTENANCE_WINDOW", window.ID)
+	return nil
+}
+
+// UpdateMaintenanceWindow validates and updates a maintenance window
+func (s *SilenceService) UpdateMaintenanceWindow(ctx context.Context, userID uuid.UUID, window *repository.MaintenanceWindow) error {
+	if err := validateMaintenanceWindow(window); err != nil {
+		return err
+	}
+
+	if err := s.windowRepo.Update(ctx, window); err != nil {
+		return fmt.Errorf("failed to update maintenance window: %w", err)
+	}
+
+	s.auditSilenceAction(ctx, userID, "UPDATE_MAINTENANCE_WINDOW", "MAINTENANCE_WINDOW", window.ID)
+	return nil
+}
+
+// DeleteMaintenanceWindow deletes a maintenance window
+func (s *SilenceService) DeleteMaintenanceWindow(ctx context.Context, userID, id uuid.UUID) error {
+	if err := s.windowRepo.Delete(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete maintenance window: %w", err)
+	}
+
+	s.auditSilenceAction(ctx, userID, "DELETE_MAINTENANCE_WINDOW", "MAINTENANCE_WINDOW", id)
+	return nil
+}
+
+func validateMaintenanceWindow(window *repository.MaintenanceWindow) error {
+	if strings.TrimSpace(window.Name) == "" {
+		return fmt.Errorf("window name is required")
+	}
+	if window.Target == "" {
+		return fmt.Errorf("window target is required")
+	}
+	if _, err := path.Match(window.Target, ""); err != nil {
+		return fmt.Errorf("invalid target pattern %q: %w", window.Target, err)
+	}
+	if window.DurationMinutes <= 0 {
+		return fmt.Errorf("window duration must be positive")
+	}
+
+	period, ok := recurrencePeriods[window.Recurrence]
+	if !ok {
+		return fmt.Errorf("unknown recurrence: %s", window.Recurrence)
+	}
+	if period > 0 && time.Duration(window.DurationMinutes)*time.Minute >= period {
+		return fmt.Errorf("window duration must be shorter than its %s recurrence", strings.ToLower(window.Recurrence))
+	}
+
+	if window.Timezone == "" {
+		window.Timezone = "UTC"
+	}
+	if _, err := time.LoadLocation(window.Timezone); err != nil {
+		return fmt.Errorf("invalid timezone %q: %w", window.Timezone, err)
+	}
+	if window.Until != nil && !window.Until.After(window.StartsAt) {
+		return fmt.Errorf("window must repeat until a time after it starts")
+	}
+	return nil
+}
+
+func (s *SilenceService) auditSilenceAction(ctx context.Context, userID uuid.UUID, action, resourceType string, id uuid.UUID) {
+	resourceID := id.String()
+	log := &repository.AuditLog{
+		UserID:       &userID,
+		Username:     userID.String(),
+		Action:       action,
+		ResourceType: resourceType,
+		ResourceID:   &resourceID,
+		Status:       "SUCCESS",
+	}
+	_ = s.auditRepo.Create(ctx, log)
+}
@@ -0,0 +1,94 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aashiq-04/oracle-dba/internal/repository"
+)
+
+func TestMaintenanceOccurrenceMonthly(t *testing.T) {
+	utc := func(year int, month time.Month, day, hour int) time.Time {
+		return time.Date(year, month, day, hour, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name       string
+		anchor     time.Time
+		duration   int // minutes
+		at         time.Time
+		wantActive bool
+		wantStart  time.Time
+	}{
+		// The 31st is clamped in 28, 29 and 30-day months
+		{"31st anchor, day after short February", utc(2025, 1, 31, 2), 120, utc(2025, 3, 1, 10), false, time.Time{}},
+		{"31st anchor, inside clamped February window", utc(2025, 1, 31, 2), 3 * 24 * 60, utc(2025, 3, 1, 10), true, utc(2025, 2, 28, 2)},
+		{"31st anchor, February 28 in common year", utc(2025, 1, 31, 2), 120, utc(2025, 2, 28, 3), true, utc(2025, 2, 28, 2)},
+		{"31st anchor, February 29 in leap year", utc(2024, 1, 31, 2), 120, utc(2024, 2, 29, 3), true, utc(2024, 2, 29, 2)},
+		{"31st anchor, not on February 28 in leap year", utc(2024, 1, 31, 2), 120, utc(2024, 2, 28, 3), false, time.Time{}},
+		{"31st anchor, 30-day month", utc(2025, 1, 31, 2), 120, utc(2025, 4, 30, 3), true, utc(2025, 4, 30, 2)},
+		{"31st anchor, 31-day month", utc(2025, 1, 31, 2), 120, utc(2025, 5, 31, 3), true, utc(2025, 5, 31, 2)},
+		{"31st anchor, before clamped occurrence", utc(2025, 1, 31, 2), 120, utc(2025, 4, 29, 3), false, time.Time{}},
+		{"30th anchor, common February", utc(2025, 1, 30, 2), 120, utc(2025, 2, 28, 3), true, utc(2025, 2, 28, 2)},
+		{"30th anchor, 30-day month keeps day", utc(2025, 1, 30, 2), 120, utc(2025, 6, 30, 3), true, utc(2025, 6, 30, 2)},
+		{"29th anchor, leap February keeps day", utc(2024, 1, 29, 2), 120, utc(2024, 2, 29, 3), true, utc(2024, 2, 29, 2)},
+		{"29th anchor, common February clamped", utc(2025, 1, 29, 2), 120, utc(2025, 2, 28, 3), true, utc(2025, 2, 28, 2)},
+		{"28th anchor, unchanged in every month", utc(2025, 1, 28, 2), 120, utc(2025, 2, 28, 3), true, utc(2025, 2, 28, 2)},
+		// Stepping back from January crosses into December of the previous year
+		{"window spanning new year", utc(2024, 12, 31, 22), 6 * 60, utc(2025, 1, 1, 1), true, utc(2024, 12, 31, 22)},
+		{"before first occurrence", utc(2025, 1, 31, 2), 120, utc(2025, 1, 30, 3), false, time.Time{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			window := &repository.MaintenanceWindow{
+				StartsAt:        tt.anchor,
+				DurationMinutes: tt.duration,
+				Recurrence:      RecurrenceMonthly,
+				Timezone:        "UTC",
+			}
+
+			start, end, active := maintenanceOccurrence(window, tt.at)
+			if active != tt.wantActive {
+				t.Fatalf("active = %v (start %s), want %v", active, start, tt.wantActive)
+			}
+			if !active {
+				return
+			}
+			if !start.Equal(tt.wantStart) {
+				t.Errorf("start = %s, want %s", start, tt.wantStart)
+			}
+			if start.After(tt.at) || !end.After(tt.at) {
+				t.Errorf("window [%s, %s) does not cover %s", start, end, tt.at)
+			}
+		})
+	}
+}
+
+func TestMonthlyOccurrence(t *testing.T) {
+	tests := []struct {
+		anchorDay int
+		year      int
+		month     time.Month
+		wantDay   int
+		wantMonth time.Month
+	}{
+		{31, 2025, time.February, 28, time.February},
+		{31, 2024, time.February, 29, time.February},
+		{31, 2025, time.April, 30, time.April},
+		{31, 2025, time.March, 31, time.March},
+		{30, 2025, time.February, 28, time.February},
+		{29, 2024, time.February, 29, time.February},
+		{28, 2025, time.February, 28, time.February},
+		{15, 2025, 0, 15, time.December}, // month before January
+	}
+
+	for _, tt := range tests {
+		anchor := time.Date(2024, time.January, tt.anchorDay, 2, 30, 0, 0, time.UTC)
+		got := monthlyOccurrence(tt.year, tt.month, anchor, time.UTC)
+		if got.Day() != tt.wantDay || got.Month() != tt.wantMonth || got.Hour() != 2 || got.Minute() != 30 {
+			t.Errorf("monthlyOccurrence(%d, %d, day %d) = %s, want %s %d at 02:30",
+				tt.year, tt.month, tt.anchorDay, got, tt.wantMonth, tt.wantDay)
+		}
+	}
+}
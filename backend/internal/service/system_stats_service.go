@@ -0,0 +1,431 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aashiq-04/oracle-dba/internal/repository"
+	"github.com/aashiq-04/oracle-dba/pkg/logger"
+	"github.com/aashiq-04/oracle-dba/pkg/oracle"
+)
+
+// Statistic names used by the summaries (time model values are microseconds)
+const (
+	StatDBTime              = "DB time"
+	StatDBCPU               = "DB CPU"
+	StatSessionLogicalReads = "session logical reads"
+	StatPhysicalReadsCache  = "physical reads cache"
+	StatParseCountTotal     = "parse count (total)"
+	StatParseCountHard      = "parse count (hard)"
+	StatExecuteCount        = "execute count"
+)
+
+// WaitEventStat is the waiting done on one event over a window
+type WaitEventStat struct {
+	Event             string
+	WaitClass         string
+	Waits             int64
+	TimeWaitedSeconds float64
+	AvgWaitMs         float64
+	PctDBTime         *float64
+}
+
+// DBTimeSummary compares DB time with DB CPU over a window
+type DBTimeSummary struct {
+	IntervalSeconds   float64
+	DBTimeSeconds     float64
+	DBCPUSeconds      float64
+	WaitTimeSeconds   float64
+	CPUPercentage     *float64
+	AvgActiveSessions float64
+}
+
+// DBTimePoint is DB time and DB CPU for one collection interval
+type DBTimePoint struct {
+	CapturedAt        time.Time
+	IntervalSeconds   float64
+	DBTimeSeconds     float64
+	DBCPUSeconds      float64
+	AvgActiveSessions float64
+}
+
+// SystemRatios are instance efficiency ratios over a window. Ratios whose
+// denominator is zero are nil.
+type SystemRatios struct {
+	IntervalSeconds          float64
+	BufferCacheHitPercentage *float64
+	SoftParsePercentage      *float64
+	ParseToExecuteRatio      *float64
+	ExecuteToParsePercentage *float64
+	ExecutesPerSecond        float64
+	HardParsesPerSecond      float64
+	LogicalReadsPerSecond    float64
+}
+
+// systemStatsSnapshot holds cumulative values as read from Oracle
+type systemStatsSnapshot struct {
+	takenAt   time.Time
+	events    map[string]*repository.WaitEventDelta
+	timeModel map[string]float64
+	sysstat   map[string]float64
+}
+
+// SystemStatsService snapshots v$system_event, v$sys_time_model and
+// v$sysstat, stores the deltas between consecutive snapshots, and answers
+// system-wide wait, DB time and ratio questions from them
+type SystemStatsService struct {
+	oracleService *OracleService
+	statsRepo     repository.SystemStatsRepository
+	logger        logger.Logger
+	target        string
+	interval      time.Duration
+	retention     time.Duration
+
+	previous *systemStatsSnapshot
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewSystemStatsService creates a new system statistics collector
+func NewSystemStatsService(
+	oracleService *OracleService,
+	statsRepo repository.SystemStatsRepository,
+	log logger.Logger,
+	target string,
+	interval time.Duration,
+	retention time.Duration,
+) *SystemStatsService {
+	return &SystemStatsService{
+		oracleService: oracleService,
+		statsRepo:     statsRepo,
+		logger:        log,
+		target:        target,
+		interval:      interval,
+		retention:     retention,
+	}
+}
+
+// Start begins periodic collection in the background. The first snapshot
+// only establishes a baseline.
+func (s *SystemStatsService) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+	s.done = make(chan struct{})
+
+	go func() {
+		defer close(s.done)
+
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		pruneTicker := time.NewTicker(time.Hour)
+		defer pruneTicker.Stop()
+
+		s.collectAndLog(ctx)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				s.collectAndLog(ctx)
+			case <-pruneTicker.C:
+				if err := s.statsRepo.DeleteBefore(ctx, time.Now().Add(-s.retention)); err != nil && ctx.Err() == nil {
+					s.logger.Error("Failed to prune system statistics", logger.Error(err))
+				}
+			}
+		}
+	}()
+}
+
+// Stop halts collection and waits for the current cycle to finish
+func (s *SystemStatsService) Stop() {
+	if s.cancel == nil {
+		return
+	}
+	s.cancel()
+	<-s.done
+}
+
+func (s *SystemStatsService) collectAndLog(ctx context.Context) {
+	if err := s.collect(ctx); err != nil && ctx.Err() == nil {
+		s.logger.Error("System statistics collection failed", logger.Error(err))
+	}
+}
+
+func (s *SystemStatsService) collect(ctx context.Context) error {
+	current, err := s.snapshot(ctx)
+	if err != nil {
+		return err
+	}
+
+	previous := s.previous
+	s.previous = current
+	if previous == nil {
+		return nil
+	}
+
+	intervalSeconds := current.takenAt.Sub(previous.takenAt).Seconds()
+
+	eventDeltas, ok := s.eventDeltas(previous, current, intervalSeconds)
+	if !ok {
+		s.logger.Info("Cumulative statistics went backwards, instance restarted; rebaselining")
+		return nil
+	}
+
+	statDeltas := []*repository.SystemStatDelta{}
+	for _, source := range []struct {
+		name      string
+		prev, cur map[string]float64
+	}{
+		{repository.StatSourceTimeModel, previous.timeModel, current.timeModel},
+		{repository.StatSourceSysstat, previous.sysstat, current.sysstat},
+	} {
+		for name, value := range source.cur {
+			delta := value - source.prev[name]
+			if delta < 0 {
+				s.logger.Info("Cumulative statistics went backwards, instance restarted; rebaselining")
+				return nil
+			}
+			statDeltas = append(statDeltas, &repository.SystemStatDelta{
+				CapturedAt:      current.takenAt,
+				Target:          s.target,
+				IntervalSeconds: intervalSeconds,
+				Source:          source.name,
+				Name:            name,
+				Value:           delta,
+			})
+		}
+	}
+
+	if err := s.statsRepo.CreateEventDeltas(ctx, eventDeltas); err != nil {
+		return err
+	}
+	return s.statsRepo.CreateStatDeltas(ctx, statDeltas)
+}
+
+func (s *SystemStatsService) snapshot(ctx context.Context) (*systemStatsSnapshot, error) {
+	takenAt := time.Now()
+
+	events, err := s.oracleService.fetchSystemEvents(ctx)
+	if err != nil {
+		return nil, err
+	}
+	timeModel, err := s.oracleService.fetchNamedValues(ctx, oracle.QuerySysTimeModel)
+	if err != nil {
+		return nil, err
+	}
+	sysstat, err := s.oracleService.fetchNamedValues(ctx, oracle.QuerySysstat)
+	if err != nil {
+		return nil, err
+	}
+
+	return &systemStatsSnapshot{
+		takenAt:   takenAt,
+		events:    events,
+		timeModel: timeModel,
+		sysstat:   sysstat,
+	}, nil
+}
+
+// eventDeltas returns events that waited during the interval; ok is false
+// if any counter decreased
+func (s *SystemStatsService) eventDeltas(previous, current *systemStatsSnapshot, intervalSeconds float64) ([]*repository.WaitEventDelta, bool) {
+	deltas := []*repository.WaitEventDelta{}
+	for name, cur := range current.events {
+		var prevWaits, prevTime int64
+		if prev, ok := previous.events[name]; ok {
+			prevWaits, prevTime = prev.Waits, prev.TimeWaitedMicro
+		}
+
+		waits := cur.Waits - prevWaits
+		timeWaited := cur.TimeWaitedMicro - prevTime
+		if waits < 0 || timeWaited < 0 {
+			return nil, false
+		}
+		if waits == 0 && timeWaited == 0 {
+			continue
+		}
+
+		deltas = append(deltas, &repository.WaitEventDelta{
+			CapturedAt:      current.takenAt,
+			Target:          s.target,
+			IntervalSeconds: intervalSeconds,
+			Event:           cur.Event,
+			WaitClass:       cur.WaitClass,
+			Waits:           waits,
+			TimeWaitedMicro: timeWaited,
+		})
+	}
+	return deltas, true
+}
+
+// ============================================================================
+// QUERIES
+// ============================================================================
+
+// TopWaitEvents returns the non-idle events with the most time waited over
+// the last N minutes, with their share of DB time
+func (s *SystemStatsService) TopWaitEvents(ctx context.Context, minutes, limit int) ([]*WaitEventStat, error) {
+	start, end, err := minutesWindow(minutes)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := s.statsRepo.TopWaitEvents(ctx, s.target, start, end, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	sums, _, err := s.statsRepo.SumStats(ctx, s.target, []string{StatDBTime}, start, end)
+	if err != nil {
+		return nil, err
+	}
+	dbTimeMicro := sums[StatDBTime]
+
+	stats := make([]*WaitEventStat, len(events))
+	for i, e := range events {
+		stat := &WaitEventStat{
+			Event:             e.Event,
+			WaitClass:         e.WaitClass,
+			Waits:             e.Waits,
+			TimeWaitedSeconds: float64(e.TimeWaitedMicro) / 1e6,
+		}
+		if e.Waits > 0 {
+			stat.AvgWaitMs = float64(e.TimeWaitedMicro) / 1e3 / float64(e.Waits)
+		}
+		stat.PctDBTime = percentage(float64(e.TimeWaitedMicro), dbTimeMicro)
+		stats[i] = stat
+	}
+
+	return stats, nil
+}
+
+// DBTimeSummary compares DB time with DB CPU over the last N minutes
+func (s *SystemStatsService) DBTimeSummary(ctx context.Context, minutes int) (*DBTimeSummary, error) {
+	start, end, err := minutesWindow(minutes)
+	if err != nil {
+		return nil, err
+	}
+
+	sums, covered, err := s.statsRepo.SumStats(ctx, s.target, []string{StatDBTime, StatDBCPU}, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	dbTime := sums[StatDBTime] / 1e6
+	dbCPU := sums[StatDBCPU] / 1e6
+
+	summary := &DBTimeSummary{
+		IntervalSeconds: covered,
+		DBTimeSeconds:   dbTime,
+		DBCPUSeconds:    dbCPU,
+		WaitTimeSeconds: dbTime - dbCPU,
+		CPUPercentage:   percentage(dbCPU, dbTime),
+	}
+	if covered > 0 {
+		summary.AvgActiveSessions = dbTime / covered
+	}
+
+	return summary, nil
+}
+
+// DBTimeHistory returns DB time and DB CPU per collection interval, to tell
+// whether the database is busier than usual
+func (s *SystemStatsService) DBTimeHistory(ctx context.Context, start, end time.Time) ([]*DBTimePoint, error) {
+	if !end.After(start) {
+		return nil, fmt.Errorf("end must be after start")
+	}
+
+	deltas, err := s.statsRepo.StatHistory(ctx, s.target, []string{StatDBTime, StatDBCPU}, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	points := []*DBTimePoint{}
+	var point *DBTimePoint
+	for _, d := range deltas {
+		if point == nil || !point.CapturedAt.Equal(d.CapturedAt) {
+			point = &DBTimePoint{CapturedAt: d.CapturedAt, IntervalSeconds: d.IntervalSeconds}
+			points = append(points, point)
+		}
+		switch d.Name {
+		case StatDBTime:
+			point.DBTimeSeconds = d.Value / 1e6
+			if d.IntervalSeconds > 0 {
+				point.AvgActiveSessions = point.DBTimeSeconds / d.IntervalSeconds
+			}
+		case StatDBCPU:
+			point.DBCPUSeconds = d.Value / 1e6
+		}
+	}
+
+	return points, nil
+}
+
+// SystemRatios computes buffer cache hit, parse and execute ratios over the
+// last N minutes
+func (s *SystemStatsService) SystemRatios(ctx context.Context, minutes int) (*SystemRatios, error) {
+	start, end, err := minutesWindow(minutes)
+	if err != nil {
+		return nil, err
+	}
+
+	sums, covered, err := s.statsRepo.SumStats(ctx, s.target, []string{
+		StatSessionLogicalReads,
+		StatPhysicalReadsCache,
+		StatParseCountTotal,
+		StatParseCountHard,
+		StatExecuteCount,
+	}, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	logicalReads := sums[StatSessionLogicalReads]
+	parses := sums[StatParseCountTotal]
+	hardParses := sums[StatParseCountHard]
+	executes := sums[StatExecuteCount]
+
+	ratios := &SystemRatios{IntervalSeconds: covered}
+
+	if logicalReads > 0 {
+		hit := 100 * (1 - sums[StatPhysicalReadsCache]/logicalReads)
+		ratios.BufferCacheHitPercentage = &hit
+	}
+	if parses > 0 {
+		soft := 100 * (1 - hardParses/parses)
+		ratios.SoftParsePercentage = &soft
+	}
+	if executes > 0 {
+		parseToExecute := parses / executes
+		executeToParse := 100 * (1 - parses/executes)
+		ratios.ParseToExecuteRatio = &parseToExecute
+		ratios.ExecuteToParsePercentage = &executeToParse
+	}
+	if covered > 0 {
+		ratios.ExecutesPerSecond = executes / covered
+		ratios.HardParsesPerSecond = hardParses / covered
+		ratios.LogicalReadsPerSecond = logicalReads / covered
+	}
+
+	return ratios, nil
+}
+
+func minutesWindow(minutes int) (time.Time, time.Time, error) {
+	if minutes <= 0 {
+		return time.Time{}, time.Time{}, fmt.Errorf("minutes must be positive")
+	}
+	end := time.Now()
+	return end.Add(-time.Duration(minutes) * time.Minute), end, nil
+}
+
+// percentage returns part/whole as a percentage, or nil when whole is zero
+func percentage(part, whole float64) *float64 {
+	if whole <= 0 {
+		return nil
+	}
+	pct := part / whole * 100
+	return &pct
+}
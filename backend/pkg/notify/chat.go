@@ -0,0 +1,39 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// ChatChannel posts to Slack or Microsoft Teams incoming webhooks, both of
+// which accept a {"text": "..."} payload
+type ChatChannel struct {
+	URL    string
+	Client *http.Client
+}
+
+// NewChatChannel creates a Slack/Teams-compatible incoming webhook channel
+func NewChatChannel(url string) *ChatChannel {
+	return &ChatChannel{
+		URL:    url,
+		Client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Send posts the subject (in bold) followed by the body
+func (c *ChatChannel) Send(ctx context.Context, msg Message) error {
+	text := msg.Body
+	if msg.Subject != "" {
+		text = fmt.Sprintf("*%s*\n%s", msg.Subject, msg.Body)
+	}
+
+	body, err := json.Marshal(map[string]string{"text": text})
+	if err != nil {
+		return &PermanentError{Err: fmt.Errorf("failed to encode chat message: %w", err)}
+	}
+
+	return postJSON(ctx, c.Client, c.URL, body, nil)
+}
@@ -0,0 +1,50 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestChatChannelPayload(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  Message
+		want string
+	}{
+		{"subject in bold", Message{Subject: "[CRITICAL] FIRING", Body: "USERS is 95% full"}, "*[CRITICAL] FIRING*\nUSERS is 95% full"},
+		{"body only", Message{Body: "USERS is 95% full"}, "USERS is 95% full"},
+		{"quotes are escaped", Message{Body: `schema "APP" invalid`}, `schema "APP" invalid`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, captured := newTestServer(t, http.StatusOK)
+
+			if err := NewChatChannel(server.URL).Send(context.Background(), tt.msg); err != nil {
+				t.Fatalf("Send() error = %v", err)
+			}
+
+			var payload map[string]string
+			if err := json.Unmarshal(captured.body, &payload); err != nil {
+				t.Fatalf("payload %s is not JSON: %v", captured.body, err)
+			}
+			if len(payload) != 1 || payload["text"] != tt.want {
+				t.Errorf("payload = %v, want {text: %q}", payload, tt.want)
+			}
+			if captured.signature != "" {
+				t.Errorf("chat payload carries a %s header", SignatureHeader)
+			}
+		})
+	}
+}
+
+func TestChatChannelRejectedWebhookIsPermanent(t *testing.T) {
+	server, _ := newTestServer(t, http.StatusForbidden)
+
+	err := NewChatChannel(server.URL).Send(context.Background(), Message{Body: "test"})
+	if !IsPermanent(err) {
+		t.Errorf("Send() error = %v, want a permanent error", err)
+	}
+}
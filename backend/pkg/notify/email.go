@@ -0,0 +1,83 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// EmailChannel sends messages over SMTP. STARTTLS is used when the server
+// offers it; authentication is only attempted when a username is set.
+type EmailChannel struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+	To       []string
+}
+
+// NewEmailChannel creates an SMTP email channel
+func NewEmailChannel(host string, port int, username, password, from string, to []string) *EmailChannel {
+	return &EmailChannel{
+		Host:     host,
+		Port:     port,
+		Username: username,
+		Password: password,
+		From:     from,
+		To:       to,
+	}
+}
+
+// Send delivers msg as a plain-text email to all recipients
+func (c *EmailChannel) Send(ctx context.Context, msg Message) error {
+	if len(c.To) == 0 {
+		return &PermanentError{Err: fmt.Errorf("email channel has no recipients")}
+	}
+
+	var auth smtp.Auth
+	if c.Username != "" {
+		auth = smtp.PlainAuth("", c.Username, c.Password, c.Host)
+	}
+
+	addr := net.JoinHostPort(c.Host, strconv.Itoa(c.Port))
+	data := c.buildMessage(msg)
+
+	// smtp.SendMail has no context support; run it so ctx can abandon the wait
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- smtp.SendMail(addr, auth, c.From, c.To, data)
+	}()
+
+	select {
+	case err := <-errCh:
+		if err != nil {
+			return fmt.Errorf("smtp send failed: %w", err)
+		}
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (c *EmailChannel) buildMessage(msg Message) []byte {
+	var b strings.Builder
+	b.WriteString("From: " + c.From + "\r\n")
+	b.WriteString("To: " + strings.Join(c.To, ", ") + "\r\n")
+	b.WriteString("Subject: " + sanitizeHeader(msg.Subject) + "\r\n")
+	b.WriteString("Date: " + time.Now().Format(time.RFC1123Z) + "\r\n")
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(msg.Body, "\n", "\r\n"))
+	return []byte(b.String())
+}
+
+// sanitizeHeader prevents header injection through templated subjects
+func sanitizeHeader(value string) string {
+	return strings.NewReplacer("\r", " ", "\n", " ").Replace(value)
+}
@@ -0,0 +1,175 @@
+package notify
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// smtpStandIn is a minimal SMTP server that accepts one message per
+// connection without STARTTLS or AUTH
+type smtpStandIn struct {
+	listener net.Listener
+	messages chan smtpMessage
+}
+
+type smtpMessage struct {
+	from string
+	to   []string
+	data string
+}
+
+func newSMTPStandIn(t *testing.T) *smtpStandIn {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	s := &smtpStandIn{listener: listener, messages: make(chan smtpMessage, 1)}
+	t.Cleanup(func() { listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+	return s
+}
+
+func (s *smtpStandIn) hostPort(t *testing.T) (string, int) {
+	t.Helper()
+	host, portStr, err := net.SplitHostPort(s.listener.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	port, err := strconv.Atoi(portStr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return host, port
+}
+
+func (s *smtpStandIn) serve(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	reply := func(line string) { conn.Write([]byte(line + "\r\n")) }
+
+	msg := smtpMessage{}
+	reply("220 localhost ESMTP stand-in")
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		verb := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+
+		switch verb {
+		case "EHLO", "HELO":
+			reply("250 localhost")
+		case "MAIL":
+			msg.from = smtpAddress(line)
+			reply("250 OK")
+		case "RCPT":
+			msg.to = append(msg.to, smtpAddress(line))
+			reply("250 OK")
+		case "DATA":
+			reply("354 End data with <CR><LF>.<CR><LF>")
+			var data strings.Builder
+			for {
+				dataLine, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if dataLine == ".\r\n" {
+					break
+				}
+				data.WriteString(dataLine)
+			}
+			msg.data = data.String()
+			reply("250 OK")
+			s.messages <- msg
+		case "QUIT":
+			reply("221 Bye")
+			return
+		default:
+			reply("250 OK")
+		}
+	}
+}
+
+// smtpAddress extracts the address of a MAIL FROM:<a> or RCPT TO:<a> command
+func smtpAddress(line string) string {
+	start, end := strings.Index(line, "<"), strings.Index(line, ">")
+	if start < 0 || end < start {
+		return ""
+	}
+	return line[start+1 : end]
+}
+
+func TestEmailChannelSendsToSMTPServer(t *testing.T) {
+	server := newSMTPStandIn(t)
+	host, port := server.hostPort(t)
+
+	channel := NewEmailChannel(host, port, "", "", "oracle-dba@example.com",
+		[]string{"dba@example.com", "oncall@example.com"})
+	msg := Message{
+		Subject: "[CRITICAL] FIRING: High usage\r\nBcc: attacker@example.com",
+		Body:    "USERS is 95% full\nObject: USERS",
+	}
+	if err := channel.Send(context.Background(), msg); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+
+	var got smtpMessage
+	select {
+	case got = <-server.messages:
+	case <-time.After(5 * time.Second):
+		t.Fatal("SMTP stand-in received no message")
+	}
+
+	if got.from != "oracle-dba@example.com" {
+		t.Errorf("MAIL FROM = %q", got.from)
+	}
+	if strings.Join(got.to, ",") != "dba@example.com,oncall@example.com" {
+		t.Errorf("RCPT TO = %v", got.to)
+	}
+	if !strings.Contains(got.data, "Subject: [CRITICAL] FIRING: High usage  Bcc: attacker@example.com\r\n") {
+		t.Errorf("subject header not sanitized:\n%s", got.data)
+	}
+	if strings.Contains(got.data, "\r\nBcc:") {
+		t.Errorf("header injected through the subject:\n%s", got.data)
+	}
+	if !strings.Contains(got.data, "\r\n\r\nUSERS is 95% full\r\nObject: USERS") {
+		t.Errorf("body not in CRLF form:\n%s", got.data)
+	}
+}
+
+func TestEmailChannelWithoutRecipientsIsPermanent(t *testing.T) {
+	channel := NewEmailChannel("127.0.0.1", 25, "", "", "oracle-dba@example.com", nil)
+
+	err := channel.Send(context.Background(), Message{Subject: "test", Body: "test"})
+	if !IsPermanent(err) {
+		t.Errorf("Send() error = %v, want a permanent error", err)
+	}
+}
+
+func TestEmailChannelUnreachableServer(t *testing.T) {
+	server := newSMTPStandIn(t)
+	host, port := server.hostPort(t)
+	server.listener.Close()
+
+	channel := NewEmailChannel(host, port, "", "", "oracle-dba@example.com", []string{"dba@example.com"})
+	err := channel.Send(context.Background(), Message{Subject: "test", Body: "test"})
+	if err == nil || IsPermanent(err) {
+		t.Errorf("Send() error = %v, want a transient error", err)
+	}
+}
@@ -0,0 +1,37 @@
+package notify
+
+import (
+	"context"
+	"errors"
+)
+
+// Message is a rendered notification ready to be delivered
+type Message struct {
+	Subject string
+	Body    string
+}
+
+// Channel delivers messages to one destination
+type Channel interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// PermanentError marks a delivery failure that retrying cannot fix
+// (bad credentials, rejected payload, unknown recipient...)
+type PermanentError struct {
+	Err error
+}
+
+func (e *PermanentError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *PermanentError) Unwrap() error {
+	return e.Err
+}
+
+// IsPermanent reports whether err should not be retried
+func IsPermanent(err error) bool {
+	var permanent *PermanentError
+	return errors.As(err, &permanent)
+}
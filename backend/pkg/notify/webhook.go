@@ -0,0 +1,85 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// SignatureHeader carries the HMAC-SHA256 signature of the webhook body
+const SignatureHeader = "X-Signature-256"
+
+// WebhookChannel POSTs the message body to an HTTP endpoint, signing it
+// with HMAC-SHA256 when a secret is configured
+type WebhookChannel struct {
+	URL    string
+	Secret string
+	Client *http.Client
+}
+
+// NewWebhookChannel creates a generic HTTP webhook channel
+func NewWebhookChannel(url, secret string) *WebhookChannel {
+	return &WebhookChannel{
+		URL:    url,
+		Secret: secret,
+		Client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Send posts msg.Body as JSON
+func (c *WebhookChannel) Send(ctx context.Context, msg Message) error {
+	body := []byte(msg.Body)
+
+	headers := map[string]string{}
+	if c.Secret != "" {
+		headers[SignatureHeader] = "sha256=" + Sign(c.Secret, body)
+	}
+
+	return postJSON(ctx, c.Client, c.URL, body, headers)
+}
+
+// Sign returns the hex-encoded HMAC-SHA256 of body with secret, so
+// receivers can verify the SignatureHeader value
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func postJSON(ctx context.Context, client *http.Client, url string, body []byte, headers map[string]string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return &PermanentError{Err: fmt.Errorf("invalid webhook request: %w", err)}
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("webhook request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+
+	err = fmt.Errorf("webhook returned %s", resp.Status)
+	if snippet, _ := io.ReadAll(io.LimitReader(resp.Body, 512)); len(bytes.TrimSpace(snippet)) > 0 {
+		err = fmt.Errorf("%w: %s", err, bytes.TrimSpace(snippet))
+	}
+
+	// Client errors other than rate limiting will not succeed on retry
+	if resp.StatusCode >= 400 && resp.StatusCode < 500 && resp.StatusCode != http.StatusTooManyRequests {
+		return &PermanentError{Err: err}
+	}
+	return err
+}
@@ -0,0 +1,112 @@
+package notify
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// capturedRequest is what a test server received
+type capturedRequest struct {
+	contentType string
+	signature   string
+	body        []byte
+}
+
+func newTestServer(t *testing.T, status int) (*httptest.Server, *capturedRequest) {
+	t.Helper()
+	captured := &capturedRequest{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("failed to read request body: %v", err)
+		}
+		captured.contentType = r.Header.Get("Content-Type")
+		captured.signature = r.Header.Get(SignatureHeader)
+		captured.body = body
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(server.Close)
+	return server, captured
+}
+
+func TestWebhookChannelSignsPayload(t *testing.T) {
+	server, captured := newTestServer(t, http.StatusOK)
+	payload := `{"event":"FIRING","alert":{"ruleName":"High usage"}}`
+
+	channel := NewWebhookChannel(server.URL, "s3cret")
+	if err := channel.Send(context.Background(), Message{Subject: "ignored", Body: payload}); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+
+	if string(captured.body) != payload {
+		t.Errorf("body = %s, want %s", captured.body, payload)
+	}
+	if captured.contentType != "application/json" {
+		t.Errorf("Content-Type = %q, want application/json", captured.contentType)
+	}
+
+	// Verify the signature the way a receiver would
+	mac := hmac.New(sha256.New, []byte("s3cret"))
+	mac.Write(captured.body)
+	want := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+	if !hmac.Equal([]byte(captured.signature), []byte(want)) {
+		t.Errorf("%s = %q, want %q", SignatureHeader, captured.signature, want)
+	}
+}
+
+func TestWebhookChannelWithoutSecretIsUnsigned(t *testing.T) {
+	server, captured := newTestServer(t, http.StatusNoContent)
+
+	if err := NewWebhookChannel(server.URL, "").Send(context.Background(), Message{Body: "{}"}); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	if captured.signature != "" {
+		t.Errorf("%s = %q, want no signature", SignatureHeader, captured.signature)
+	}
+}
+
+func TestWebhookChannelErrorClassification(t *testing.T) {
+	tests := []struct {
+		status    int
+		permanent bool
+	}{
+		{http.StatusBadRequest, true},
+		{http.StatusUnauthorized, true},
+		{http.StatusNotFound, true},
+		{http.StatusTooManyRequests, false},
+		{http.StatusInternalServerError, false},
+		{http.StatusBadGateway, false},
+		{http.StatusServiceUnavailable, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.status), func(t *testing.T) {
+			server, _ := newTestServer(t, tt.status)
+
+			err := NewWebhookChannel(server.URL, "").Send(context.Background(), Message{Body: "{}"})
+			if err == nil {
+				t.Fatalf("Send() error = nil, want an error for status %d", tt.status)
+			}
+			if IsPermanent(err) != tt.permanent {
+				t.Errorf("IsPermanent(%v) = %v, want %v", err, IsPermanent(err), tt.permanent)
+			}
+		})
+	}
+}
+
+func TestWebhookChannelUnreachableIsTransient(t *testing.T) {
+	server, _ := newTestServer(t, http.StatusOK)
+	url := server.URL
+	server.Close()
+
+	err := NewWebhookChannel(url, "").Send(context.Background(), Message{Body: "{}"})
+	if err == nil || IsPermanent(err) {
+		t.Errorf("Send() error = %v, want a transient error", err)
+	}
+}
@@ -13,6 +13,10 @@ import (
 // OracleDB wraps the Oracle connection pool
 type OracleDB struct {
 	DB *sql.DB
+
+	// Cluster switches monitoring queries to the gv$ views so that every
+	// instance of a RAC database is seen, not just the one connected to
+	Cluster bool
 }
 
 // OracleConfig holds Oracle connection configuration
@@ -24,6 +28,7 @@ type OracleConfig struct {
 	Password    string
 	MaxConns    int
 	MinConns    int
+	Cluster     bool
 }
 
 // NewOracleDB creates a new Oracle connection pool
@@ -56,7 +61,7 @@ func NewOracleDB(cfg OracleConfig) (*OracleDB, error) {
 		return nil, fmt.Errorf("failed to ping oracle: %w", err)
 	}
 
-	return &OracleDB{DB: db}, nil
+	return &OracleDB{DB: db, Cluster: cfg.Cluster}, nil
 }
 
 // Close closes the Oracle connection pool
@@ -99,7 +104,9 @@ const (
 			s.blocking_session,
 			s.wait_class,
 			s.event,
-			s.seconds_in_wait
+			s.seconds_in_wait,
+			USERENV('INSTANCE') as inst_id,
+			s.con_id
 		FROM v$session s
 		LEFT JOIN v$sql sq ON s.sql_id = sq.sql_id
 		WHERE s.type = 'USER'
@@ -126,7 +133,9 @@ const (
 			s.blocking_session,
 			s.wait_class,
 			s.event,
-			s.seconds_in_wait
+			s.seconds_in_wait,
+			USERENV('INSTANCE') as inst_id,
+			s.con_id
 		FROM v$session s
 		LEFT JOIN v$sql sq ON s.sql_id = sq.sql_id
 		WHERE s.type = 'USER'
@@ -152,7 +161,9 @@ const (
 			s.blocking_session,
 			s.wait_class,
 			s.event,
-			s.seconds_in_wait
+			s.seconds_in_wait,
+			USERENV('INSTANCE') as inst_id,
+			s.con_id
 		FROM v$session s
 		LEFT JOIN v$sql sq ON s.sql_id = sq.sql_id
 		WHERE s.type = 'USER'
@@ -161,7 +172,8 @@ const (
 		ORDER BY s.last_call_et DESC
 	`
 
-	// QueryBlockingSessions retrieves blocking session information
+	// QueryBlockingSessions retrieves blocking session information, optionally
+	// only of sessions blocked in the PDB named by :1/:2
 	QueryBlockingSessions = `
 		SELECT
 			blocking.sid as blocking_sid,
@@ -178,16 +190,21 @@ const (
 			blocked.wait_class as blocked_wait_class,
 			blocked.event as blocked_event,
 			blocked.seconds_in_wait as blocked_duration_seconds,
-			blocked_sql.sql_text as blocked_sql_text
+			blocked_sql.sql_text as blocked_sql_text,
+			USERENV('INSTANCE') as blocking_inst_id,
+			USERENV('INSTANCE') as blocked_inst_id
 		FROM v$session blocking
 		JOIN v$session blocked ON blocking.sid = blocked.blocking_session
 		LEFT JOIN v$sql blocking_sql ON blocking.sql_id = blocking_sql.sql_id
 		LEFT JOIN v$sql blocked_sql ON blocked.sql_id = blocked_sql.sql_id
 		WHERE blocking.type = 'USER'
+		  AND (:1 IS NULL OR blocked.con_id = CON_NAME_TO_ID(:2))
 		ORDER BY blocked.seconds_in_wait DESC
 	`
 
-	// QueryTablespaces retrieves tablespace usage information
+	// QueryTablespaces retrieves tablespace usage information for every
+	// container (the cdb_ views also work on a non-CDB, where con_id is 0),
+	// optionally only for the PDB named by :1/:2
 	QueryTablespaces = `
 		SELECT
 			df.tablespace_name,
@@ -195,29 +212,68 @@ const (
 			df.total_size_mb - NVL(fs.free_size_mb, 0) as used_size_mb,
 			NVL(fs.free_size_mb, 0) as free_size_mb,
 			ROUND(((df.total_size_mb - NVL(fs.free_size_mb, 0)) / df.total_size_mb) * 100, 2) as usage_percentage,
+			df.max_size_mb,
+			ROUND(((df.total_size_mb - NVL(fs.free_size_mb, 0)) / df.max_size_mb) * 100, 2) as max_usage_percentage,
+			df.autoextensible,
 			ts.status,
 			ts.contents,
-			df.datafile_count
+			df.datafile_count,
+			df.con_id,
+			p.name as pdb_name
 		FROM (
 			SELECT 
+				con_id,
+				tablespace_name,
+				ROUND(SUM(bytes) / 1024 / 1024, 2) as total_size_mb,
+				ROUND(SUM(DECODE(autoextensible, 'YES', GREATEST(maxbytes, bytes), bytes)) / 1024 / 1024, 2) as max_size_mb,
+				MAX(autoextensible) as autoextensible,
+				COUNT(*) as datafile_count
+			FROM cdb_data_files
+			GROUP BY con_id, tablespace_name
+			UNION ALL
+			SELECT
+				con_id,
 				tablespace_name,
 				ROUND(SUM(bytes) / 1024 / 1024, 2) as total_size_mb,
+				ROUND(SUM(DECODE(autoextensible, 'YES', GREATEST(maxbytes, bytes), bytes)) / 1024 / 1024, 2) as max_size_mb,
+				MAX(autoextensible) as autoextensible,
 				COUNT(*) as datafile_count
-			FROM dba_data_files
-			GROUP BY tablespace_name
+			FROM cdb_temp_files
+			GROUP BY con_id, tablespace_name
 		) df
 		LEFT JOIN (
 			SELECT 
+				con_id,
 				tablespace_name,
 				ROUND(SUM(bytes) / 1024 / 1024, 2) as free_size_mb
-			FROM dba_free_space
-			GROUP BY tablespace_name
-		) fs ON df.tablespace_name = fs.tablespace_name
-		JOIN dba_tablespaces ts ON df.tablespace_name = ts.tablespace_name
-		ORDER BY usage_percentage DESC
+			FROM cdb_free_space
+			GROUP BY con_id, tablespace_name
+			UNION ALL
+			-- Temp space is free unless allocated to a sort/hash/temp table segment
+			SELECT
+				tf.con_id,
+				tf.tablespace_name,
+				ROUND((tf.total_bytes - NVL(tu.used_bytes, 0)) / 1024 / 1024, 2) as free_size_mb
+			FROM (
+				SELECT con_id, tablespace_name, SUM(bytes) as total_bytes
+				FROM cdb_temp_files
+				GROUP BY con_id, tablespace_name
+			) tf
+			LEFT JOIN (
+				SELECT u.con_id, u.tablespace, SUM(u.blocks * t.block_size) as used_bytes
+				FROM v$tempseg_usage u
+				JOIN cdb_tablespaces t ON t.con_id = u.con_id AND t.tablespace_name = u.tablespace
+				GROUP BY u.con_id, u.tablespace
+			) tu ON tu.con_id = tf.con_id AND tu.tablespace = tf.tablespace_name
+		) fs ON df.con_id = fs.con_id AND df.tablespace_name = fs.tablespace_name
+		JOIN cdb_tablespaces ts ON df.con_id = ts.con_id AND df.tablespace_name = ts.tablespace_name
+		LEFT JOIN v$pdbs p ON p.con_id = df.con_id
+		WHERE (:1 IS NULL OR df.con_id = CON_NAME_TO_ID(:2))
+		ORDER BY max_usage_percentage DESC
 	`
 
-	// QueryTopSQLByElapsedTime retrieves top SQL by elapsed time
+	// QueryTopSQLByElapsedTime retrieves top SQL by elapsed time, optionally
+	// only for the PDB named by :1/:2
 	QueryTopSQLByElapsedTime = `
 		SELECT
 			sql_id,
@@ -231,15 +287,19 @@ const (
 			buffer_gets,
 			rows_processed,
 			first_load_time,
-			last_active_time
+			last_active_time,
+			USERENV('INSTANCE') as inst_id,
+			con_id
 		FROM v$sql
 		WHERE executions > 0
 		  AND parsing_schema_name IS NOT NULL
+		  AND (:1 IS NULL OR con_id = CON_NAME_TO_ID(:2))
 		ORDER BY elapsed_time DESC
-		FETCH FIRST :1 ROWS ONLY
+		FETCH FIRST :3 ROWS ONLY
 	`
 
-	// QueryTopSQLByCPU retrieves top SQL by CPU time
+	// QueryTopSQLByCPU retrieves top SQL by CPU time, optionally only for the
+	// PDB named by :1/:2
 	QueryTopSQLByCPU = `
 		SELECT
 			sql_id,
@@ -251,17 +311,21 @@ const (
 			ROUND(elapsed_time / 1000000, 2) as elapsed_time_seconds,
 			disk_reads,
 			buffer_gets,
-			rows_processed
+			rows_processed,
+			USERENV('INSTANCE') as inst_id,
+			con_id
 		FROM v$sql
 		WHERE executions > 0
 		  AND parsing_schema_name IS NOT NULL
+		  AND (:1 IS NULL OR con_id = CON_NAME_TO_ID(:2))
 		ORDER BY cpu_time DESC
-		FETCH FIRST :1 ROWS ONLY
+		FETCH FIRST :3 ROWS ONLY
 	`
 
 	// QueryDatabaseInstance retrieves database instance information
 	QueryDatabaseInstance = `
 		SELECT
+			instance_number,
 			instance_name,
 			host_name,
 			version,
@@ -280,7 +344,33 @@ const (
 		FROM dba_data_files
 	`
 
-	// QuerySchemas retrieves all non-system schemas with object counts
+	// QueryPDBs retrieves the pluggable databases of a CDB (none on a non-CDB,
+	// only the current one when connected to a PDB)
+	QueryPDBs = `
+		SELECT
+			con_id,
+			name,
+			dbid,
+			open_mode,
+			restricted,
+			open_time,
+			ROUND(total_size / 1024 / 1024, 2) as total_size_mb,
+			USERENV('INSTANCE') as inst_id
+		FROM v$pdbs
+		WHERE name <> 'PDB$SEED'
+		ORDER BY con_id
+	`
+
+	// QueryConnectedPDB retrieves the container of the session, or NULL on a
+	// non-CDB; actions run there, so the cdb_ views they read are scoped to it
+	QueryConnectedPDB = `
+		SELECT CASE WHEN SYS_CONTEXT('USERENV', 'CON_ID') = '0' THEN NULL
+			ELSE SYS_CONTEXT('USERENV', 'CON_NAME') END
+		FROM dual
+	`
+
+	// QuerySchemas retrieves all non-system schemas with object counts, per
+	// container, optionally only for the PDB named by :1/:2
 	QuerySchemas = `
 		SELECT
 			owner as schema_name,
@@ -290,14 +380,17 @@ const (
 			SUM(CASE WHEN object_type = 'VIEW' THEN 1 ELSE 0 END) as view_count,
 			SUM(CASE WHEN object_type = 'PROCEDURE' THEN 1 ELSE 0 END) as procedure_count,
 			SUM(CASE WHEN object_type = 'FUNCTION' THEN 1 ELSE 0 END) as function_count,
-			SUM(CASE WHEN object_type = 'PACKAGE' THEN 1 ELSE 0 END) as package_count
-		FROM dba_objects
+			SUM(CASE WHEN object_type = 'PACKAGE' THEN 1 ELSE 0 END) as package_count,
+			con_id
+		FROM cdb_objects
 		WHERE owner NOT IN ('SYS', 'SYSTEM', 'OUTLN', 'DBSNMP', 'WMSYS', 'XDB', 'CTXSYS', 'MDSYS', 'ORDSYS')
-		GROUP BY owner
+		  AND (:1 IS NULL OR con_id = CON_NAME_TO_ID(:2))
+		GROUP BY con_id, owner
 		ORDER BY total_objects DESC
 	`
 
-	// QueryInvalidObjects retrieves invalid objects
+	// QueryInvalidObjects retrieves invalid objects of every container,
+	// optionally only in the PDB named by :1/:2 or of one owner (:3/:4)
 	QueryInvalidObjects = `
 		SELECT
 			owner as schema_name,
@@ -305,10 +398,1659 @@ const (
 			object_type,
 			status,
 			last_ddl_time,
-			created
+			created,
+			con_id
+		FROM cdb_objects
+		WHERE status = 'INVALID'
+		  AND owner NOT IN ('SYS', 'SYSTEM', 'OUTLN', 'DBSNMP', 'WMSYS', 'XDB', 'CTXSYS', 'MDSYS', 'ORDSYS')
+		  AND (:1 IS NULL OR con_id = CON_NAME_TO_ID(:2))
+		  AND (:3 IS NULL OR owner = :4)
+		ORDER BY con_id, owner, object_type, object_name
+	`
+
+	// QueryObjectErrors retrieves the compilation errors and warnings of
+	// stored objects, optionally only in the PDB named by :1/:2 or of one
+	// owner (:3/:4)
+	QueryObjectErrors = `
+		SELECT
+			owner,
+			name,
+			type,
+			line,
+			position,
+			text,
+			attribute,
+			con_id
+		FROM cdb_errors
+		WHERE owner NOT IN ('SYS', 'SYSTEM', 'OUTLN', 'DBSNMP', 'WMSYS', 'XDB', 'CTXSYS', 'MDSYS', 'ORDSYS')
+		  AND (:1 IS NULL OR con_id = CON_NAME_TO_ID(:2))
+		  AND (:3 IS NULL OR owner = :4)
+		ORDER BY con_id, owner, type, name, sequence
+	`
+
+	// QueryObjectStatus retrieves the status of one object (:1 owner, :2 name,
+	// :3 type)
+	QueryObjectStatus = `
+		SELECT status
+		FROM dba_objects
+		WHERE owner = :1 AND object_name = :2 AND object_type = :3
+	`
+
+	// ExecRecompileSchema recompiles every invalid object of schema :1 in
+	// dependency order
+	ExecRecompileSchema = `
+		BEGIN
+			UTL_RECOMP.RECOMP_SERIAL(:1);
+		END;
+	`
+
+	// QueryActiveSessionCount counts active user sessions
+	QueryActiveSessionCount = `
+		SELECT COUNT(*) as active_sessions
+		FROM v$session
+		WHERE type = 'USER'
+		  AND username IS NOT NULL
+		  AND status = 'ACTIVE'
+	`
+
+	// QueryInvalidObjectCounts counts invalid objects per schema
+	QueryInvalidObjectCounts = `
+		SELECT
+			owner as schema_name,
+			COUNT(*) as invalid_count
 		FROM dba_objects
 		WHERE status = 'INVALID'
 		  AND owner NOT IN ('SYS', 'SYSTEM', 'OUTLN', 'DBSNMP', 'WMSYS', 'XDB', 'CTXSYS', 'MDSYS', 'ORDSYS')
-		ORDER BY owner, object_type, object_name
+		GROUP BY owner
+		ORDER BY owner
+	`
+
+	// QuerySQLSnapshot retrieves cumulative SQL statistics per SQL_ID, used to
+	// compute deltas between snapshots
+	QuerySQLSnapshot = `
+		SELECT
+			sql_id,
+			MAX(SUBSTR(sql_text, 1, 1000)) as sql_text,
+			MAX(parsing_schema_name) as parsing_schema_name,
+			SUM(executions) as executions,
+			ROUND(SUM(elapsed_time) / 1000, 2) as elapsed_time_ms,
+			ROUND(SUM(cpu_time) / 1000, 2) as cpu_time_ms,
+			SUM(disk_reads) as disk_reads,
+			SUM(buffer_gets) as buffer_gets,
+			SUM(rows_processed) as rows_processed,
+			MAX(last_active_time) as last_active_time,
+			MAX(plan_hash_value) KEEP (DENSE_RANK LAST ORDER BY last_active_time) as plan_hash_value
+		FROM v$sql
+		WHERE executions > 0
+		  AND parsing_schema_name IS NOT NULL
+		GROUP BY sql_id
+		ORDER BY SUM(elapsed_time) DESC
+		FETCH FIRST :1 ROWS ONLY
+	`
+
+	// QueryASHSample samples active foreground sessions that are on CPU or in
+	// a non-idle wait, excluding the sampling session itself
+	QueryASHSample = `
+		SELECT
+			s.sid,
+			s.serial#,
+			s.username,
+			s.sql_id,
+			CASE WHEN s.state = 'WAITING' THEN s.wait_class ELSE 'CPU' END as wait_class,
+			CASE WHEN s.state = 'WAITING' THEN s.event ELSE 'ON CPU' END as event,
+			s.module,
+			s.program,
+			s.machine,
+			s.blocking_session,
+			USERENV('INSTANCE') as inst_id
+		FROM v$session s
+		WHERE s.type = 'USER'
+		  AND s.status = 'ACTIVE'
+		  AND s.sid <> SYS_CONTEXT('USERENV', 'SID')
+		  AND (s.state <> 'WAITING' OR s.wait_class <> 'Idle')
+	`
+
+	// QuerySystemEvents retrieves cumulative non-idle wait event totals since
+	// instance startup
+	QuerySystemEvents = `
+		SELECT
+			event,
+			wait_class,
+			total_waits,
+			time_waited_micro
+		FROM v$system_event
+		WHERE wait_class <> 'Idle'
+	`
+
+	// QuerySysTimeModel retrieves the cumulative time model (microseconds)
+	QuerySysTimeModel = `
+		SELECT
+			stat_name,
+			value
+		FROM v$sys_time_model
+	`
+
+	// QuerySysstat retrieves the cumulative statistics used for load profile
+	// and efficiency ratios
+	QuerySysstat = `
+		SELECT
+			name,
+			value
+		FROM v$sysstat
+		WHERE name IN (
+			'session logical reads',
+			'physical reads',
+			'physical reads cache',
+			'physical writes',
+			'parse count (total)',
+			'parse count (hard)',
+			'execute count',
+			'user commits',
+			'user rollbacks',
+			'user calls',
+			'redo size'
+		)
+	`
+	// QuerySQLByID retrieves statistics for one SQL_ID summed over its child
+	// cursors, with the plan of the most recently active child
+	QuerySQLByID = `
+		SELECT
+			sql_id,
+			MAX(SUBSTR(sql_text, 1, 4000)) as sql_text,
+			MAX(parsing_schema_name) as parsing_schema_name,
+			SUM(executions) as executions,
+			ROUND(SUM(elapsed_time) / 1000000, 2) as elapsed_time_seconds,
+			ROUND(SUM(elapsed_time) / NULLIF(SUM(executions), 0) / 1000000, 4) as avg_elapsed_seconds,
+			ROUND(SUM(cpu_time) / 1000000, 2) as cpu_time_seconds,
+			SUM(disk_reads) as disk_reads,
+			SUM(buffer_gets) as buffer_gets,
+			SUM(rows_processed) as rows_processed,
+			MIN(first_load_time) as first_load_time,
+			MAX(last_active_time) as last_active_time,
+			MAX(plan_hash_value) KEEP (DENSE_RANK LAST ORDER BY last_active_time) as plan_hash_value,
+			USERENV('INSTANCE') as inst_id,
+			CASE WHEN COUNT(DISTINCT con_id) = 1 THEN MIN(con_id) END as con_id
+		FROM v$sql
+		WHERE sql_id = :1
+		GROUP BY sql_id
+	`
+
+	// QueryLatestChildNumber retrieves the most recently active child cursor of a SQL_ID
+	QueryLatestChildNumber = `
+		SELECT child_number
+		FROM v$sql
+		WHERE sql_id = :1
+		ORDER BY last_active_time DESC NULLS LAST, child_number
+		FETCH FIRST 1 ROWS ONLY
+	`
+
+	// QueryExecutionPlanText retrieves the formatted plan of a child cursor
+	QueryExecutionPlanText = `
+		SELECT plan_table_output
+		FROM TABLE(DBMS_XPLAN.DISPLAY_CURSOR(:1, :2, 'TYPICAL'))
+	`
+
+	// QueryExecutionPlanSteps retrieves the plan operations of a child cursor
+	QueryExecutionPlanSteps = `
+		SELECT
+			id,
+			parent_id,
+			depth,
+			operation,
+			options,
+			object_owner,
+			object_name,
+			cost,
+			cardinality,
+			bytes,
+			cpu_cost,
+			io_cost,
+			time,
+			access_predicates,
+			filter_predicates,
+			plan_hash_value
+		FROM v$sql_plan
+		WHERE sql_id = :1
+		  AND child_number = :2
+		ORDER BY id
+	`
+
+	// QuerySQLPlanStats retrieves cumulative executions and elapsed time per
+	// plan of a SQL_ID across the child cursors still in the shared pool
+	QuerySQLPlanStats = `
+		SELECT
+			plan_hash_value,
+			SUM(executions) as executions,
+			ROUND(SUM(elapsed_time) / 1000, 2) as elapsed_time_ms
+		FROM v$sql
+		WHERE sql_id = :1
+		  AND executions > 0
+		GROUP BY plan_hash_value
+	`
+	// QueryLongOps retrieves long-running operations with their owning session,
+	// if it is still connected, optionally only in the PDB named by :1/:2
+	QueryLongOps = `
+		SELECT
+			l.sid,
+			l.serial#,
+			l.opname,
+			l.target,
+			l.target_desc,
+			l.sofar,
+			l.totalwork,
+			l.units,
+			ROUND(l.sofar / NULLIF(l.totalwork, 0) * 100, 2) as percent_complete,
+			l.elapsed_seconds,
+			l.time_remaining,
+			l.start_time,
+			l.last_update_time,
+			l.message,
+			l.sql_id,
+			s.sid as session_sid,
+			s.username,
+			s.schemaname,
+			s.osuser,
+			s.machine,
+			s.program,
+			s.status,
+			s.sql_id as session_sql_id,
+			SUBSTR(sq.sql_text, 1, 1000) as sql_text,
+			s.logon_time,
+			s.last_call_et,
+			s.blocking_session,
+			s.wait_class,
+			s.event,
+			s.seconds_in_wait,
+			USERENV('INSTANCE') as inst_id,
+			s.con_id
+		FROM v$session_longops l
+		LEFT JOIN v$session s ON s.sid = l.sid AND s.serial# = l.serial#
+		LEFT JOIN v$sqlarea sq ON sq.sql_id = s.sql_id
+		WHERE (:1 IS NULL OR l.con_id = CON_NAME_TO_ID(:2))
+		ORDER BY l.start_time DESC
+	`
+
+	// QuerySessionLongOps retrieves the long-running operations of one session
+	QuerySessionLongOps = `
+		SELECT
+			l.sid,
+			l.serial#,
+			l.opname,
+			l.target,
+			l.target_desc,
+			l.sofar,
+			l.totalwork,
+			l.units,
+			ROUND(l.sofar / NULLIF(l.totalwork, 0) * 100, 2) as percent_complete,
+			l.elapsed_seconds,
+			l.time_remaining,
+			l.start_time,
+			l.last_update_time,
+			l.message,
+			l.sql_id,
+			s.sid as session_sid,
+			s.username,
+			s.schemaname,
+			s.osuser,
+			s.machine,
+			s.program,
+			s.status,
+			s.sql_id as session_sql_id,
+			SUBSTR(sq.sql_text, 1, 1000) as sql_text,
+			s.logon_time,
+			s.last_call_et,
+			s.blocking_session,
+			s.wait_class,
+			s.event,
+			s.seconds_in_wait,
+			USERENV('INSTANCE') as inst_id,
+			s.con_id
+		FROM v$session_longops l
+		LEFT JOIN v$session s ON s.sid = l.sid AND s.serial# = l.serial#
+		LEFT JOIN v$sqlarea sq ON sq.sql_id = s.sql_id
+		WHERE l.sid = :1
+		  AND l.serial# = :2
+		ORDER BY l.start_time DESC
+	`
+	// QuerySessionDetail retrieves one session with its client and SQL context
+	QuerySessionDetail = `
+		SELECT
+			s.sid,
+			s.serial#,
+			s.username,
+			s.schemaname,
+			s.osuser,
+			s.machine,
+			s.program,
+			s.status,
+			s.sql_id,
+			SUBSTR(sq.sql_text, 1, 1000) as sql_text,
+			s.logon_time,
+			s.last_call_et,
+			s.blocking_session,
+			s.wait_class,
+			s.event,
+			s.seconds_in_wait,
+			s.state,
+			s.client_identifier,
+			s.client_info,
+			s.module,
+			s.action,
+			s.service_name,
+			s.terminal,
+			p.spid,
+			s.sql_child_number,
+			s.sql_exec_start,
+			s.prev_sql_id,
+			s.prev_child_number,
+			USERENV('INSTANCE') as inst_id,
+			s.con_id
+		FROM v$session s
+		LEFT JOIN v$process p ON p.addr = s.paddr
+		LEFT JOIN v$sqlarea sq ON sq.sql_id = s.sql_id
+		WHERE s.sid = :1
+	`
+
+	// QuerySQLFullText retrieves the complete text of a SQL statement in pieces
+	QuerySQLFullText = `
+		SELECT
+			address,
+			sql_text
+		FROM v$sqltext
+		WHERE sql_id = :1
+		ORDER BY address, piece
+	`
+
+	// QuerySessionStats retrieves the statistics shown in session drill-down
+	QuerySessionStats = `
+		SELECT
+			n.name,
+			st.value
+		FROM v$sesstat st
+		JOIN v$statname n ON n.statistic# = st.statistic#
+		WHERE st.sid = :1
+		  AND n.name IN (
+			'CPU used by this session',
+			'session logical reads',
+			'physical reads',
+			'session pga memory',
+			'session pga memory max',
+			'opened cursors current'
+		  )
+	`
+
+	// QuerySessionOpenCursors retrieves the cursors a session has open
+	QuerySessionOpenCursors = `
+		SELECT
+			sql_id,
+			sql_text,
+			cursor_type,
+			last_sql_active_time
+		FROM v$open_cursor
+		WHERE sid = :1
+		ORDER BY last_sql_active_time DESC NULLS LAST
+	`
+
+	// QuerySessionLocks retrieves the locks a session holds or requests
+	QuerySessionLocks = `
+		SELECT
+			l.type,
+			DECODE(l.lmode, 0, 'None', 1, 'Null', 2, 'Row-S (SS)', 3, 'Row-X (SX)',
+				4, 'Share', 5, 'S/Row-X (SSX)', 6, 'Exclusive', TO_CHAR(l.lmode)) as lock_mode,
+			DECODE(l.request, 0, 'None', 1, 'Null', 2, 'Row-S (SS)', 3, 'Row-X (SX)',
+				4, 'Share', 5, 'S/Row-X (SSX)', 6, 'Exclusive', TO_CHAR(l.request)) as requested_mode,
+			l.id1,
+			l.id2,
+			l.ctime,
+			l.block,
+			o.owner,
+			o.object_name,
+			o.object_type
+		FROM v$lock l
+		LEFT JOIN dba_objects o ON l.type = 'TM' AND o.object_id = l.id1
+		WHERE l.sid = :1
+		ORDER BY l.ctime DESC
+	`
+
+	// QuerySessionWaitHistory retrieves the last waits of a session, most recent first
+	QuerySessionWaitHistory = `
+		SELECT
+			h.seq#,
+			h.event,
+			en.wait_class,
+			h.wait_time_micro,
+			h.time_since_last_wait_micro,
+			h.p1text,
+			TO_CHAR(h.p1) as p1,
+			h.p2text,
+			TO_CHAR(h.p2) as p2,
+			h.p3text,
+			TO_CHAR(h.p3) as p3
+		FROM v$session_wait_history h
+		LEFT JOIN v$event_name en ON en.event# = h.event#
+		WHERE h.sid = :1
+		ORDER BY h.seq#
+	`
+	// QueryTempUsage retrieves the :3 largest temporary segment users per
+	// session and SQL, optionally only in the PDB named by :1/:2
+	QueryTempUsage = `
+		SELECT
+			s.sid,
+			s.serial#,
+			s.username,
+			u.sql_id,
+			SUBSTR(sq.sql_text, 1, 1000) as sql_text,
+			u.tablespace,
+			u.segtype,
+			ROUND(u.blocks * t.block_size / 1024 / 1024, 2) as used_mb
+		FROM v$tempseg_usage u
+		JOIN v$session s ON s.saddr = u.session_addr
+		JOIN cdb_tablespaces t ON t.con_id = u.con_id AND t.tablespace_name = u.tablespace
+		LEFT JOIN v$sqlarea sq ON sq.sql_id = u.sql_id
+		WHERE (:1 IS NULL OR u.con_id = CON_NAME_TO_ID(:2))
+		ORDER BY u.blocks DESC
+		FETCH FIRST :3 ROWS ONLY
+	`
+
+	// QueryUndoParameters retrieves the undo configuration
+	QueryUndoParameters = `
+		SELECT
+			MAX(DECODE(name, 'undo_tablespace', value)) as undo_tablespace,
+			TO_NUMBER(MAX(DECODE(name, 'undo_retention', value))) as undo_retention
+		FROM v$parameter
+		WHERE name IN ('undo_tablespace', 'undo_retention')
+	`
+
+	// QueryUndoStat retrieves the 10-minute undo statistics buckets of the
+	// last :1 hours, most recent first
+	QueryUndoStat = `
+		SELECT
+			begin_time,
+			end_time,
+			undoblks,
+			txncount,
+			maxquerylen,
+			maxqueryid,
+			tuned_undoretention,
+			activeblks,
+			unexpiredblks,
+			expiredblks,
+			unxpstealcnt,
+			ssolderrcnt,
+			nospaceerrcnt
+		FROM v$undostat
+		WHERE begin_time >= SYSDATE - :1 / 24
+		ORDER BY begin_time DESC
+	`
+
+	// QueryActiveTransactions retrieves the :3 open transactions holding the
+	// most undo, optionally only in the PDB named by :1/:2
+	QueryActiveTransactions = `
+		SELECT
+			s.sid,
+			s.serial#,
+			s.username,
+			s.program,
+			t.status,
+			t.start_date,
+			ROUND((SYSDATE - t.start_date) * 86400) as elapsed_seconds,
+			t.used_ublk,
+			t.used_urec,
+			ROUND(t.used_ublk * p.block_size / 1024 / 1024, 2) as undo_mb,
+			NVL(s.sql_id, s.prev_sql_id) as sql_id
+		FROM v$transaction t
+		JOIN v$session s ON s.taddr = t.addr
+		CROSS JOIN (
+			SELECT TO_NUMBER(value) as block_size FROM v$parameter WHERE name = 'db_block_size'
+		) p
+		WHERE (:1 IS NULL OR t.con_id = CON_NAME_TO_ID(:2))
+		ORDER BY t.used_ublk DESC
+		FETCH FIRST :3 ROWS ONLY
+	`
+
+	// QueryDatafiles retrieves every data file and temp file of every container
+	// with its autoextend settings. Used space is only known for data files.
+	QueryDatafiles = `
+		SELECT
+			d.file_id,
+			d.file_name,
+			d.tablespace_name,
+			d.status,
+			ROUND(d.bytes / 1024 / 1024, 2) as size_mb,
+			ROUND((d.bytes - NVL(f.free_bytes, 0)) / 1024 / 1024, 2) as used_mb,
+			d.autoextensible,
+			ROUND(DECODE(d.autoextensible, 'YES', GREATEST(d.maxbytes, d.bytes), d.bytes) / 1024 / 1024, 2) as max_size_mb,
+			ROUND(d.increment_by * t.block_size / 1024 / 1024, 2) as increment_mb,
+			0 as temporary,
+			d.con_id
+		FROM cdb_data_files d
+		JOIN cdb_tablespaces t ON t.con_id = d.con_id AND t.tablespace_name = d.tablespace_name
+		LEFT JOIN (
+			SELECT con_id, file_id, SUM(bytes) as free_bytes
+			FROM cdb_free_space
+			GROUP BY con_id, file_id
+		) f ON f.con_id = d.con_id AND f.file_id = d.file_id
+		UNION ALL
+		SELECT
+			d.file_id,
+			d.file_name,
+			d.tablespace_name,
+			d.status,
+			ROUND(d.bytes / 1024 / 1024, 2) as size_mb,
+			NULL as used_mb,
+			d.autoextensible,
+			ROUND(DECODE(d.autoextensible, 'YES', GREATEST(d.maxbytes, d.bytes), d.bytes) / 1024 / 1024, 2) as max_size_mb,
+			ROUND(d.increment_by * t.block_size / 1024 / 1024, 2) as increment_mb,
+			1 as temporary,
+			d.con_id
+		FROM cdb_temp_files d
+		JOIN cdb_tablespaces t ON t.con_id = d.con_id AND t.tablespace_name = d.tablespace_name
+		ORDER BY 11, 3, 10, 1
+	`
+
+	// QueryASMDiskGroups retrieves ASM disk group capacity; empty when the
+	// database does not use ASM
+	QueryASMDiskGroups = `
+		SELECT
+			group_number,
+			name,
+			state,
+			type,
+			total_mb,
+			free_mb,
+			usable_file_mb,
+			required_mirror_free_mb,
+			ROUND(DECODE(total_mb, 0, 0, (total_mb - free_mb) / total_mb * 100), 2) as usage_percentage,
+			offline_disks
+		FROM v$asm_diskgroup
+		ORDER BY name
+	`
+
+	// QueryASMDisks retrieves the disks of every mounted ASM disk group
+	QueryASMDisks = `
+		SELECT
+			group_number,
+			disk_number,
+			name,
+			path,
+			failgroup,
+			mount_status,
+			header_status,
+			mode_status,
+			state,
+			total_mb,
+			free_mb
+		FROM v$asm_disk
+		WHERE group_number > 0
+		ORDER BY group_number, disk_number
+	`
+
+	// QueryRedoLogGroups retrieves the online redo log groups
+	QueryRedoLogGroups = `
+		SELECT
+			group#,
+			thread#,
+			sequence#,
+			ROUND(bytes / 1024 / 1024, 2) as size_mb,
+			members,
+			archived,
+			status,
+			first_time
+		FROM v$log
+		ORDER BY thread#, group#
+	`
+
+	// QueryRedoLogFiles retrieves the members of every redo log group
+	QueryRedoLogFiles = `
+		SELECT group#, member, type, status
+		FROM v$logfile
+		ORDER BY group#, member
+	`
+
+	// QueryLogSwitchesPerHour retrieves log switches in each hour of the last
+	// :1 days, including hours without any
+	QueryLogSwitchesPerHour = `
+		SELECT
+			h.hour,
+			COUNT(lh.first_time) as switches
+		FROM (
+			SELECT TRUNC(SYSDATE, 'HH24') - (LEVEL - 1) / 24 as hour
+			FROM dual
+			CONNECT BY LEVEL <= :1 * 24
+		) h
+		LEFT JOIN v$log_history lh
+			ON lh.first_time >= h.hour AND lh.first_time < h.hour + 1 / 24
+		GROUP BY h.hour
+		ORDER BY h.hour
+	`
+
+	// QueryArchiveGenerationPerDay retrieves archived redo per day over the
+	// last :1 days, counting each log once even with several destinations
+	QueryArchiveGenerationPerDay = `
+		SELECT
+			TRUNC(completion_time) as day,
+			COUNT(*) as archived_logs,
+			ROUND(SUM(blocks * block_size) / 1024 / 1024, 2) as size_mb
+		FROM v$archived_log
+		WHERE completion_time >= TRUNC(SYSDATE) - :1
+		  AND standby_dest = 'NO'
+		  AND dest_id = (SELECT MIN(dest_id) FROM v$archived_log WHERE standby_dest = 'NO')
+		GROUP BY TRUNC(completion_time)
+		ORDER BY day
+	`
+
+	// QueryLogMode retrieves whether the database archives its redo
+	QueryLogMode = `
+		SELECT log_mode FROM v$database
+	`
+
+	// QueryRecoveryFileDest retrieves Fast Recovery Area space; no rows when
+	// no recovery area is configured
+	QueryRecoveryFileDest = `
+		SELECT
+			name,
+			ROUND(space_limit / 1024 / 1024, 2) as space_limit_mb,
+			ROUND(space_used / 1024 / 1024, 2) as space_used_mb,
+			ROUND(space_reclaimable / 1024 / 1024, 2) as space_reclaimable_mb,
+			number_of_files
+		FROM v$recovery_file_dest
+		WHERE space_limit > 0
+	`
+
+	// QueryRecoveryAreaUsage retrieves Fast Recovery Area usage by file type
+	QueryRecoveryAreaUsage = `
+		SELECT
+			file_type,
+			percent_space_used,
+			percent_space_reclaimable,
+			number_of_files
+		FROM v$recovery_area_usage
+		ORDER BY percent_space_used DESC
+	`
+
+	// QueryBackupJobs retrieves RMAN jobs started in the last :1 days
+	QueryBackupJobs = `
+		SELECT
+			session_key,
+			command_id,
+			start_time,
+			end_time,
+			status,
+			input_type,
+			output_device_type,
+			elapsed_seconds,
+			ROUND(input_bytes / 1024 / 1024, 2) as input_mb,
+			ROUND(output_bytes / 1024 / 1024, 2) as output_mb,
+			compression_ratio
+		FROM v$rman_backup_job_details
+		WHERE start_time >= SYSDATE - :1
+		ORDER BY start_time DESC
+	`
+
+	// QueryBackupSets retrieves backup sets completed in the last :1 days
+	// with the size of their pieces that are still available
+	QueryBackupSets = `
+		SELECT
+			bs.set_stamp,
+			bs.set_count,
+			bs.backup_type,
+			bs.incremental_level,
+			bs.pieces,
+			bs.start_time,
+			bs.completion_time,
+			bs.elapsed_seconds,
+			bs.controlfile_included,
+			ROUND(NVL(bp.bytes, 0) / 1024 / 1024, 2) as size_mb,
+			NVL(bp.available_pieces, 0) as available_pieces
+		FROM v$backup_set bs
+		LEFT JOIN (
+			SELECT set_stamp, set_count, SUM(bytes) as bytes, COUNT(*) as available_pieces
+			FROM v$backup_piece
+			WHERE status = 'A'
+			GROUP BY set_stamp, set_count
+		) bp ON bp.set_stamp = bs.set_stamp AND bp.set_count = bs.set_count
+		WHERE bs.completion_time >= SYSDATE - :1
+		ORDER BY bs.completion_time DESC
+	`
+
+	// QueryDatafileBackupCoverage retrieves the most recent full (or level 0)
+	// backup of every datafile that still has an available piece. Files never
+	// backed up come first.
+	QueryDatafileBackupCoverage = `
+		SELECT
+			f.file#,
+			f.name,
+			MAX(bd.completion_time) as last_full_backup
+		FROM v$datafile f
+		LEFT JOIN v$backup_datafile bd
+			ON bd.file# = f.file#
+			AND NVL(bd.incremental_level, 0) = 0
+			AND EXISTS (
+				SELECT 1 FROM v$backup_piece bp
+				WHERE bp.set_stamp = bd.set_stamp AND bp.set_count = bd.set_count AND bp.status = 'A'
+			)
+		GROUP BY f.file#, f.name
+		ORDER BY last_full_backup NULLS FIRST, f.file#
+	`
+
+	// QueryBackupCoverage retrieves the most recent backup of each kind from
+	// the controlfile records, with when the database was created
+	QueryBackupCoverage = `
+		SELECT
+			SYSDATE as now,
+			d.created,
+			d.log_mode,
+			(SELECT MAX(completion_time) FROM v$backup_datafile
+			 WHERE file# > 0 AND incremental_level > 0) as last_incremental_backup,
+			(SELECT MAX(completion_time) FROM v$backup_redolog) as last_archivelog_backup,
+			(SELECT MAX(completion_time) FROM v$backup_datafile WHERE file# = 0) as last_controlfile_backup,
+			(SELECT MAX(completion_time) FROM v$backup_spfile) as last_spfile_backup
+		FROM v$database d
+	`
+
+	// QueryDataGuardDatabase retrieves the Data Guard role and protection settings
+	QueryDataGuardDatabase = `
+		SELECT
+			db_unique_name,
+			database_role,
+			protection_mode,
+			protection_level,
+			switchover_status,
+			dataguard_broker
+		FROM v$database
+	`
+
+	// QueryDataGuardStats retrieves lag figures, populated on a standby.
+	// Values are intervals formatted as +DD HH:MI:SS.
+	QueryDataGuardStats = `
+		SELECT name, value
+		FROM v$dataguard_stats
+		WHERE name IN ('transport lag', 'apply lag', 'apply finish time')
+	`
+
+	// QueryApplyRate retrieves the redo apply rate (KB/s) of the current recovery
+	QueryApplyRate = `
+		SELECT item, sofar
+		FROM v$recovery_progress
+		WHERE item IN ('Active Apply Rate', 'Average Apply Rate')
+		  AND start_time = (SELECT MAX(start_time) FROM v$recovery_progress)
+	`
+
+	// QueryArchiveDestStatus retrieves the status of every enabled archive destination
+	QueryArchiveDestStatus = `
+		SELECT
+			dest_id,
+			dest_name,
+			status,
+			type,
+			database_mode,
+			recovery_mode,
+			destination,
+			archived_thread#,
+			archived_seq#,
+			applied_thread#,
+			applied_seq#,
+			gap_status,
+			error
+		FROM v$archive_dest_status
+		WHERE status <> 'INACTIVE'
+		ORDER BY dest_id
+	`
+
+	// QueryManagedStandby retrieves the redo transport and apply processes
+	QueryManagedStandby = `
+		SELECT
+			process,
+			pid,
+			status,
+			client_process,
+			thread#,
+			sequence#,
+			block#,
+			blocks
+		FROM v$managed_standby
+		ORDER BY process, thread#
+	`
+
+	// QueryArchiveGap retrieves archived log sequences missing on a standby
+	QueryArchiveGap = `
+		SELECT thread#, low_sequence#, high_sequence#
+		FROM v$archive_gap
+		ORDER BY thread#
+	`
+
+	// QueryParameters retrieves the initialization parameters of the connected
+	// instance with the value stored in the spfile, preferring an
+	// instance-specific spfile entry over a '*' one. spfile_value is NULL when
+	// the parameter is not set in the spfile (or the instance uses a pfile).
+	QueryParameters = `
+		SELECT
+			p.name,
+			p.display_value,
+			sp.display_value as spfile_value,
+			p.isdefault,
+			p.ismodified,
+			p.isdeprecated,
+			p.isses_modifiable,
+			p.issys_modifiable,
+			p.ispdb_modifiable,
+			p.description
+		FROM v$parameter p
+		LEFT JOIN (
+			SELECT name, LISTAGG(display_value, ', ') WITHIN GROUP (ORDER BY ordinal) as display_value
+			FROM (
+				SELECT
+					name,
+					display_value,
+					ordinal,
+					DENSE_RANK() OVER (PARTITION BY name ORDER BY DECODE(sid, '*', 2, 1)) as preference
+				FROM v$spparameter
+				WHERE isspecified = 'TRUE'
+				  AND sid IN ('*', SYS_CONTEXT('USERENV', 'INSTANCE_NAME'))
+			)
+			WHERE preference = 1
+			GROUP BY name
+		) sp ON sp.name = p.name
+		ORDER BY p.name
+	`
+
+	// QuerySGAInfo retrieves the SGA breakdown (fixed size, redo buffers,
+	// pools, granule size, maximum and free SGA)
+	QuerySGAInfo = `
+		SELECT
+			name,
+			ROUND(bytes / 1024 / 1024, 2) as size_mb,
+			resizeable
+		FROM v$sgainfo
+		ORDER BY bytes DESC
+	`
+
+	// QuerySGADynamicComponents retrieves the automatically sized SGA
+	// components with their last resize operation
+	QuerySGADynamicComponents = `
+		SELECT
+			component,
+			ROUND(current_size / 1024 / 1024, 2) as current_size_mb,
+			ROUND(min_size / 1024 / 1024, 2) as min_size_mb,
+			ROUND(max_size / 1024 / 1024, 2) as max_size_mb,
+			ROUND(user_specified_size / 1024 / 1024, 2) as user_specified_size_mb,
+			oper_count,
+			last_oper_type,
+			last_oper_mode,
+			last_oper_time,
+			ROUND(granule_size / 1024 / 1024, 2) as granule_size_mb
+		FROM v$sga_dynamic_components
+		ORDER BY current_size DESC, component
+	`
+
+	// QueryPGAStat retrieves the PGA statistics; unit is 'bytes', 'percent'
+	// or NULL for counts
+	QueryPGAStat = `
+		SELECT name, value, unit
+		FROM v$pgastat
+	`
+
+	// QueryProcessMemory retrieves the :1 processes holding the most PGA with
+	// their memory by category (SQL, PL/SQL, Freeable, Other, ...) and owning
+	// session. Background processes have no session.
+	QueryProcessMemory = `
+		SELECT
+			p.pid,
+			p.spid,
+			s.sid,
+			s.serial#,
+			s.username,
+			p.program,
+			ROUND(p.pga_used_mem / 1024 / 1024, 2) as pga_used_mb,
+			ROUND(p.pga_alloc_mem / 1024 / 1024, 2) as pga_alloc_mb,
+			ROUND(p.pga_freeable_mem / 1024 / 1024, 2) as pga_freeable_mb,
+			ROUND(p.pga_max_mem / 1024 / 1024, 2) as pga_max_mb,
+			pm.category,
+			ROUND(pm.allocated / 1024 / 1024, 2) as allocated_mb,
+			ROUND(pm.used / 1024 / 1024, 2) as used_mb,
+			ROUND(pm.max_allocated / 1024 / 1024, 2) as max_allocated_mb
+		FROM (
+			SELECT pid, spid, addr, program, pga_used_mem, pga_alloc_mem, pga_freeable_mem, pga_max_mem
+			FROM v$process
+			ORDER BY pga_alloc_mem DESC
+			FETCH FIRST :1 ROWS ONLY
+		) p
+		LEFT JOIN v$session s ON s.paddr = p.addr
+		LEFT JOIN v$process_memory pm ON pm.pid = p.pid
+		ORDER BY p.pga_alloc_mem DESC, p.pid, pm.allocated DESC
+	`
+
+	// QueryMemoryResizeOps retrieves the SGA and PGA resize operations that
+	// started between :1 and :2, newest first. Oracle keeps only the last 800.
+	QueryMemoryResizeOps = `
+		SELECT
+			component,
+			oper_type,
+			oper_mode,
+			parameter,
+			ROUND(initial_size / 1024 / 1024, 2) as initial_size_mb,
+			ROUND(target_size / 1024 / 1024, 2) as target_size_mb,
+			ROUND(final_size / 1024 / 1024, 2) as final_size_mb,
+			status,
+			start_time,
+			end_time
+		FROM v$memory_resize_ops
+		WHERE start_time BETWEEN :1 AND :2
+		ORDER BY start_time DESC, component
+	`
+
+	// QuerySGATargetAdvice retrieves the estimated DB time and physical reads
+	// at other SGA_TARGET sizes; size_factor 1 is the current size
+	QuerySGATargetAdvice = `
+		SELECT
+			sga_size,
+			sga_size_factor,
+			estd_db_time,
+			estd_db_time_factor,
+			estd_physical_reads
+		FROM v$sga_target_advice
+		ORDER BY sga_size
+	`
+
+	// QueryPGATargetAdvice retrieves the estimated cache hit percentage and
+	// over-allocations at other PGA_AGGREGATE_TARGET sizes
+	QueryPGATargetAdvice = `
+		SELECT
+			ROUND(pga_target_for_estimate / 1024 / 1024, 2) as pga_target_mb,
+			pga_target_factor,
+			estd_pga_cache_hit_percentage,
+			estd_overalloc_count,
+			ROUND(estd_extra_bytes_rw / 1024 / 1024, 2) as estd_extra_mb_rw
+		FROM v$pga_target_advice
+		ORDER BY pga_target_for_estimate
+	`
+
+	// QueryDBCacheAdvice retrieves the estimated physical reads at other
+	// buffer cache sizes for every pool and block size being advised on
+	QueryDBCacheAdvice = `
+		SELECT
+			name,
+			block_size,
+			size_for_estimate,
+			size_factor,
+			estd_physical_read_factor,
+			estd_physical_reads,
+			estd_physical_read_time
+		FROM v$db_cache_advice
+		WHERE advice_status = 'ON'
+		ORDER BY name, block_size, size_for_estimate
+	`
+
+	// QueryTableStatistics retrieves table-level optimizer statistics of every
+	// container with the DML tracked since they were gathered, optionally only
+	// in the PDB named by :1/:2, for one owner (:3/:4) and table (:5/:6).
+	// Modifications are flushed from memory every few minutes, so the latest
+	// DML may not be counted yet.
+	QueryTableStatistics = `
+		SELECT
+			ts.owner,
+			ts.table_name,
+			ts.num_rows,
+			ts.last_analyzed,
+			ts.stale_stats,
+			ts.stattype_locked,
+			NVL(m.inserts, 0) as inserts,
+			NVL(m.updates, 0) as updates,
+			NVL(m.deletes, 0) as deletes,
+			NVL(m.truncated, 'NO') as truncated,
+			m.timestamp as last_modified,
+			CASE WHEN ts.num_rows > 0
+				THEN ROUND((m.inserts + m.updates + m.deletes) * 100 / ts.num_rows, 2)
+			END as pct_modified,
+			ts.con_id
+		FROM cdb_tab_statistics ts
+		LEFT JOIN cdb_tab_modifications m
+			ON m.con_id = ts.con_id
+			AND m.table_owner = ts.owner
+			AND m.table_name = ts.table_name
+			AND m.partition_name IS NULL
+		WHERE ts.object_type = 'TABLE'
+		  AND ts.table_name NOT LIKE 'BIN$%'
+		  AND ts.owner NOT IN ('SYS', 'SYSTEM', 'OUTLN', 'DBSNMP', 'WMSYS', 'XDB', 'CTXSYS', 'MDSYS', 'ORDSYS')
+		  AND (:1 IS NULL OR ts.con_id = CON_NAME_TO_ID(:2))
+		  AND (:3 IS NULL OR ts.owner = :4)
+		  AND (:5 IS NULL OR ts.table_name = :6)
+		ORDER BY ts.con_id, ts.owner, ts.table_name
+	`
+
+	// ExecGatherTableStats gathers statistics for table :2 of :1. A NULL
+	// estimate percent (:3) uses AUTO_SAMPLE_SIZE; cascade (:4) is 1, 0, or
+	// NULL to let Oracle decide whether to gather index statistics.
+	ExecGatherTableStats = `
+		BEGIN
+			DBMS_STATS.GATHER_TABLE_STATS(
+				ownname          => :1,
+				tabname          => :2,
+				estimate_percent => NVL(:3, DBMS_STATS.AUTO_SAMPLE_SIZE),
+				cascade          => CASE :4 WHEN 1 THEN TRUE WHEN 0 THEN FALSE ELSE DBMS_STATS.AUTO_CASCADE END
+			);
+		END;
+	`
+
+	// QueryAutoStatsClient retrieves whether automatic statistics gathering is enabled
+	QueryAutoStatsClient = `
+		SELECT status
+		FROM dba_autotask_client
+		WHERE client_name = 'auto optimizer stats collection'
+	`
+
+	// QueryAutoStatsJobHistory retrieves the automatic statistics gathering
+	// runs started between :1 and :2, newest first
+	QueryAutoStatsJobHistory = `
+		SELECT
+			window_name,
+			window_start_time,
+			job_name,
+			job_status,
+			job_start_time,
+			EXTRACT(DAY FROM job_duration) * 86400
+				+ EXTRACT(HOUR FROM job_duration) * 3600
+				+ EXTRACT(MINUTE FROM job_duration) * 60
+				+ EXTRACT(SECOND FROM job_duration) as duration_seconds,
+			job_error,
+			job_info
+		FROM dba_autotask_job_history
+		WHERE client_name = 'auto optimizer stats collection'
+		  AND job_start_time BETWEEN :1 AND :2
+		ORDER BY job_start_time DESC
+	`
+
+	// QueryIndexes retrieves every B-tree and bitmap index of every container
+	// with its columns in order, the primary or unique key it enforces and its
+	// unusable partitions, optionally only in the PDB named by :1/:2 or on
+	// tables of one owner (:3/:4)
+	QueryIndexes = `
+		SELECT
+			i.owner,
+			i.index_name,
+			i.table_owner,
+			i.table_name,
+			i.index_type,
+			i.uniqueness,
+			i.status,
+			i.funcidx_status,
+			c.constraint_name,
+			LISTAGG(ic.column_name, ',') WITHIN GROUP (ORDER BY ic.column_position) as columns,
+			(
+				SELECT LISTAGG(p.partition_name, ',') WITHIN GROUP (ORDER BY p.partition_position)
+				FROM cdb_ind_partitions p
+				WHERE p.con_id = i.con_id
+				  AND p.index_owner = i.owner
+				  AND p.index_name = i.index_name
+				  AND p.status = 'UNUSABLE'
+			) as unusable_partitions,
+			i.con_id
+		FROM cdb_indexes i
+		JOIN cdb_ind_columns ic
+			ON ic.con_id = i.con_id AND ic.index_owner = i.owner AND ic.index_name = i.index_name
+		LEFT JOIN cdb_constraints c
+			ON c.con_id = i.con_id
+			AND c.index_owner = i.owner
+			AND c.index_name = i.index_name
+			AND c.constraint_type IN ('P', 'U')
+		WHERE i.index_type NOT IN ('LOB', 'DOMAIN', 'CLUSTER', 'IOT - TOP')
+		  AND i.table_name NOT LIKE 'BIN$%'
+		  AND i.table_owner NOT IN ('SYS', 'SYSTEM', 'OUTLN', 'DBSNMP', 'WMSYS', 'XDB', 'CTXSYS', 'MDSYS', 'ORDSYS')
+		  AND (:1 IS NULL OR i.con_id = CON_NAME_TO_ID(:2))
+		  AND (:3 IS NULL OR i.table_owner = :4)
+		GROUP BY i.con_id, i.owner, i.index_name, i.table_owner, i.table_name, i.index_type,
+			i.uniqueness, i.status, i.funcidx_status, c.constraint_name
+		ORDER BY i.con_id, i.table_owner, i.table_name, i.index_name
+	`
+
+	// QueryForeignKeys retrieves every foreign key of every container with its
+	// columns in order, the table it references and the row count of its own
+	// table, optionally only in the PDB named by :1/:2 or of one owner (:3/:4)
+	QueryForeignKeys = `
+		SELECT
+			c.owner,
+			c.table_name,
+			c.constraint_name,
+			LISTAGG(cc.column_name, ',') WITHIN GROUP (ORDER BY cc.position) as columns,
+			r.owner as referenced_owner,
+			r.table_name as referenced_table,
+			t.num_rows,
+			c.con_id
+		FROM cdb_constraints c
+		JOIN cdb_cons_columns cc
+			ON cc.con_id = c.con_id AND cc.owner = c.owner AND cc.constraint_name = c.constraint_name
+		JOIN cdb_constraints r
+			ON r.con_id = c.con_id AND r.owner = c.r_owner AND r.constraint_name = c.r_constraint_name
+		LEFT JOIN cdb_tables t
+			ON t.con_id = c.con_id AND t.owner = c.owner AND t.table_name = c.table_name
+		WHERE c.constraint_type = 'R'
+		  AND c.table_name NOT LIKE 'BIN$%'
+		  AND c.owner NOT IN ('SYS', 'SYSTEM', 'OUTLN', 'DBSNMP', 'WMSYS', 'XDB', 'CTXSYS', 'MDSYS', 'ORDSYS')
+		  AND (:1 IS NULL OR c.con_id = CON_NAME_TO_ID(:2))
+		  AND (:3 IS NULL OR c.owner = :4)
+		GROUP BY c.con_id, c.owner, c.table_name, c.constraint_name, r.owner, r.table_name, t.num_rows
+		ORDER BY c.con_id, c.owner, c.table_name, c.constraint_name
+	`
+
+	// QueryIndexUsage retrieves the use of indexes tracked since 12.2, in
+	// every container. Only indexes used at least once have a row; tracking
+	// samples by default.
+	QueryIndexUsage = `
+		SELECT
+			owner,
+			name,
+			total_access_count,
+			total_exec_count,
+			total_rows_returned,
+			last_used,
+			con_id
+		FROM cdb_index_usage
+	`
+
+	// QueryObjectUsage retrieves the use of indexes under ALTER INDEX ...
+	// MONITORING USAGE, the all-schema, all-container form of v$object_usage
+	QueryObjectUsage = `
+		SELECT
+			owner,
+			index_name,
+			used,
+			TO_DATE(start_monitoring, 'MM/DD/YYYY HH24:MI:SS') as start_monitoring,
+			con_id
+		FROM cdb_object_usage
+	`
+
+	// QuerySegments retrieves the :7 largest segments of every container,
+	// optionally only in the PDB named by :1/:2, in one tablespace (:3/:4) or
+	// of one owner (:5/:6)
+	QuerySegments = `
+		SELECT
+			owner,
+			segment_name,
+			partition_name,
+			segment_type,
+			tablespace_name,
+			ROUND(bytes / 1024 / 1024, 2) as size_mb,
+			extents,
+			blocks,
+			con_id
+		FROM cdb_segments
+		WHERE (:1 IS NULL OR con_id = CON_NAME_TO_ID(:2))
+		  AND (:3 IS NULL OR tablespace_name = :4)
+		  AND (:5 IS NULL OR owner = :6)
+		ORDER BY bytes DESC, con_id, owner, segment_name, partition_name
+		FETCH FIRST :7 ROWS ONLY
+	`
+
+	// ========================================================================
+	// RAC (gv$) variants, used in cluster mode. Each returns the same columns
+	// as its v$ counterpart, with inst_id naming the instance of each row.
+	// ========================================================================
+
+	// QueryClusterActiveSessions retrieves active sessions on every instance
+	QueryClusterActiveSessions = `
+		SELECT
+			s.sid,
+			s.serial#,
+			s.username,
+			s.schemaname,
+			s.osuser,
+			s.machine,
+			s.program,
+			s.status,
+			s.sql_id,
+			sq.sql_text,
+			s.logon_time,
+			s.last_call_et,
+			s.blocking_session,
+			s.wait_class,
+			s.event,
+			s.seconds_in_wait,
+			s.inst_id,
+			s.con_id
+		FROM gv$session s
+		LEFT JOIN gv$sql sq ON s.sql_id = sq.sql_id AND s.inst_id = sq.inst_id
+		WHERE s.type = 'USER'
+		  AND s.username IS NOT NULL
+		  AND s.status = 'ACTIVE'
+		ORDER BY s.last_call_et DESC
+	`
+
+	// QueryClusterAllSessions retrieves user sessions on every instance
+	QueryClusterAllSessions = `
+		SELECT
+			s.sid,
+			s.serial#,
+			s.username,
+			s.schemaname,
+			s.osuser,
+			s.machine,
+			s.program,
+			s.status,
+			s.sql_id,
+			sq.sql_text,
+			s.logon_time,
+			s.last_call_et,
+			s.blocking_session,
+			s.wait_class,
+			s.event,
+			s.seconds_in_wait,
+			s.inst_id,
+			s.con_id
+		FROM gv$session s
+		LEFT JOIN gv$sql sq ON s.sql_id = sq.sql_id AND s.inst_id = sq.inst_id
+		WHERE s.type = 'USER'
+		  AND s.username IS NOT NULL
+		ORDER BY s.logon_time DESC
+	`
+
+	// QueryClusterSessionsBySchema retrieves sessions for a schema on every instance
+	QueryClusterSessionsBySchema = `
+		SELECT
+			s.sid,
+			s.serial#,
+			s.username,
+			s.schemaname,
+			s.osuser,
+			s.machine,
+			s.program,
+			s.status,
+			s.sql_id,
+			sq.sql_text,
+			s.logon_time,
+			s.last_call_et,
+			s.blocking_session,
+			s.wait_class,
+			s.event,
+			s.seconds_in_wait,
+			s.inst_id,
+			s.con_id
+		FROM gv$session s
+		LEFT JOIN gv$sql sq ON s.sql_id = sq.sql_id AND s.inst_id = sq.inst_id
+		WHERE s.type = 'USER'
+		  AND s.username IS NOT NULL
+		  AND s.schemaname = :1
+		ORDER BY s.last_call_et DESC
+	`
+
+	// QueryClusterActiveSessionCount counts active user sessions on every instance
+	QueryClusterActiveSessionCount = `
+		SELECT COUNT(*) as active_sessions
+		FROM gv$session
+		WHERE type = 'USER'
+		  AND username IS NOT NULL
+		  AND status = 'ACTIVE'
+	`
+
+	// QueryClusterBlockingSessions retrieves blocking sessions across instances;
+	// a blocker may run on a different instance than the session it blocks
+	QueryClusterBlockingSessions = `
+		SELECT
+			blocking.sid as blocking_sid,
+			blocking.serial# as blocking_serial,
+			blocking.username as blocking_user,
+			blocking.schemaname as blocking_schema,
+			blocking.status as blocking_status,
+			blocking.sql_id as blocking_sql_id,
+			blocking_sql.sql_text as blocking_sql_text,
+			blocked.sid as blocked_sid,
+			blocked.serial# as blocked_serial,
+			blocked.username as blocked_user,
+			blocked.schemaname as blocked_schema,
+			blocked.wait_class as blocked_wait_class,
+			blocked.event as blocked_event,
+			blocked.seconds_in_wait as blocked_duration_seconds,
+			blocked_sql.sql_text as blocked_sql_text,
+			blocking.inst_id as blocking_inst_id,
+			blocked.inst_id as blocked_inst_id
+		FROM gv$session blocking
+		JOIN gv$session blocked
+		  ON blocking.sid = blocked.blocking_session
+		 AND blocking.inst_id = blocked.blocking_instance
+		LEFT JOIN gv$sql blocking_sql ON blocking.sql_id = blocking_sql.sql_id AND blocking.inst_id = blocking_sql.inst_id
+		LEFT JOIN gv$sql blocked_sql ON blocked.sql_id = blocked_sql.sql_id AND blocked.inst_id = blocked_sql.inst_id
+		WHERE blocking.type = 'USER'
+		  AND (:1 IS NULL OR blocked.con_id = CON_NAME_TO_ID(:2))
+		ORDER BY blocked.seconds_in_wait DESC
+	`
+
+	// QueryClusterTopSQLByElapsedTime retrieves top SQL by elapsed time on every instance
+	QueryClusterTopSQLByElapsedTime = `
+		SELECT
+			sql_id,
+			SUBSTR(sql_text, 1, 4000) as sql_text,
+			parsing_schema_name,
+			executions,
+			ROUND(elapsed_time / 1000000, 2) as elapsed_time_seconds,
+			ROUND(elapsed_time / executions / 1000000, 4) as avg_elapsed_seconds,
+			ROUND(cpu_time / 1000000, 2) as cpu_time_seconds,
+			disk_reads,
+			buffer_gets,
+			rows_processed,
+			first_load_time,
+			last_active_time,
+			inst_id,
+			con_id
+		FROM gv$sql
+		WHERE executions > 0
+		  AND parsing_schema_name IS NOT NULL
+		  AND (:1 IS NULL OR con_id = CON_NAME_TO_ID(:2))
+		ORDER BY elapsed_time DESC
+		FETCH FIRST :3 ROWS ONLY
+	`
+
+	// QueryClusterTopSQLByCPU retrieves top SQL by CPU time on every instance
+	QueryClusterTopSQLByCPU = `
+		SELECT
+			sql_id,
+			SUBSTR(sql_text, 1, 4000) as sql_text,
+			parsing_schema_name,
+			executions,
+			ROUND(cpu_time / 1000000, 2) as cpu_time_seconds,
+			ROUND(cpu_time / executions / 1000000, 4) as avg_cpu_seconds,
+			ROUND(elapsed_time / 1000000, 2) as elapsed_time_seconds,
+			disk_reads,
+			buffer_gets,
+			rows_processed,
+			inst_id,
+			con_id
+		FROM gv$sql
+		WHERE executions > 0
+		  AND parsing_schema_name IS NOT NULL
+		  AND (:1 IS NULL OR con_id = CON_NAME_TO_ID(:2))
+		ORDER BY cpu_time DESC
+		FETCH FIRST :3 ROWS ONLY
+	`
+
+	// QueryClusterSQLByID retrieves statistics for one SQL_ID summed over its
+	// child cursors on every instance; inst_id is set only when a single
+	// instance has it cached
+	QueryClusterSQLByID = `
+		SELECT
+			sql_id,
+			MAX(SUBSTR(sql_text, 1, 4000)) as sql_text,
+			MAX(parsing_schema_name) as parsing_schema_name,
+			SUM(executions) as executions,
+			ROUND(SUM(elapsed_time) / 1000000, 2) as elapsed_time_seconds,
+			ROUND(SUM(elapsed_time) / NULLIF(SUM(executions), 0) / 1000000, 4) as avg_elapsed_seconds,
+			ROUND(SUM(cpu_time) / 1000000, 2) as cpu_time_seconds,
+			SUM(disk_reads) as disk_reads,
+			SUM(buffer_gets) as buffer_gets,
+			SUM(rows_processed) as rows_processed,
+			MIN(first_load_time) as first_load_time,
+			MAX(last_active_time) as last_active_time,
+			MAX(plan_hash_value) KEEP (DENSE_RANK LAST ORDER BY last_active_time) as plan_hash_value,
+			CASE WHEN COUNT(DISTINCT inst_id) = 1 THEN MIN(inst_id) END as inst_id,
+			CASE WHEN COUNT(DISTINCT con_id) = 1 THEN MIN(con_id) END as con_id
+		FROM gv$sql
+		WHERE sql_id = :1
+		GROUP BY sql_id
+	`
+
+	// QueryClusterDatabaseInstances retrieves every open instance of the database
+	QueryClusterDatabaseInstances = `
+		SELECT
+			inst_id,
+			instance_name,
+			host_name,
+			version,
+			startup_time,
+			status,
+			database_status,
+			instance_role,
+			ROUND((SYSDATE - startup_time), 2) as uptime_days
+		FROM gv$instance
+		ORDER BY inst_id
+	`
+	// QueryClusterPDBs retrieves the pluggable databases as seen by every
+	// instance; open mode can differ between instances
+	QueryClusterPDBs = `
+		SELECT
+			con_id,
+			name,
+			dbid,
+			open_mode,
+			restricted,
+			open_time,
+			ROUND(total_size / 1024 / 1024, 2) as total_size_mb,
+			inst_id
+		FROM gv$pdbs
+		WHERE name <> 'PDB$SEED'
+		ORDER BY con_id, inst_id
+	`
+
+	// QueryClusterASHSample samples active foreground sessions on every instance
+	QueryClusterASHSample = `
+		SELECT
+			s.sid,
+			s.serial#,
+			s.username,
+			s.sql_id,
+			CASE WHEN s.state = 'WAITING' THEN s.wait_class ELSE 'CPU' END as wait_class,
+			CASE WHEN s.state = 'WAITING' THEN s.event ELSE 'ON CPU' END as event,
+			s.module,
+			s.program,
+			s.machine,
+			s.blocking_session,
+			s.inst_id
+		FROM gv$session s
+		WHERE s.type = 'USER'
+		  AND s.status = 'ACTIVE'
+		  AND NOT (s.sid = SYS_CONTEXT('USERENV', 'SID') AND s.inst_id = USERENV('INSTANCE'))
+		  AND (s.state <> 'WAITING' OR s.wait_class <> 'Idle')
+	`
+
+	// QueryClusterLongOps retrieves long-running operations on every instance,
+	// optionally only in the PDB named by :1/:2
+	QueryClusterLongOps = `
+		SELECT
+			l.sid,
+			l.serial#,
+			l.opname,
+			l.target,
+			l.target_desc,
+			l.sofar,
+			l.totalwork,
+			l.units,
+			ROUND(l.sofar / NULLIF(l.totalwork, 0) * 100, 2) as percent_complete,
+			l.elapsed_seconds,
+			l.time_remaining,
+			l.start_time,
+			l.last_update_time,
+			l.message,
+			l.sql_id,
+			s.sid as session_sid,
+			s.username,
+			s.schemaname,
+			s.osuser,
+			s.machine,
+			s.program,
+			s.status,
+			s.sql_id as session_sql_id,
+			SUBSTR(sq.sql_text, 1, 1000) as sql_text,
+			s.logon_time,
+			s.last_call_et,
+			s.blocking_session,
+			s.wait_class,
+			s.event,
+			s.seconds_in_wait,
+			l.inst_id,
+			s.con_id
+		FROM gv$session_longops l
+		LEFT JOIN gv$session s ON s.sid = l.sid AND s.serial# = l.serial# AND s.inst_id = l.inst_id
+		LEFT JOIN gv$sqlarea sq ON sq.sql_id = s.sql_id AND sq.inst_id = s.inst_id
+		WHERE (:1 IS NULL OR l.con_id = CON_NAME_TO_ID(:2))
+		ORDER BY l.start_time DESC
 	`
-)
\ No newline at end of file
+
+	// QueryClusterSessionLongOps retrieves the long-running operations of one
+	// session on instance :3, or on the connected instance when :3 is NULL
+	QueryClusterSessionLongOps = `
+		SELECT
+			l.sid,
+			l.serial#,
+			l.opname,
+			l.target,
+			l.target_desc,
+			l.sofar,
+			l.totalwork,
+			l.units,
+			ROUND(l.sofar / NULLIF(l.totalwork, 0) * 100, 2) as percent_complete,
+			l.elapsed_seconds,
+			l.time_remaining,
+			l.start_time,
+			l.last_update_time,
+			l.message,
+			l.sql_id,
+			s.sid as session_sid,
+			s.username,
+			s.schemaname,
+			s.osuser,
+			s.machine,
+			s.program,
+			s.status,
+			s.sql_id as session_sql_id,
+			SUBSTR(sq.sql_text, 1, 1000) as sql_text,
+			s.logon_time,
+			s.last_call_et,
+			s.blocking_session,
+			s.wait_class,
+			s.event,
+			s.seconds_in_wait,
+			l.inst_id,
+			s.con_id
+		FROM gv$session_longops l
+		LEFT JOIN gv$session s ON s.sid = l.sid AND s.serial# = l.serial# AND s.inst_id = l.inst_id
+		LEFT JOIN gv$sqlarea sq ON sq.sql_id = s.sql_id AND sq.inst_id = s.inst_id
+		WHERE l.sid = :1
+		  AND l.serial# = :2
+		  AND l.inst_id = NVL(:3, USERENV('INSTANCE'))
+		ORDER BY l.start_time DESC
+	`
+
+	// QueryClusterSessionDetail retrieves one session on instance :2, or on
+	// the connected instance when :2 is NULL
+	QueryClusterSessionDetail = `
+		SELECT
+			s.sid,
+			s.serial#,
+			s.username,
+			s.schemaname,
+			s.osuser,
+			s.machine,
+			s.program,
+			s.status,
+			s.sql_id,
+			SUBSTR(sq.sql_text, 1, 1000) as sql_text,
+			s.logon_time,
+			s.last_call_et,
+			s.blocking_session,
+			s.wait_class,
+			s.event,
+			s.seconds_in_wait,
+			s.state,
+			s.client_identifier,
+			s.client_info,
+			s.module,
+			s.action,
+			s.service_name,
+			s.terminal,
+			p.spid,
+			s.sql_child_number,
+			s.sql_exec_start,
+			s.prev_sql_id,
+			s.prev_child_number,
+			s.inst_id,
+			s.con_id
+		FROM gv$session s
+		LEFT JOIN gv$process p ON p.addr = s.paddr AND p.inst_id = s.inst_id
+		LEFT JOIN gv$sqlarea sq ON sq.sql_id = s.sql_id AND sq.inst_id = s.inst_id
+		WHERE s.sid = :1
+		  AND s.inst_id = NVL(:2, USERENV('INSTANCE'))
+	`
+
+	// QueryClusterSQLFullText retrieves the text of a SQL statement cached on instance :2
+	QueryClusterSQLFullText = `
+		SELECT
+			address,
+			sql_text
+		FROM gv$sqltext
+		WHERE sql_id = :1
+		  AND inst_id = :2
+		ORDER BY address, piece
+	`
+
+	// QueryClusterSessionStats retrieves the drill-down statistics of a session on instance :2
+	QueryClusterSessionStats = `
+		SELECT
+			n.name,
+			st.value
+		FROM gv$sesstat st
+		JOIN gv$statname n ON n.statistic# = st.statistic# AND n.inst_id = st.inst_id
+		WHERE st.sid = :1
+		  AND st.inst_id = :2
+		  AND n.name IN (
+			'CPU used by this session',
+			'session logical reads',
+			'physical reads',
+			'session pga memory',
+			'session pga memory max',
+			'opened cursors current'
+		  )
+	`
+
+	// QueryClusterSessionOpenCursors retrieves the open cursors of a session on instance :2
+	QueryClusterSessionOpenCursors = `
+		SELECT
+			sql_id,
+			sql_text,
+			cursor_type,
+			last_sql_active_time
+		FROM gv$open_cursor
+		WHERE sid = :1
+		  AND inst_id = :2
+		ORDER BY last_sql_active_time DESC NULLS LAST
+	`
+
+	// QueryClusterSessionLocks retrieves the locks of a session on instance :2
+	QueryClusterSessionLocks = `
+		SELECT
+			l.type,
+			DECODE(l.lmode, 0, 'None', 1, 'Null', 2, 'Row-S (SS)', 3, 'Row-X (SX)',
+				4, 'Share', 5, 'S/Row-X (SSX)', 6, 'Exclusive', TO_CHAR(l.lmode)) as lock_mode,
+			DECODE(l.request, 0, 'None', 1, 'Null', 2, 'Row-S (SS)', 3, 'Row-X (SX)',
+				4, 'Share', 5, 'S/Row-X (SSX)', 6, 'Exclusive', TO_CHAR(l.request)) as requested_mode,
+			l.id1,
+			l.id2,
+			l.ctime,
+			l.block,
+			o.owner,
+			o.object_name,
+			o.object_type
+		FROM gv$lock l
+		LEFT JOIN dba_objects o ON l.type = 'TM' AND o.object_id = l.id1
+		WHERE l.sid = :1
+		  AND l.inst_id = :2
+		ORDER BY l.ctime DESC
+	`
+
+	// QueryClusterSessionWaitHistory retrieves the last waits of a session on instance :2
+	QueryClusterSessionWaitHistory = `
+		SELECT
+			h.seq#,
+			h.event,
+			en.wait_class,
+			h.wait_time_micro,
+			h.time_since_last_wait_micro,
+			h.p1text,
+			TO_CHAR(h.p1) as p1,
+			h.p2text,
+			TO_CHAR(h.p2) as p2,
+			h.p3text,
+			TO_CHAR(h.p3) as p3
+		FROM gv$session_wait_history h
+		LEFT JOIN v$event_name en ON en.event# = h.event#
+		WHERE h.sid = :1
+		  AND h.inst_id = :2
+		ORDER BY h.seq#
+	`
+)
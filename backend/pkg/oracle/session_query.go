@@ -0,0 +1,244 @@
+package oracle
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Session sort keys
+const (
+	SessionSortSID       = "SID"
+	SessionSortLogonTime = "LOGON_TIME"
+	SessionSortIdleTime  = "IDLE_TIME"
+	SessionSortUsername  = "USERNAME"
+	SessionSortMachine   = "MACHINE"
+	SessionSortProgram   = "PROGRAM"
+)
+
+// Sort expressions never yield NULL so keyset comparisons stay well defined
+var sessionSortExpressions = map[string]string{
+	SessionSortSID:       "s.sid",
+	SessionSortLogonTime: "s.logon_time",
+	SessionSortIdleTime:  "s.last_call_et",
+	SessionSortUsername:  "NVL(s.username, ' ')",
+	SessionSortMachine:   "NVL(s.machine, ' ')",
+	SessionSortProgram:   "NVL(s.program, ' ')",
+}
+
+// ValidSessionSort reports whether sortBy is a known session sort key
+func ValidSessionSort(sortBy string) bool {
+	_, ok := sessionSortExpressions[sortBy]
+	return ok
+}
+
+// SessionFilter narrows a session listing; zero values are not filtered on.
+// Machine, program and module accept LIKE patterns.
+type SessionFilter struct {
+	SchemaName     string
+	Status         string
+	Username       string
+	Machine        string
+	Program        string
+	Module         string
+	WaitClass      string
+	MinIdleSeconds int
+	InstID         int    // RAC instance number
+	PDB            string // pluggable database name
+}
+
+// SessionKey is the position of a row in a sorted session listing
+type SessionKey struct {
+	SortValue interface{}
+	SID       int
+	InstID    int
+	Serial    int
+}
+
+// SessionQuery is one page of a filtered, sorted session listing. Pages are
+// addressed either by offset or, for stable paging, by the key of the last
+// row of the previous page. Cluster lists the sessions of every RAC instance
+// from gv$session.
+type SessionQuery struct {
+	Filter     SessionFilter
+	SortBy     string
+	Descending bool
+	After      *SessionKey
+	Offset     int
+	Limit      int
+	Cluster    bool
+}
+
+// sqlBinds numbers bind variables in the order they are added
+type sqlBinds struct {
+	args []interface{}
+}
+
+func (b *sqlBinds) add(value interface{}) string {
+	b.args = append(b.args, value)
+	return ":" + strconv.Itoa(len(b.args))
+}
+
+// Build returns the parameterized listing query and its bind values
+func (q SessionQuery) Build() (string, []interface{}, error) {
+	sortExpr, ok := sessionSortExpressions[q.SortBy]
+	if !ok {
+		return "", nil, fmt.Errorf("unknown session sort key: %s", q.SortBy)
+	}
+
+	binds := &sqlBinds{}
+	conditions := q.Filter.conditions(binds, q.Cluster)
+
+	direction, op := "ASC", ">"
+	if q.Descending {
+		direction, op = "DESC", "<"
+	}
+
+	// SID alone is only unique within one instance
+	keys := []string{sortExpr, "s.sid", "s.serial#"}
+	if q.Cluster {
+		keys = []string{sortExpr, "s.sid", "s.inst_id", "s.serial#"}
+	}
+
+	if q.After != nil {
+		values := []interface{}{q.After.SortValue, q.After.SID, q.After.Serial}
+		if q.Cluster {
+			values = []interface{}{q.After.SortValue, q.After.SID, q.After.InstID, q.After.Serial}
+		}
+		conditions = append(conditions, "("+keysetCondition(keys, values, op, binds)+")")
+	}
+
+	order := make([]string, len(keys))
+	for i, key := range keys {
+		order[i] = key + " " + direction
+	}
+
+	selectList := sessionListSelect
+	if q.Cluster {
+		selectList = clusterSessionListSelect
+	}
+
+	query := selectList + `
+		WHERE ` + strings.Join(conditions, "\n		  AND ") + fmt.Sprintf(`
+		ORDER BY %s
+		OFFSET %s ROWS FETCH NEXT %s ROWS ONLY
+	`, strings.Join(order, ", "), binds.add(q.Offset), binds.add(q.Limit))
+
+	return query, binds.args, nil
+}
+
+// BuildCount returns the parameterized query counting every session matching the filter
+func (q SessionQuery) BuildCount() (string, []interface{}) {
+	binds := &sqlBinds{}
+	conditions := q.Filter.conditions(binds, q.Cluster)
+
+	view := "v$session"
+	if q.Cluster {
+		view = "gv$session"
+	}
+
+	query := `
+		SELECT COUNT(*)
+		FROM ` + view + ` s
+		WHERE ` + strings.Join(conditions, "\n		  AND ")
+
+	return query, binds.args
+}
+
+// keysetCondition matches rows sorting after the given key values: each
+// column is compared only where all earlier columns are equal
+func keysetCondition(keys []string, values []interface{}, op string, binds *sqlBinds) string {
+	condition := fmt.Sprintf("%s %s %s", keys[0], op, binds.add(values[0]))
+	if len(keys) == 1 {
+		return condition
+	}
+	return fmt.Sprintf("%s OR (%s = %s AND (%s))",
+		condition, keys[0], binds.add(values[0]), keysetCondition(keys[1:], values[1:], op, binds))
+}
+
+func (f SessionFilter) conditions(binds *sqlBinds, cluster bool) []string {
+	conditions := []string{"s.type = 'USER'", "s.username IS NOT NULL"}
+
+	if f.SchemaName != "" {
+		conditions = append(conditions, "s.schemaname = "+binds.add(f.SchemaName))
+	}
+	if f.Status != "" {
+		conditions = append(conditions, "s.status = "+binds.add(f.Status))
+	}
+	if f.Username != "" {
+		conditions = append(conditions, "s.username = "+binds.add(f.Username))
+	}
+	if f.Machine != "" {
+		conditions = append(conditions, "s.machine LIKE "+binds.add(f.Machine))
+	}
+	if f.Program != "" {
+		conditions = append(conditions, "s.program LIKE "+binds.add(f.Program))
+	}
+	if f.Module != "" {
+		conditions = append(conditions, "s.module LIKE "+binds.add(f.Module))
+	}
+	if f.WaitClass != "" {
+		conditions = append(conditions, "s.wait_class = "+binds.add(f.WaitClass))
+	}
+	if f.MinIdleSeconds > 0 {
+		conditions = append(conditions, "s.last_call_et >= "+binds.add(f.MinIdleSeconds))
+	}
+	if f.InstID > 0 {
+		instance := "USERENV('INSTANCE')"
+		if cluster {
+			instance = "s.inst_id"
+		}
+		conditions = append(conditions, instance+" = "+binds.add(f.InstID))
+	}
+	if f.PDB != "" {
+		conditions = append(conditions, "s.con_id = CON_NAME_TO_ID("+binds.add(f.PDB)+")")
+	}
+
+	return conditions
+}
+
+const sessionListSelect = `
+		SELECT
+			s.sid,
+			s.serial#,
+			s.username,
+			s.schemaname,
+			s.osuser,
+			s.machine,
+			s.program,
+			s.status,
+			s.sql_id,
+			SUBSTR(sq.sql_text, 1, 1000) as sql_text,
+			s.logon_time,
+			s.last_call_et,
+			s.blocking_session,
+			s.wait_class,
+			s.event,
+			s.seconds_in_wait,
+			USERENV('INSTANCE') as inst_id,
+			s.con_id
+		FROM v$session s
+		LEFT JOIN v$sqlarea sq ON sq.sql_id = s.sql_id`
+
+const clusterSessionListSelect = `
+		SELECT
+			s.sid,
+			s.serial#,
+			s.username,
+			s.schemaname,
+			s.osuser,
+			s.machine,
+			s.program,
+			s.status,
+			s.sql_id,
+			SUBSTR(sq.sql_text, 1, 1000) as sql_text,
+			s.logon_time,
+			s.last_call_et,
+			s.blocking_session,
+			s.wait_class,
+			s.event,
+			s.seconds_in_wait,
+			s.inst_id,
+			s.con_id
+		FROM gv$session s
+		LEFT JOIN gv$sqlarea sq ON sq.sql_id = s.sql_id AND sq.inst_id = s.inst_id`
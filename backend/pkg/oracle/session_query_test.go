@@ -0,0 +1,192 @@
+package oracle
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestKeysetCondition(t *testing.T) {
+	tests := []struct {
+		name      string
+		keys      []string
+		values    []interface{}
+		op        string
+		want      string
+		wantBinds []interface{}
+	}{
+		{
+			name:      "single key",
+			keys:      []string{"s.sid"},
+			values:    []interface{}{42},
+			op:        ">",
+			want:      "s.sid > :1",
+			wantBinds: []interface{}{42},
+		},
+		{
+			name:      "ties on the sort key fall through to the next key",
+			keys:      []string{"s.last_call_et", "s.sid"},
+			values:    []interface{}{300, 42},
+			op:        ">",
+			want:      "s.last_call_et > :1 OR (s.last_call_et = :2 AND (s.sid > :3))",
+			wantBinds: []interface{}{300, 300, 42},
+		},
+		{
+			name:   "three keys descending",
+			keys:   []string{"NVL(s.username, ' ')", "s.sid", "s.serial#"},
+			values: []interface{}{"SCOTT", 42, 7},
+			op:     "<",
+			want: "NVL(s.username, ' ') < :1 OR (NVL(s.username, ' ') = :2 AND " +
+				"(s.sid < :3 OR (s.sid = :4 AND (s.serial# < :5))))",
+			wantBinds: []interface{}{"SCOTT", "SCOTT", 42, 42, 7},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			binds := &sqlBinds{}
+			got := keysetCondition(tt.keys, tt.values, tt.op, binds)
+			if got != tt.want {
+				t.Errorf("condition = %q\nwant        %q", got, tt.want)
+			}
+			if !reflect.DeepEqual(binds.args, tt.wantBinds) {
+				t.Errorf("binds = %v, want %v", binds.args, tt.wantBinds)
+			}
+		})
+	}
+}
+
+func TestSessionQueryBuild(t *testing.T) {
+	logon := time.Date(2026, 3, 1, 8, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		query        SessionQuery
+		wantContains []string
+		wantMissing  []string
+		wantBinds    []interface{}
+	}{
+		{
+			name:  "first page",
+			query: SessionQuery{SortBy: SessionSortSID, Offset: 0, Limit: 50},
+			wantContains: []string{
+				"FROM v$session s",
+				"ORDER BY s.sid ASC, s.sid ASC, s.serial# ASC",
+				"OFFSET :1 ROWS FETCH NEXT :2 ROWS ONLY",
+			},
+			wantMissing: []string{"gv$session"},
+			wantBinds:   []interface{}{0, 50},
+		},
+		{
+			name: "filters bind in order",
+			query: SessionQuery{
+				Filter: SessionFilter{Status: "ACTIVE", Machine: "app%", MinIdleSeconds: 60},
+				SortBy: SessionSortIdleTime, Descending: true, Offset: 100, Limit: 25,
+			},
+			wantContains: []string{
+				"s.status = :1", "s.machine LIKE :2", "s.last_call_et >= :3",
+				"ORDER BY s.last_call_et DESC, s.sid DESC, s.serial# DESC",
+				"OFFSET :4 ROWS FETCH NEXT :5 ROWS ONLY",
+			},
+			wantBinds: []interface{}{"ACTIVE", "app%", 60, 100, 25},
+		},
+		{
+			name: "cursor after a tied sort value",
+			query: SessionQuery{
+				SortBy: SessionSortLogonTime, Limit: 10,
+				After: &SessionKey{SortValue: logon, SID: 42, Serial: 7},
+			},
+			wantContains: []string{
+				"(s.logon_time > :1 OR (s.logon_time = :2 AND (s.sid > :3 OR (s.sid = :4 AND (s.serial# > :5)))))",
+				"OFFSET :6 ROWS FETCH NEXT :7 ROWS ONLY",
+			},
+			wantBinds: []interface{}{logon, logon, 42, 42, 7, 0, 10},
+		},
+		{
+			name: "descending cursor",
+			query: SessionQuery{
+				SortBy: SessionSortUsername, Descending: true, Limit: 10,
+				After: &SessionKey{SortValue: "SCOTT", SID: 42, Serial: 7},
+			},
+			wantContains: []string{"NVL(s.username, ' ') < :1 OR (NVL(s.username, ' ') = :2"},
+			wantBinds:    []interface{}{"SCOTT", "SCOTT", 42, 42, 7, 0, 10},
+		},
+		{
+			name: "cluster cursor includes the instance",
+			query: SessionQuery{
+				SortBy: SessionSortSID, Limit: 10, Cluster: true,
+				Filter: SessionFilter{InstID: 2},
+				After:  &SessionKey{SortValue: 42, SID: 42, InstID: 2, Serial: 7},
+			},
+			wantContains: []string{
+				"FROM gv$session s",
+				"s.inst_id = :1",
+				"ORDER BY s.sid ASC, s.sid ASC, s.inst_id ASC, s.serial# ASC",
+			},
+			wantBinds: []interface{}{2, 42, 42, 42, 42, 2, 2, 7, 0, 10},
+		},
+		{
+			name: "local instance filter",
+			query: SessionQuery{
+				SortBy: SessionSortSID, Limit: 10,
+				Filter: SessionFilter{InstID: 1, PDB: "SALES"},
+			},
+			wantContains: []string{"USERENV('INSTANCE') = :1", "s.con_id = CON_NAME_TO_ID(:2)"},
+			wantBinds:    []interface{}{1, "SALES", 0, 10},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sql, binds, err := tt.query.Build()
+			if err != nil {
+				t.Fatalf("Build() error = %v", err)
+			}
+			for _, want := range tt.wantContains {
+				if !strings.Contains(sql, want) {
+					t.Errorf("query missing %q:\n%s", want, sql)
+				}
+			}
+			for _, missing := range tt.wantMissing {
+				if strings.Contains(sql, missing) {
+					t.Errorf("query contains %q:\n%s", missing, sql)
+				}
+			}
+			if !reflect.DeepEqual(binds, tt.wantBinds) {
+				t.Errorf("binds = %v, want %v", binds, tt.wantBinds)
+			}
+		})
+	}
+}
+
+func TestSessionQueryBuildUnknownSort(t *testing.T) {
+	if _, _, err := (SessionQuery{SortBy: "CPU"}).Build(); err == nil {
+		t.Error("Build() with an unknown sort key succeeded")
+	}
+	if ValidSessionSort("CPU") || !ValidSessionSort(SessionSortProgram) {
+		t.Error("ValidSessionSort disagrees with the known sort keys")
+	}
+}
+
+func TestSessionQueryBuildCount(t *testing.T) {
+	query := SessionQuery{
+		Filter:  SessionFilter{Username: "SCOTT", WaitClass: "User I/O"},
+		SortBy:  SessionSortSID,
+		After:   &SessionKey{SortValue: 1, SID: 1, Serial: 1},
+		Limit:   10,
+		Cluster: true,
+	}
+
+	sql, binds := query.BuildCount()
+	if !strings.Contains(sql, "FROM gv$session s") || !strings.Contains(sql, "s.wait_class = :2") {
+		t.Errorf("unexpected count query:\n%s", sql)
+	}
+	// The count covers the whole filtered listing, not the page after the cursor
+	if strings.Contains(sql, "ORDER BY") || strings.Contains(sql, "s.sid >") {
+		t.Errorf("count query is paged:\n%s", sql)
+	}
+	if !reflect.DeepEqual(binds, []interface{}{"SCOTT", "User I/O"}) {
+		t.Errorf("binds = %v", binds)
+	}
+}
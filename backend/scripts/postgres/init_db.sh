@@ -47,6 +47,7 @@ psql -U ${DB_USER} -h ${DB_HOST} -p ${DB_PORT} -d ${DB_NAME} <<EOF
 CREATE SCHEMA IF NOT EXISTS auth;
 CREATE SCHEMA IF NOT EXISTS audit;
 CREATE SCHEMA IF NOT EXISTS monitoring;
+CREATE SCHEMA IF NOT EXISTS alerting;
 EOF
 
 # Create tables
@@ -127,6 +128,245 @@ CREATE TABLE IF NOT EXISTS monitoring.session_snapshots (
 );
 
 CREATE INDEX IF NOT EXISTS idx_session_snapshots_time ON monitoring.session_snapshots(snapshot_time DESC);
+
+-- SQL statistics snapshots (cumulative v\$sql values per SQL_ID)
+CREATE TABLE IF NOT EXISTS monitoring.sql_metrics (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    oracle_db TEXT NOT NULL,
+    sql_id TEXT NOT NULL,
+    sql_text TEXT,
+    parsing_schema TEXT,
+    executions BIGINT NOT NULL,
+    elapsed_time_ms DOUBLE PRECISION NOT NULL,
+    cpu_time_ms DOUBLE PRECISION NOT NULL,
+    disk_reads BIGINT NOT NULL,
+    buffer_gets BIGINT NOT NULL,
+    rows_processed BIGINT NOT NULL,
+    first_load_time TIMESTAMP,
+    last_active_time TIMESTAMP,
+    plan_hash_value BIGINT,
+    captured_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_sql_metrics_db_time ON monitoring.sql_metrics(oracle_db, captured_at DESC);
+CREATE INDEX IF NOT EXISTS idx_sql_metrics_sql_id ON monitoring.sql_metrics(sql_id, captured_at);
+
+-- SQL plan changes detected between snapshots
+CREATE TABLE IF NOT EXISTS monitoring.sql_plan_changes (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    oracle_db TEXT NOT NULL,
+    sql_id TEXT NOT NULL,
+    old_plan_hash_value BIGINT NOT NULL,
+    new_plan_hash_value BIGINT NOT NULL,
+    old_avg_elapsed_ms DOUBLE PRECISION NOT NULL,
+    new_avg_elapsed_ms DOUBLE PRECISION NOT NULL,
+    regressed BOOLEAN NOT NULL,
+    detected_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_sql_plan_changes_db_time ON monitoring.sql_plan_changes(oracle_db, detected_at DESC);
+CREATE INDEX IF NOT EXISTS idx_sql_plan_changes_sql_id ON monitoring.sql_plan_changes(oracle_db, sql_id, detected_at DESC);
+
+-- Active session history samples (one row per active session per sample)
+CREATE TABLE IF NOT EXISTS monitoring.ash_samples (
+    sample_time TIMESTAMP NOT NULL,
+    oracle_db TEXT NOT NULL,
+    sid INTEGER NOT NULL,
+    serial INTEGER NOT NULL,
+    username TEXT,
+    sql_id TEXT,
+    wait_class TEXT NOT NULL,
+    event TEXT NOT NULL,
+    module TEXT,
+    program TEXT,
+    machine TEXT,
+    blocking_session INTEGER,
+    inst_id INTEGER NOT NULL DEFAULT 1
+);
+
+CREATE INDEX IF NOT EXISTS idx_ash_samples_db_time ON monitoring.ash_samples(oracle_db, sample_time);
+
+-- System-wide wait event deltas (v\$system_event, one row per event per interval)
+CREATE TABLE IF NOT EXISTS monitoring.wait_event_deltas (
+    captured_at TIMESTAMP NOT NULL,
+    oracle_db TEXT NOT NULL,
+    interval_seconds DOUBLE PRECISION NOT NULL,
+    event TEXT NOT NULL,
+    wait_class TEXT NOT NULL,
+    waits BIGINT NOT NULL,
+    time_waited_micro BIGINT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_wait_event_deltas_db_time ON monitoring.wait_event_deltas(oracle_db, captured_at);
+
+-- Time model and sysstat deltas (v\$sys_time_model, v\$sysstat)
+CREATE TABLE IF NOT EXISTS monitoring.system_stat_deltas (
+    captured_at TIMESTAMP NOT NULL,
+    oracle_db TEXT NOT NULL,
+    interval_seconds DOUBLE PRECISION NOT NULL,
+    source TEXT NOT NULL,
+    name TEXT NOT NULL,
+    value DOUBLE PRECISION NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_system_stat_deltas_db_time ON monitoring.system_stat_deltas(oracle_db, captured_at);
+
+-- Fast Recovery Area snapshots (v\$recovery_file_dest)
+CREATE TABLE IF NOT EXISTS monitoring.recovery_area_metrics (
+    captured_at TIMESTAMP NOT NULL,
+    oracle_db TEXT NOT NULL,
+    space_limit_mb DOUBLE PRECISION NOT NULL,
+    space_used_mb DOUBLE PRECISION NOT NULL,
+    space_reclaimable_mb DOUBLE PRECISION NOT NULL,
+    file_count INTEGER NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_recovery_area_metrics_db_time ON monitoring.recovery_area_metrics(oracle_db, captured_at);
+
+-- Data Guard standby lag snapshots (v\$dataguard_stats)
+CREATE TABLE IF NOT EXISTS monitoring.dataguard_lag_metrics (
+    captured_at TIMESTAMP NOT NULL,
+    oracle_db TEXT NOT NULL,
+    transport_lag_seconds DOUBLE PRECISION,
+    apply_lag_seconds DOUBLE PRECISION
+);
+
+CREATE INDEX IF NOT EXISTS idx_dataguard_lag_metrics_db_time ON monitoring.dataguard_lag_metrics(oracle_db, captured_at);
+
+-- Initialization parameter values (v\$parameter / v\$spparameter), one row
+-- per change; the first row of each parameter is its baseline
+CREATE TABLE IF NOT EXISTS monitoring.parameter_snapshots (
+    captured_at TIMESTAMP NOT NULL,
+    oracle_db TEXT NOT NULL,
+    name TEXT NOT NULL,
+    value TEXT,
+    spfile_value TEXT,
+    is_default BOOLEAN NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_parameter_snapshots_db_name_time ON monitoring.parameter_snapshots(oracle_db, name, captured_at);
+
+-- Sizes of the largest segments (dba_segments)
+CREATE TABLE IF NOT EXISTS monitoring.segment_snapshots (
+    captured_at TIMESTAMP NOT NULL,
+    oracle_db TEXT NOT NULL,
+    owner TEXT NOT NULL,
+    segment_name TEXT NOT NULL,
+    partition_name TEXT,
+    segment_type TEXT NOT NULL,
+    tablespace_name TEXT NOT NULL,
+    size_mb DOUBLE PRECISION NOT NULL,
+    extents INTEGER NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_segment_snapshots_db_time ON monitoring.segment_snapshots(oracle_db, captured_at);
+CREATE INDEX IF NOT EXISTS idx_segment_snapshots_db_segment_time ON monitoring.segment_snapshots(oracle_db, owner, segment_name, captured_at);
+EOF
+
+# Alerting tables
+psql -U ${DB_USER} -h ${DB_HOST} -p ${DB_PORT} -d ${DB_NAME} <<EOF
+-- Alert rules
+CREATE TABLE IF NOT EXISTS alerting.rules (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name TEXT NOT NULL,
+    description TEXT,
+    metric TEXT NOT NULL,
+    condition TEXT NOT NULL,
+    threshold DOUBLE PRECISION NOT NULL,
+    duration_seconds INTEGER NOT NULL DEFAULT 0,
+    severity TEXT NOT NULL,
+    target_filter TEXT,
+    object_filter TEXT,
+    enabled BOOLEAN NOT NULL DEFAULT true,
+    created_by UUID REFERENCES auth.users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+-- Alerts (kept after their rule is deleted)
+CREATE TABLE IF NOT EXISTS alerting.alerts (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    rule_id UUID REFERENCES alerting.rules(id) ON DELETE SET NULL,
+    rule_name TEXT NOT NULL,
+    metric TEXT NOT NULL,
+    severity TEXT NOT NULL,
+    status TEXT NOT NULL,
+    target TEXT NOT NULL,
+    object_key TEXT NOT NULL,
+    value DOUBLE PRECISION NOT NULL,
+    threshold DOUBLE PRECISION NOT NULL,
+    message TEXT NOT NULL,
+    fired_at TIMESTAMP NOT NULL,
+    acknowledged_at TIMESTAMP,
+    acknowledged_by TEXT,
+    resolved_at TIMESTAMP,
+    last_evaluated_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_alerts_status ON alerting.alerts(target, status);
+CREATE INDEX IF NOT EXISTS idx_alerts_fired_at ON alerting.alerts(fired_at DESC);
+
+-- Notification channels (settings hold type-specific URL/SMTP config and secrets)
+CREATE TABLE IF NOT EXISTS alerting.notification_channels (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name TEXT NOT NULL UNIQUE,
+    type TEXT NOT NULL,
+    settings JSONB NOT NULL DEFAULT '{}',
+    subject_template TEXT,
+    body_template TEXT,
+    min_severity TEXT NOT NULL DEFAULT 'INFO',
+    enabled BOOLEAN NOT NULL DEFAULT true,
+    created_by UUID REFERENCES auth.users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+-- Notification delivery log
+CREATE TABLE IF NOT EXISTS alerting.notifications (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    alert_id UUID REFERENCES alerting.alerts(id) ON DELETE CASCADE,
+    channel_id UUID REFERENCES alerting.notification_channels(id) ON DELETE SET NULL,
+    channel_name TEXT NOT NULL,
+    event TEXT NOT NULL,
+    status TEXT NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    error TEXT,
+    reason TEXT,
+    suppressed_by UUID,
+    created_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_notifications_alert ON alerting.notifications(alert_id, created_at);
+
+-- Ad hoc silences (matchers map alert labels to glob patterns)
+CREATE TABLE IF NOT EXISTS alerting.silences (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    matchers JSONB NOT NULL,
+    comment TEXT NOT NULL,
+    created_by UUID REFERENCES auth.users(id) ON DELETE SET NULL,
+    created_by_name TEXT NOT NULL,
+    starts_at TIMESTAMP NOT NULL,
+    ends_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_silences_active ON alerting.silences(ends_at, starts_at);
+
+-- Maintenance windows (recurrence repeats starts_at's wall-clock time in timezone)
+CREATE TABLE IF NOT EXISTS alerting.maintenance_windows (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name TEXT NOT NULL,
+    target TEXT NOT NULL,
+    starts_at TIMESTAMPTZ NOT NULL,
+    duration_minutes INTEGER NOT NULL,
+    recurrence TEXT NOT NULL DEFAULT 'ONCE',
+    timezone TEXT NOT NULL DEFAULT 'UTC',
+    until TIMESTAMPTZ,
+    enabled BOOLEAN NOT NULL DEFAULT true,
+    created_by UUID REFERENCES auth.users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP NOT NULL DEFAULT now()
+);
 EOF
 
 # Insert seed data
@@ -147,10 +387,16 @@ INSERT INTO auth.permissions (code, description) VALUES
 ('VIEW_TABLESPACES', 'View tablespace usage'),
 ('VIEW_SQL', 'View SQL execution metrics'),
 ('VIEW_SCHEMA', 'View schema objects and changes'),
+('VIEW_BACKUPS', 'View RMAN backups, backup coverage and Data Guard status'),
+('VIEW_PARAMETERS', 'View initialization parameters and parameter drift'),
 ('MANAGE_USERS', 'Create/update users'),
 ('MANAGE_ROLES', 'Assign roles and permissions'),
 ('AUDIT_READ', 'View audit logs'),
-('SESSION_KILL', 'Kill Oracle sessions')
+('SESSION_KILL', 'Kill Oracle sessions'),
+('GATHER_STATS', 'Gather optimizer statistics'),
+('RECOMPILE_OBJECTS', 'Recompile invalid schema objects'),
+('VIEW_ALERTS', 'View alert rules and alerts'),
+('MANAGE_ALERTS', 'Manage alert rules and acknowledge alerts')
 ON CONFLICT (code) DO NOTHING;
 
 -- Assign permissions to DBA role
@@ -164,7 +410,11 @@ AND p.code IN (
     'VIEW_TABLESPACES',
     'VIEW_SQL',
     'VIEW_SCHEMA',
-    'AUDIT_READ'
+    'VIEW_BACKUPS',
+    'VIEW_PARAMETERS',
+    'AUDIT_READ',
+    'VIEW_ALERTS',
+    'MANAGE_ALERTS'
 )
 ON CONFLICT DO NOTHING;
 
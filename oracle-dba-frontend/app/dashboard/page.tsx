@@ -45,7 +45,7 @@ interface BlockingSessionsQuery {
 }
 
 interface DatabaseInstanceQuery {
-  databaseInstance: DatabaseInstance;
+  databaseInstance: DatabaseInstance[];
 }
 
 /* ---------------- Component ---------------- */
@@ -103,17 +103,24 @@ export default function DashboardPage() {
         </div>
 
         {/* Database Instance */}
-        {dbInstanceData?.databaseInstance && (
+        {/* One row per instance; a RAC cluster lists every instance */}
+        {dbInstanceData && dbInstanceData.databaseInstance.length > 0 && (
           <div className="bg-white rounded-lg shadow p-6 mb-6">
-            <h2 className="text-lg font-semibold mb-4">Database Instance</h2>
-            <div className="grid grid-cols-2 md:grid-cols-4 gap-4">
-              <Info label="Instance" value={dbInstanceData.databaseInstance.instanceName} />
-              <Info label="Version" value={dbInstanceData.databaseInstance.version} />
-              <Info label="Status" value={dbInstanceData.databaseInstance.status} />
-              <Info
-                label="Uptime"
-                value={`${dbInstanceData.databaseInstance.uptimeDays.toFixed(1)} days`}
-              />
+            <h2 className="text-lg font-semibold mb-4">
+              {dbInstanceData.databaseInstance.length > 1 ? 'Database Instances' : 'Database Instance'}
+            </h2>
+            <div className="space-y-4">
+              {dbInstanceData.databaseInstance.map((instance) => (
+                <div key={instance.instId} className="grid grid-cols-2 md:grid-cols-4 gap-4">
+                  <Info label="Instance" value={instance.instanceName} />
+                  <Info label="Version" value={instance.version} />
+                  <Info label="Status" value={instance.status} />
+                  <Info
+                    label="Uptime"
+                    value={`${instance.uptimeDays.toFixed(1)} days`}
+                  />
+                </div>
+              ))}
             </div>
           </div>
         )}
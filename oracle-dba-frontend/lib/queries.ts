@@ -38,25 +38,32 @@ export const ME_QUERY = gql`
 `;
 
 // Session Queries
+// activeSessions is paginated: pass the previous page's nextCursor as $after
 export const ACTIVE_SESSIONS_QUERY = gql`
-  query ActiveSessions {
-    activeSessions {
-      sid
-      serial
-      username
-      schemaName
-      osUser
-      machine
-      program
-      status
-      sqlId
-      sqlText
-      logonTime
-      lastCallSeconds
-      blockingSession
-      waitClass
-      event
-      secondsInWait
+  query ActiveSessions($limit: Int, $after: String) {
+    activeSessions(limit: $limit, after: $after) {
+      sessions {
+        sid
+        serial
+        username
+        schemaName
+        osUser
+        machine
+        program
+        status
+        sqlId
+        sqlText
+        logonTime
+        lastCallSeconds
+        blockingSession
+        waitClass
+        event
+        secondsInWait
+        instId
+        conId
+      }
+      totalCount
+      nextCursor
     }
   }
 `;
@@ -177,6 +184,7 @@ export const SCHEMAS_QUERY = gql`
 export const DATABASE_INSTANCE_QUERY = gql`
   query DatabaseInstance {
     databaseInstance {
+      instId
       instanceName
       hostName
       version
@@ -38,6 +38,14 @@ export interface User {
     waitClass?: string;
     event?: string;
     secondsInWait?: number;
+    instId: number;
+    conId: number;
+  }
+  
+  export interface SessionPage {
+    sessions: OracleSession[];
+    totalCount: number;
+    nextCursor?: string;
   }
   
   export interface SessionSummary {
@@ -117,6 +125,7 @@ export interface User {
   
   // Database Health Types
   export interface DatabaseInstance {
+    instId: number;
     instanceName: string;
     hostName: string;
     version: string;